@@ -55,7 +55,16 @@ func main() {
 	// openIM clear msg --userID=xxx --beginSeq=100 --limit=10
 	// openIM clear msg --superGroupID=xxx --beginSeq=100 --limit=10
 	// openIM clear msg --clearAll
-	msgUtilsCmd.AddCommand(&getCmd.Command, &fixCmd.Command, &clearCmd.Command)
+
+	redriveCmd := cmd.NewRedriveCmd()
+	redriveCmd.AddCommand(cmd.NewMsgCmd().RedriveMsgCmd())
+	redriveCmd.AddConfFlag()
+	// openIM redrive msg --config_folder_path=xxx
+	indexCmd := cmd.NewIndexCmd()
+	indexCmd.AddCommand(cmd.NewMsgCmd().IndexMsgCmd())
+	indexCmd.AddConfFlag()
+	// openIM index msg --config_folder_path=xxx
+	msgUtilsCmd.AddCommand(&getCmd.Command, &fixCmd.Command, &clearCmd.Command, &redriveCmd.Command, &indexCmd.Command)
 	if err := msgUtilsCmd.Execute(); err != nil {
 		fmt.Fprintf(os.Stderr, "\n\nexit -1: \n%+v\n\n", err)
 		os.Exit(-1)
@@ -15,18 +15,75 @@
 package api
 
 import (
+	"context"
+
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
 
 	"github.com/OpenIMSDK/protocol/conversation"
 	"github.com/OpenIMSDK/tools/a2r"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/msgext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 )
 
-type ConversationApi rpcclient.Conversation
+type ConversationApi struct {
+	rpcclient.Conversation
+	msgExtClient msgext.MsgExtClient
+}
+
+func NewConversationApi(client rpcclient.Conversation, msgClient *rpcclient.Message) ConversationApi {
+	return ConversationApi{Conversation: client, msgExtClient: msgClient.ExtClient}
+}
+
+// sortedConversationElem is a conversation in the sorted list together with the msgs pinned in it.
+type sortedConversationElem struct {
+	*conversation.ConversationElem
+	PinnedMsgs []*msgext.PinnedMsg `json:"pinnedMsgs"`
+}
+
+type sortedConversationListResp struct {
+	ConversationTotal int64                     `json:"conversationTotal"`
+	UnreadTotal       int64                     `json:"unreadTotal"`
+	ConversationElems []*sortedConversationElem `json:"conversationElems"`
+}
+
+// pinnedConversationClient gets the sorted conversation list with the pinned msgs attached, pins are shared
+// by the conversation members and not part of the conversation protocol.
+type pinnedConversationClient struct {
+	conversation conversation.ConversationClient
+	msgExt       msgext.MsgExtClient
+}
 
-func NewConversationApi(client rpcclient.Conversation) ConversationApi {
-	return ConversationApi(client)
+func (p pinnedConversationClient) GetSortedConversationList(ctx context.Context, req *conversation.GetSortedConversationListReq, opts ...grpc.CallOption) (*sortedConversationListResp, error) {
+	resp, err := p.conversation.GetSortedConversationList(ctx, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+	conversationIDs := make([]string, 0, len(resp.ConversationElems))
+	for _, elem := range resp.ConversationElems {
+		conversationIDs = append(conversationIDs, elem.ConversationID)
+	}
+	var pinnedMsgs map[string]*msgext.PinnedMsgs
+	if len(conversationIDs) > 0 {
+		pinResp, err := p.msgExt.GetPinnedMsgs(ctx, &msgext.GetPinnedMsgsReq{UserID: req.UserID, ConversationIDs: conversationIDs})
+		if err != nil {
+			return nil, err
+		}
+		pinnedMsgs = pinResp.PinnedMsgs
+	}
+	res := &sortedConversationListResp{
+		ConversationTotal: resp.ConversationTotal,
+		UnreadTotal:       resp.UnreadTotal,
+		ConversationElems: make([]*sortedConversationElem, 0, len(resp.ConversationElems)),
+	}
+	for _, elem := range resp.ConversationElems {
+		res.ConversationElems = append(res.ConversationElems, &sortedConversationElem{
+			ConversationElem: elem,
+			PinnedMsgs:       pinnedMsgs[elem.ConversationID].GetPinnedMsgs(),
+		})
+	}
+	return res, nil
 }
 
 func (o *ConversationApi) GetAllConversations(c *gin.Context) {
@@ -34,7 +91,7 @@ func (o *ConversationApi) GetAllConversations(c *gin.Context) {
 }
 
 func (o *ConversationApi) GetSortedConversationList(c *gin.Context) {
-	a2r.Call(conversation.ConversationClient.GetSortedConversationList, o.Client, c)
+	a2r.Call(pinnedConversationClient.GetSortedConversationList, pinnedConversationClient{conversation: o.Client, msgExt: o.msgExtClient}, c)
 }
 
 func (o *ConversationApi) GetConversation(c *gin.Context) {
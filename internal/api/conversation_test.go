@@ -0,0 +1,68 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OpenIMSDK/protocol/conversation"
+	"google.golang.org/grpc"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/msgext"
+)
+
+type fakeConversationClient struct {
+	conversation.ConversationClient
+	elems []*conversation.ConversationElem
+}
+
+func (f *fakeConversationClient) GetSortedConversationList(ctx context.Context, req *conversation.GetSortedConversationListReq, opts ...grpc.CallOption) (*conversation.GetSortedConversationListResp, error) {
+	return &conversation.GetSortedConversationListResp{ConversationTotal: int64(len(f.elems)), ConversationElems: f.elems}, nil
+}
+
+type fakeMsgExtClient struct {
+	msgext.MsgExtClient
+	pinnedMsgs map[string]*msgext.PinnedMsgs
+}
+
+func (f *fakeMsgExtClient) GetPinnedMsgs(ctx context.Context, req *msgext.GetPinnedMsgsReq, opts ...grpc.CallOption) (*msgext.GetPinnedMsgsResp, error) {
+	return &msgext.GetPinnedMsgsResp{PinnedMsgs: f.pinnedMsgs}, nil
+}
+
+func TestSortedConversationListWithPins(t *testing.T) {
+	client := pinnedConversationClient{
+		conversation: &fakeConversationClient{elems: []*conversation.ConversationElem{
+			{ConversationID: "sg_1"},
+			{ConversationID: "si_1_2"},
+		}},
+		msgExt: &fakeMsgExtClient{pinnedMsgs: map[string]*msgext.PinnedMsgs{
+			"sg_1": {PinnedMsgs: []*msgext.PinnedMsg{{Seq: 7, PinUserID: "1"}}},
+		}},
+	}
+	resp, err := client.GetSortedConversationList(context.Background(), &conversation.GetSortedConversationListReq{UserID: "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.ConversationTotal != 2 || len(resp.ConversationElems) != 2 {
+		t.Fatalf("resp %+v", resp)
+	}
+	if pins := resp.ConversationElems[0].PinnedMsgs; len(pins) != 1 || pins[0].Seq != 7 {
+		t.Fatalf("pins of sg_1 %+v", pins)
+	}
+	if pins := resp.ConversationElems[1].PinnedMsgs; len(pins) != 0 {
+		t.Fatalf("pins of si_1_2 %+v", pins)
+	}
+}
@@ -32,6 +32,8 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/apistruct"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/msgext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 )
 
@@ -114,6 +116,110 @@ func (m *MessageApi) RevokeMsg(c *gin.Context) {
 	a2r.Call(msg.MsgClient.RevokeMsg, m.Client, c)
 }
 
+func (m *MessageApi) EditMsg(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.EditMsg, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetMsgEditHistory(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetMsgEditHistory, m.ExtClient, c)
+}
+
+func (m *MessageApi) AddMsgReaction(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.AddMsgReaction, m.ExtClient, c)
+}
+
+func (m *MessageApi) RemoveMsgReaction(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.RemoveMsgReaction, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetMsgReactionUsers(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetMsgReactionUsers, m.ExtClient, c)
+}
+
+func (m *MessageApi) SendThreadMsg(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.SendThreadMsg, m.ExtClient, c)
+}
+
+func (m *MessageApi) PullThreadMsgs(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.PullThreadMsgs, m.ExtClient, c)
+}
+
+func (m *MessageApi) ScheduleMsg(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.ScheduleMsg, m.ExtClient, c)
+}
+
+func (m *MessageApi) CancelScheduledMsg(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.CancelScheduledMsg, m.ExtClient, c)
+}
+
+func (m *MessageApi) RescheduleMsg(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.RescheduleMsg, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetScheduledMsgs(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetScheduledMsgs, m.ExtClient, c)
+}
+
+func (m *MessageApi) PinMsg(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.PinMsg, m.ExtClient, c)
+}
+
+func (m *MessageApi) UnpinMsg(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.UnpinMsg, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetPinnedMsgs(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetPinnedMsgs, m.ExtClient, c)
+}
+
+func (m *MessageApi) VotePoll(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.VotePoll, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetPollResult(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetPollResult, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetModerationRecords(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetModerationRecords, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetMsgDeliveryStatus(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetMsgDeliveryStatus, m.ExtClient, c)
+}
+
+func (m *MessageApi) SetRetentionPolicy(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.SetRetentionPolicy, m.ExtClient, c)
+}
+
+func (m *MessageApi) DeleteRetentionPolicy(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.DeleteRetentionPolicy, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetRetentionPolicies(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetRetentionPolicies, m.ExtClient, c)
+}
+
+func (m *MessageApi) RetentionDryRun(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.RetentionDryRun, m.ExtClient, c)
+}
+
+func (m *MessageApi) PlaceLegalHold(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.PlaceLegalHold, m.ExtClient, c)
+}
+
+func (m *MessageApi) ReleaseLegalHold(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.ReleaseLegalHold, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetLegalHolds(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetLegalHolds, m.ExtClient, c)
+}
+
+func (m *MessageApi) GetLegalHoldLogs(c *gin.Context) {
+	a2r.Call(msgext.MsgExtClient.GetLegalHoldLogs, m.ExtClient, c)
+}
+
 func (m *MessageApi) MarkMsgsAsRead(c *gin.Context) {
 	a2r.Call(msg.MsgClient.MarkMsgsAsRead, m.Client, c)
 }
@@ -168,6 +274,8 @@ func (m *MessageApi) getSendMsgReq(c *gin.Context, req apistruct.SendMsg) (sendM
 		data = apistruct.AtElem{}
 	case constant.Custom:
 		data = apistruct.CustomElem{}
+	case msgprocessor.Poll:
+		data = apistruct.PollElem{}
 	case constant.OANotification:
 		data = apistruct.OANotificationElem{}
 		req.SessionType = constant.NotificationChatType
@@ -371,7 +479,7 @@ func (m *MessageApi) GetActiveGroup(c *gin.Context) {
 }
 
 func (m *MessageApi) SearchMsg(c *gin.Context) {
-	a2r.Call(msg.MsgClient.SearchMessage, m.Client, c)
+	a2r.Call(msgext.MsgExtClient.SearchMsg, m.ExtClient, c)
 }
 
 func (m *MessageApi) GetServerTime(c *gin.Context) {
@@ -60,6 +60,7 @@ func NewGinRouter(discov discoveryregistry.SvcDiscoveryRegistry, rdb redis.Unive
 	conversationRpc := rpcclient.NewConversation(discov)
 	authRpc := rpcclient.NewAuth(discov)
 	thirdRpc := rpcclient.NewThird(discov)
+	pushRpc := rpcclient.NewPush(discov)
 
 	u := NewUserApi(*userRpc)
 	m := NewMessageApi(messageRpc, userRpc)
@@ -89,6 +90,9 @@ func NewGinRouter(discov discoveryregistry.SvcDiscoveryRegistry, rdb redis.Unive
 		userRouterGroup.POST("/add_notification_account", ParseToken, u.AddNotificationAccount)
 		userRouterGroup.POST("/update_notification_account", ParseToken, u.UpdateNotificationAccountInfo)
 		userRouterGroup.POST("/search_notification_account", ParseToken, u.SearchNotificationAccount)
+		userRouterGroup.POST("/set_notification_preference", ParseToken, u.SetNotificationPreference)
+		userRouterGroup.POST("/get_notification_preference", ParseToken, u.GetNotificationPreference)
+		userRouterGroup.POST("/set_user_locale", ParseToken, u.SetUserLocale)
 	}
 	// friend routing group
 	friendRouterGroup := r.Group("/friend", ParseToken)
@@ -160,6 +164,7 @@ func NewGinRouter(discov discoveryregistry.SvcDiscoveryRegistry, rdb redis.Unive
 		thirdGroup.GET("/prometheus", GetPrometheus)
 		t := NewThirdApi(*thirdRpc)
 		thirdGroup.POST("/fcm_update_token", t.FcmUpdateToken)
+		thirdGroup.POST("/apns_update_token", t.ApnsUpdateToken)
 		thirdGroup.POST("/set_app_badge", t.SetAppBadge)
 
 		logs := thirdGroup.Group("/logs")
@@ -179,6 +184,13 @@ func NewGinRouter(discov discoveryregistry.SvcDiscoveryRegistry, rdb redis.Unive
 		objectGroup.POST("/complete_form_data", t.CompleteFormData)
 		objectGroup.GET("/*name", t.ObjectRedirect)
 	}
+	// Push
+	pushGroup := r.Group("/push", ParseToken)
+	{
+		p := NewPushApi(*pushRpc)
+		pushGroup.POST("/get_offline_push_dead_letters", p.GetOfflinePushDeadLetters)
+		pushGroup.POST("/replay_offline_push_dead_letters", p.ReplayOfflinePushDeadLetters)
+	}
 	// Message
 	msgGroup := r.Group("/msg", ParseToken)
 	{
@@ -188,6 +200,32 @@ func NewGinRouter(discov discoveryregistry.SvcDiscoveryRegistry, rdb redis.Unive
 		msgGroup.POST("/send_business_notification", m.SendBusinessNotification)
 		msgGroup.POST("/pull_msg_by_seq", m.PullMsgBySeqs)
 		msgGroup.POST("/revoke_msg", m.RevokeMsg)
+		msgGroup.POST("/edit_msg", m.EditMsg)
+		msgGroup.POST("/get_msg_edit_history", m.GetMsgEditHistory)
+		msgGroup.POST("/add_msg_reaction", m.AddMsgReaction)
+		msgGroup.POST("/remove_msg_reaction", m.RemoveMsgReaction)
+		msgGroup.POST("/get_msg_reaction_users", m.GetMsgReactionUsers)
+		msgGroup.POST("/send_thread_msg", m.SendThreadMsg)
+		msgGroup.POST("/pull_thread_msgs", m.PullThreadMsgs)
+		msgGroup.POST("/schedule_msg", m.ScheduleMsg)
+		msgGroup.POST("/cancel_scheduled_msg", m.CancelScheduledMsg)
+		msgGroup.POST("/reschedule_msg", m.RescheduleMsg)
+		msgGroup.POST("/get_scheduled_msgs", m.GetScheduledMsgs)
+		msgGroup.POST("/pin_msg", m.PinMsg)
+		msgGroup.POST("/unpin_msg", m.UnpinMsg)
+		msgGroup.POST("/get_pinned_msgs", m.GetPinnedMsgs)
+		msgGroup.POST("/vote_poll", m.VotePoll)
+		msgGroup.POST("/get_poll_result", m.GetPollResult)
+		msgGroup.POST("/get_moderation_records", m.GetModerationRecords)
+		msgGroup.POST("/get_msg_delivery_status", m.GetMsgDeliveryStatus)
+		msgGroup.POST("/set_retention_policy", m.SetRetentionPolicy)
+		msgGroup.POST("/delete_retention_policy", m.DeleteRetentionPolicy)
+		msgGroup.POST("/get_retention_policies", m.GetRetentionPolicies)
+		msgGroup.POST("/retention_dry_run", m.RetentionDryRun)
+		msgGroup.POST("/place_legal_hold", m.PlaceLegalHold)
+		msgGroup.POST("/release_legal_hold", m.ReleaseLegalHold)
+		msgGroup.POST("/get_legal_holds", m.GetLegalHolds)
+		msgGroup.POST("/get_legal_hold_logs", m.GetLegalHoldLogs)
 		msgGroup.POST("/mark_msgs_as_read", m.MarkMsgsAsRead)
 		msgGroup.POST("/mark_conversation_as_read", m.MarkConversationAsRead)
 		msgGroup.POST("/get_conversations_has_read_and_max_seq", m.GetConversationsHasReadAndMaxSeq)
@@ -206,7 +244,7 @@ func NewGinRouter(discov discoveryregistry.SvcDiscoveryRegistry, rdb redis.Unive
 	// Conversation
 	conversationGroup := r.Group("/conversation", ParseToken)
 	{
-		c := NewConversationApi(*conversationRpc)
+		c := NewConversationApi(*conversationRpc, messageRpc)
 		conversationGroup.POST("/get_sorted_conversation_list", c.GetSortedConversationList)
 		conversationGroup.POST("/get_all_conversations", c.GetAllConversations)
 		conversationGroup.POST("/get_conversation", c.GetConversation)
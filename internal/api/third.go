@@ -28,6 +28,7 @@ import (
 	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/mcontext"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/thirdext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 )
 
@@ -41,6 +42,10 @@ func (o *ThirdApi) FcmUpdateToken(c *gin.Context) {
 	a2r.Call(third.ThirdClient.FcmUpdateToken, o.Client, c)
 }
 
+func (o *ThirdApi) ApnsUpdateToken(c *gin.Context) {
+	a2r.Call(thirdext.ThirdExtClient.ApnsUpdateToken, o.ExtClient, c)
+}
+
 func (o *ThirdApi) SetAppBadge(c *gin.Context) {
 	a2r.Call(third.ThirdClient.SetAppBadge, o.Client, c)
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/userext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 )
 
@@ -237,3 +238,15 @@ func (u *UserApi) UpdateNotificationAccountInfo(c *gin.Context) {
 func (u *UserApi) SearchNotificationAccount(c *gin.Context) {
 	a2r.Call(user.UserClient.SearchNotificationAccount, u.Client, c)
 }
+
+func (u *UserApi) SetNotificationPreference(c *gin.Context) {
+	a2r.Call(userext.UserExtClient.SetNotificationPreference, u.ExtClient, c)
+}
+
+func (u *UserApi) GetNotificationPreference(c *gin.Context) {
+	a2r.Call(userext.UserExtClient.GetNotificationPreference, u.ExtClient, c)
+}
+
+func (u *UserApi) SetUserLocale(c *gin.Context) {
+	a2r.Call(userext.UserExtClient.SetUserLocale, u.ExtClient, c)
+}
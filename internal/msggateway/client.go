@@ -16,12 +16,16 @@ package msggateway
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"runtime/debug"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 
 	"google.golang.org/protobuf/proto"
@@ -32,6 +36,7 @@ import (
 	"github.com/OpenIMSDK/tools/log"
 	"github.com/OpenIMSDK/tools/mcontext"
 	"github.com/OpenIMSDK/tools/utils"
+	"github.com/gorilla/websocket"
 )
 
 var (
@@ -67,6 +72,7 @@ type Client struct {
 	conn           LongConn
 	PlatformID     int    `json:"platformID"`
 	IsCompress     bool   `json:"isCompress"`
+	Encoding       string `json:"encoding"`
 	UserID         string `json:"userID"`
 	IsBackground   bool   `json:"isBackground"`
 	ctx            *UserConnContext
@@ -74,6 +80,16 @@ type Client struct {
 	closed         atomic.Bool
 	closedErr      error
 	token          string
+	encoder        Encoder
+	heartbeat      *heartbeatPolicy
+	idleTimeout    time.Duration
+	// done is closed with the connection, stopping its keepAlive
+	done          chan struct{}
+	sessionResume bool
+	resumeToken   string
+	session       atomic.Pointer[resumeSession]
+	// resumable is cleared when the connection is kicked or left on purpose, so its session is not parked
+	resumable atomic.Bool
 }
 
 func newClient(ctx *UserConnContext, conn LongConn, isCompress bool) *Client {
@@ -92,13 +108,19 @@ func (c *Client) ResetClient(
 	ctx *UserConnContext,
 	conn LongConn,
 	isBackground, isCompress bool,
+	encoding string,
 	longConnServer LongConnServer,
 	token string,
+	heartbeat *heartbeatPolicy,
+	sessionResume bool,
+	resumeToken string,
 ) {
 	c.w = new(sync.Mutex)
 	c.conn = conn
 	c.PlatformID = utils.StringToInt(ctx.GetPlatformID())
 	c.IsCompress = isCompress
+	c.Encoding = encoding
+	c.encoder, _ = GetEncoder(encoding)
 	c.IsBackground = isBackground
 	c.UserID = ctx.GetUserID()
 	c.ctx = ctx
@@ -107,14 +129,58 @@ func (c *Client) ResetClient(
 	c.closed.Store(false)
 	c.closedErr = nil
 	c.token = token
+	c.heartbeat = heartbeat
+	c.idleTimeout = heartbeat.IdleTimeout(c.PlatformID)
+	c.done = make(chan struct{})
+	c.sessionResume = sessionResume
+	c.resumeToken = resumeToken
+	c.session.Store(nil)
+	c.resumable.Store(true)
 }
 
 // pingHandler handles ping messages and sends pong responses.
 func (c *Client) pingHandler(_ string) error {
-	_ = c.conn.SetReadDeadline(pongWait)
+	_ = c.conn.SetReadDeadline(c.idleTimeout)
 	return c.writePongMsg()
 }
 
+// pongHandler handles the pongs answering keepAlive pings and records their round trip time.
+func (c *Client) pongHandler(payload string) error {
+	_ = c.conn.SetReadDeadline(c.idleTimeout)
+	if rtt, ok := pingRTT(payload, time.Now()); ok {
+		prommetrics.HeartbeatRTTHistogram.WithLabelValues(constant.PlatformIDToName(c.PlatformID)).Observe(rtt.Seconds())
+	}
+	return nil
+}
+
+// keepAlive returns the loop pinging the connection the client holds now every ping interval of the heartbeat
+// policy until it is closed, nil when pings are off. The client goes back to the pool and is reset for another
+// connection once unregistered, so the loop never touches the client: a ping that can not be written closes
+// its own connection, and readMessage then fails and unregisters the client.
+func (c *Client) keepAlive() func() {
+	interval := c.heartbeat.PingInterval()
+	if interval <= 0 {
+		return nil
+	}
+	ctx, conn, w, done := c.ctx, c.conn, c.w, c.done
+	return func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := writePingMsg(conn, w, done); err != nil {
+					log.ZWarn(ctx, "writePingMsg failed, evict the connection", err)
+					_ = conn.Close()
+					return
+				}
+			}
+		}
+	}
+}
+
 // readMessage continuously reads messages from the connection.
 func (c *Client) readMessage() {
 	defer func() {
@@ -126,12 +192,21 @@ func (c *Client) readMessage() {
 	}()
 
 	c.conn.SetReadLimit(maxMessageSize)
-	_ = c.conn.SetReadDeadline(pongWait)
+	_ = c.conn.SetReadDeadline(c.idleTimeout)
 	c.conn.SetPingHandler(c.pingHandler)
+	c.conn.SetPongHandler(c.pongHandler)
 
 	for {
 		messageType, message, returnErr := c.conn.ReadMessage()
 		if returnErr != nil {
+			var netErr net.Error
+			if errors.As(returnErr, &netErr) && netErr.Timeout() {
+				// nothing arrived within the idle timeout, the connection is most likely half-open
+				prommetrics.HeartbeatTimeoutCounter.Inc()
+			}
+			if websocket.IsCloseError(returnErr, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				c.resumable.Store(false)
+			}
 			log.ZWarn(c.ctx, "readMessage", returnErr, "messageType", messageType)
 			c.closedErr = returnErr
 			return
@@ -145,15 +220,24 @@ func (c *Client) readMessage() {
 
 		switch messageType {
 		case MessageBinary:
-			_ = c.conn.SetReadDeadline(pongWait)
+			_ = c.conn.SetReadDeadline(c.idleTimeout)
 			parseDataErr := c.handleMessage(message)
 			if parseDataErr != nil {
 				c.closedErr = parseDataErr
 				return
 			}
 		case MessageText:
-			c.closedErr = ErrNotSupportMessageProtocol
-			return
+			// only uncompressed json can be sent as text
+			if c.Encoding != JsonEncoding || c.IsCompress {
+				c.closedErr = ErrNotSupportMessageProtocol
+				return
+			}
+			_ = c.conn.SetReadDeadline(c.idleTimeout)
+			parseDataErr := c.handleMessage(message)
+			if parseDataErr != nil {
+				c.closedErr = parseDataErr
+				return
+			}
 
 		case PingMessage:
 			err := c.writePongMsg()
@@ -180,7 +264,7 @@ func (c *Client) handleMessage(message []byte) error {
 	var binaryReq = getReq()
 	defer freeReq(binaryReq)
 
-	err := c.longConnServer.Decode(message, binaryReq)
+	err := c.encoder.Decode(message, binaryReq)
 	if err != nil {
 		return utils.Wrap(err, "")
 	}
@@ -217,6 +301,8 @@ func (c *Client) handleMessage(message []byte) error {
 		resp, messageErr = c.longConnServer.UserLogout(ctx, binaryReq)
 	case WsSetBackgroundStatus:
 		resp, messageErr = c.setAppBackgroundStatus(ctx, binaryReq)
+	case WsPingMsg:
+		return c.replyPing(ctx, binaryReq)
 	default:
 		return fmt.Errorf(
 			"ReqIdentifier failed,sendID:%s,msgIncr:%s,reqIdentifier:%d",
@@ -247,8 +333,13 @@ func (c *Client) close() {
 
 	c.w.Lock()
 	defer c.w.Unlock()
+	// readMessage and writeBinaryMsg may both get here
+	if c.closed.Load() {
+		return
+	}
 
 	c.closed.Store(true)
+	close(c.done)
 	c.conn.Close()
 	c.longConnServer.UnRegister(c)
 }
@@ -270,12 +361,69 @@ func (c *Client) replyMessage(ctx context.Context, binaryReq *Req, err error, re
 	}
 
 	if binaryReq.ReqIdentifier == WsLogoutMsg {
+		c.resumable.Store(false)
 		return errors.New("user logout")
 	}
 	return nil
 }
 
+// replyPing answers an application level ping with a pong echoing its data,
+// for clients such as browsers that can not send websocket control pings.
+func (c *Client) replyPing(ctx context.Context, binaryReq *Req) error {
+	pong := Resp{
+		ReqIdentifier: WsPongMsg,
+		MsgIncr:       binaryReq.MsgIncr,
+		OperationID:   binaryReq.OperationID,
+		Data:          binaryReq.Data,
+	}
+	if err := c.writeBinaryMsg(pong); err != nil {
+		log.ZWarn(ctx, "wireBinaryMsg replyPing", err)
+	}
+	return nil
+}
+
 func (c *Client) PushMessage(ctx context.Context, msgData *sdkws.MsgData) error {
+	resp, err := newPushResp(ctx, msgData)
+	if err != nil {
+		return err
+	}
+	err = c.writeBinaryMsg(resp)
+	// only pushes that did not reach the connection are kept for the client to get when it resumes,
+	// a connection closed meanwhile may have sent it already and the client then gets it twice
+	if err != nil || c.closed.Load() {
+		if session := c.session.Load(); session != nil {
+			session.buffer(resp, time.Now())
+		}
+	}
+	return err
+}
+
+// sendSession tells the client its resumable session and replays the pushes it missed.
+func (c *Client) sendSession(info SessionInfo, replay []Resp) {
+	data, err := json.Marshal(info)
+	if err != nil {
+		log.ZWarn(c.ctx, "marshal session info failed", err)
+		return
+	}
+	if err := c.writeBinaryMsg(Resp{ReqIdentifier: WsSessionMsg, Data: data}); err != nil {
+		log.ZWarn(c.ctx, "wireBinaryMsg sendSession", err)
+		return
+	}
+	for i, resp := range replay {
+		if err := c.writeBinaryMsg(resp); err != nil {
+			log.ZWarn(c.ctx, "wireBinaryMsg replay push", err)
+			if session := c.session.Load(); session != nil {
+				session.requeue(replay[i:], time.Now())
+			}
+			return
+		}
+	}
+	if len(replay) > 0 {
+		log.ZInfo(c.ctx, "session resumed, missed pushes replayed", "num", len(replay))
+	}
+}
+
+func newPushResp(ctx context.Context, msgData *sdkws.MsgData) (Resp, error) {
 	var msg sdkws.PushMessages
 	conversationID := msgprocessor.GetConversationIDByMsg(msgData)
 	m := map[string]*sdkws.PullMsgs{conversationID: {Msgs: []*sdkws.MsgData{msgData}}}
@@ -287,17 +435,17 @@ func (c *Client) PushMessage(ctx context.Context, msgData *sdkws.MsgData) error
 	log.ZDebug(ctx, "PushMessage", "msg", &msg)
 	data, err := proto.Marshal(&msg)
 	if err != nil {
-		return err
+		return Resp{}, err
 	}
-	resp := Resp{
+	return Resp{
 		ReqIdentifier: WSPushMsg,
 		OperationID:   mcontext.GetOperationID(ctx),
 		Data:          data,
-	}
-	return c.writeBinaryMsg(resp)
+	}, nil
 }
 
 func (c *Client) KickOnlineMessage() error {
+	c.resumable.Store(false)
 	resp := Resp{
 		ReqIdentifier: WSKickOnlineMsg,
 	}
@@ -311,7 +459,7 @@ func (c *Client) writeBinaryMsg(resp Resp) error {
 		return nil
 	}
 
-	encodedBuf, err := c.longConnServer.Encode(resp)
+	encodedBuf, err := c.encoder.Encode(resp)
 	if err != nil {
 		return utils.Wrap(err, "")
 	}
@@ -327,7 +475,9 @@ func (c *Client) writeBinaryMsg(resp Resp) error {
 		}
 		return c.conn.WriteMessage(MessageBinary, resultBuf)
 	}
-
+	if c.Encoding == JsonEncoding {
+		return c.conn.WriteMessage(MessageText, encodedBuf)
+	}
 	return c.conn.WriteMessage(MessageBinary, encodedBuf)
 }
 
@@ -346,3 +496,22 @@ func (c *Client) writePongMsg() error {
 
 	return c.conn.WriteMessage(PongMessage, nil)
 }
+
+// writePingMsg pings conn unless done is closed, w is the write lock of the client conn belongs to.
+func writePingMsg(conn LongConn, w *sync.Mutex, done <-chan struct{}) error {
+	w.Lock()
+	defer w.Unlock()
+	// close closes done holding w
+	select {
+	case <-done:
+		return nil
+	default:
+	}
+
+	err := conn.SetWriteDeadline(writeWait)
+	if err != nil {
+		return utils.Wrap(err, "")
+	}
+
+	return conn.WriteMessage(PingMessage, []byte(pingPayload(time.Now())))
+}
@@ -25,8 +25,14 @@ const (
 	OperationID             = "operationID"
 	Compression             = "compression"
 	GzipCompressionProtocol = "gzip"
+	Encoding                = "encoding"
+	GobEncoding             = "gob"
+	JsonEncoding            = "json"
+	ProtobufEncoding        = "protobuf"
 	BackgroundStatus        = "isBackground"
 	MsgResp                 = "isMsgResp"
+	SessionResume           = "sessionResume"
+	ResumeToken             = "resumeToken"
 )
 
 const (
@@ -43,6 +49,9 @@ const (
 	WSKickOnlineMsg       = 2002
 	WsLogoutMsg           = 2003
 	WsSetBackgroundStatus = 2004
+	WsPingMsg             = 2005
+	WsPongMsg             = 2006
+	WsSessionMsg          = 2007
 	WSDataError           = 3001
 )
 
@@ -50,7 +59,7 @@ const (
 	// Time allowed to write a message to the peer.
 	writeWait = 10 * time.Second
 
-	// Time allowed to read the next pong message from the peer.
+	// Time allowed to read the next pong message from the peer, when the heartbeat policy sets no idle timeout.
 	pongWait = 30 * time.Second
 
 	// Maximum message size allowed from peer.
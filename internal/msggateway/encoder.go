@@ -17,8 +17,13 @@ package msggateway
 import (
 	"bytes"
 	"encoding/gob"
+	"encoding/json"
+	"fmt"
 
 	"github.com/OpenIMSDK/tools/utils"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/gateway"
 )
 
 type Encoder interface {
@@ -51,3 +56,120 @@ func (g *GobEncoder) Decode(encodeData []byte, decodeData any) error {
 	}
 	return nil
 }
+
+type JsonEncoder struct{}
+
+func NewJsonEncoder() *JsonEncoder {
+	return &JsonEncoder{}
+}
+
+func (j *JsonEncoder) Encode(data any) ([]byte, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	return b, nil
+}
+
+func (j *JsonEncoder) Decode(encodeData []byte, decodeData any) error {
+	if err := json.Unmarshal(encodeData, decodeData); err != nil {
+		return utils.Wrap(err, "")
+	}
+	return nil
+}
+
+// ProtobufEncoder frames Req and Resp as the messages in pkg/proto/gateway.
+type ProtobufEncoder struct{}
+
+func NewProtobufEncoder() *ProtobufEncoder {
+	return &ProtobufEncoder{}
+}
+
+func (p *ProtobufEncoder) Encode(data any) ([]byte, error) {
+	var msg proto.Message
+	switch v := data.(type) {
+	case Resp:
+		msg = respToPb(&v)
+	case *Resp:
+		msg = respToPb(v)
+	case Req:
+		msg = reqToPb(&v)
+	case *Req:
+		msg = reqToPb(v)
+	default:
+		return nil, fmt.Errorf("protobuf encoder not support type %T", data)
+	}
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	return b, nil
+}
+
+func (p *ProtobufEncoder) Decode(encodeData []byte, decodeData any) error {
+	switch v := decodeData.(type) {
+	case *Req:
+		var req gateway.Req
+		if err := proto.Unmarshal(encodeData, &req); err != nil {
+			return utils.Wrap(err, "")
+		}
+		*v = Req{
+			ReqIdentifier: req.ReqIdentifier,
+			Token:         req.Token,
+			SendID:        req.SendID,
+			OperationID:   req.OperationID,
+			MsgIncr:       req.MsgIncr,
+			Data:          req.Data,
+		}
+	case *Resp:
+		var resp gateway.Resp
+		if err := proto.Unmarshal(encodeData, &resp); err != nil {
+			return utils.Wrap(err, "")
+		}
+		*v = Resp{
+			ReqIdentifier: resp.ReqIdentifier,
+			MsgIncr:       resp.MsgIncr,
+			OperationID:   resp.OperationID,
+			ErrCode:       int(resp.ErrCode),
+			ErrMsg:        resp.ErrMsg,
+			Data:          resp.Data,
+		}
+	default:
+		return fmt.Errorf("protobuf encoder not support type %T", decodeData)
+	}
+	return nil
+}
+
+func reqToPb(req *Req) *gateway.Req {
+	return &gateway.Req{
+		ReqIdentifier: req.ReqIdentifier,
+		Token:         req.Token,
+		SendID:        req.SendID,
+		OperationID:   req.OperationID,
+		MsgIncr:       req.MsgIncr,
+		Data:          req.Data,
+	}
+}
+
+func respToPb(resp *Resp) *gateway.Resp {
+	return &gateway.Resp{
+		ReqIdentifier: resp.ReqIdentifier,
+		MsgIncr:       resp.MsgIncr,
+		OperationID:   resp.OperationID,
+		ErrCode:       int32(resp.ErrCode),
+		ErrMsg:        resp.ErrMsg,
+		Data:          resp.Data,
+	}
+}
+
+var encoders = map[string]Encoder{
+	GobEncoding:      NewGobEncoder(),
+	JsonEncoding:     NewJsonEncoder(),
+	ProtobufEncoding: NewProtobufEncoder(),
+}
+
+// GetEncoder returns the encoder of an encoding a client can ask for on connect.
+func GetEncoder(encoding string) (Encoder, bool) {
+	encoder, ok := encoders[encoding]
+	return encoder, ok
+}
@@ -0,0 +1,90 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/constant"
+)
+
+// heartbeatPolicy decides how often the gateway pings a connection and how long a connection may stay silent
+// before it is taken for half-open and evicted.
+type heartbeatPolicy struct {
+	// pingInterval is how often control pings are sent, zero sends none
+	pingInterval time.Duration
+	idleTimeout  time.Duration
+	// platformIdleTimeouts overrides idleTimeout by platform id
+	platformIdleTimeouts map[int]time.Duration
+}
+
+// newHeartbeatPolicy takes seconds, platformIdleTimeouts is keyed by platform name and unknown names are ignored.
+func newHeartbeatPolicy(pingInterval, idleTimeout int, platformIdleTimeouts map[string]int) *heartbeatPolicy {
+	h := &heartbeatPolicy{
+		pingInterval:         time.Duration(pingInterval) * time.Second,
+		idleTimeout:          time.Duration(idleTimeout) * time.Second,
+		platformIdleTimeouts: make(map[int]time.Duration),
+	}
+	if h.pingInterval < 0 {
+		h.pingInterval = 0
+	}
+	if h.idleTimeout <= 0 {
+		h.idleTimeout = pongWait
+	}
+	for name, timeout := range platformIdleTimeouts {
+		if platformID := constant.PlatformNameToID(name); platformID != 0 && timeout > 0 {
+			h.platformIdleTimeouts[platformID] = time.Duration(timeout) * time.Second
+		}
+	}
+	return h
+}
+
+// IdleTimeout is how long a connection of platformID may go without sending anything, pongs included.
+func (h *heartbeatPolicy) IdleTimeout(platformID int) time.Duration {
+	if h == nil {
+		return pongWait
+	}
+	if timeout, ok := h.platformIdleTimeouts[platformID]; ok {
+		return timeout
+	}
+	return h.idleTimeout
+}
+
+// PingInterval is how often the gateway pings a connection, zero when it does not.
+func (h *heartbeatPolicy) PingInterval() time.Duration {
+	if h == nil {
+		return 0
+	}
+	return h.pingInterval
+}
+
+// pingPayload carries the send time of a ping so the pong answering it gives the round trip time.
+func pingPayload(now time.Time) string {
+	return strconv.FormatInt(now.UnixNano(), 10)
+}
+
+// pingRTT is the round trip time of the ping whose pong carried payload, false for a pong the gateway did not ask for.
+func pingRTT(payload string, now time.Time) (time.Duration, bool) {
+	sent, err := strconv.ParseInt(payload, 10, 64)
+	if err != nil || sent <= 0 {
+		return 0, false
+	}
+	rtt := now.Sub(time.Unix(0, sent))
+	if rtt < 0 {
+		return 0, false
+	}
+	return rtt, true
+}
@@ -0,0 +1,96 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeartbeatPolicyIdleTimeout(t *testing.T) {
+	h := newHeartbeatPolicy(10, 30, map[string]int{constant.WebPlatformStr: 90, "unknown": 5})
+	assert.Equal(t, 10*time.Second, h.PingInterval())
+	assert.Equal(t, 90*time.Second, h.IdleTimeout(constant.WebPlatformID))
+	assert.Equal(t, 30*time.Second, h.IdleTimeout(constant.IOSPlatformID))
+
+	h = newHeartbeatPolicy(0, 0, nil)
+	assert.Equal(t, time.Duration(0), h.PingInterval())
+	assert.Equal(t, pongWait, h.IdleTimeout(constant.WebPlatformID))
+}
+
+func TestPingRTT(t *testing.T) {
+	now := time.Now()
+	rtt, ok := pingRTT(pingPayload(now.Add(-50*time.Millisecond)), now)
+	assert.True(t, ok)
+	assert.Equal(t, 50*time.Millisecond, rtt)
+
+	_, ok = pingRTT("", now)
+	assert.False(t, ok)
+	_, ok = pingRTT(pingPayload(now.Add(time.Second)), now)
+	assert.False(t, ok)
+}
+
+type fakePingConn struct {
+	LongConn
+	writeErr error
+	pings    atomic.Int32
+	closed   atomic.Bool
+}
+
+func (f *fakePingConn) SetWriteDeadline(time.Duration) error { return nil }
+
+func (f *fakePingConn) WriteMessage(messageType int, _ []byte) error {
+	if messageType == PingMessage {
+		f.pings.Add(1)
+	}
+	return f.writeErr
+}
+
+func (f *fakePingConn) Close() error {
+	f.closed.Store(true)
+	return nil
+}
+
+func TestKeepAliveStaysOnItsConn(t *testing.T) {
+	old := &fakePingConn{writeErr: errors.New("broken pipe")}
+	c := &Client{
+		ctx:       &UserConnContext{},
+		conn:      old,
+		w:         new(sync.Mutex),
+		done:      make(chan struct{}),
+		heartbeat: &heartbeatPolicy{pingInterval: time.Millisecond},
+	}
+	keepAlive := c.keepAlive()
+	// the pooled client is reset for another connection before the old loop runs
+	reused := &fakePingConn{}
+	c.conn, c.w, c.done = reused, new(sync.Mutex), make(chan struct{})
+	keepAlive()
+	assert.True(t, old.closed.Load())
+	assert.Equal(t, int32(1), old.pings.Load())
+	assert.False(t, reused.closed.Load())
+	assert.Equal(t, int32(0), reused.pings.Load())
+
+	// a closed connection is no longer pinged
+	conn, done := &fakePingConn{}, make(chan struct{})
+	close(done)
+	assert.NoError(t, writePingMsg(conn, new(sync.Mutex), done))
+	assert.Equal(t, int32(0), conn.pings.Load())
+}
@@ -42,6 +42,7 @@ func (s *Server) InitServer(disCov discoveryregistry.SvcDiscoveryRegistry, serve
 	msgModel := cache.NewMsgCacheModel(rdb)
 	s.LongConnServer.SetDiscoveryRegistry(disCov)
 	s.LongConnServer.SetCacheHandler(msgModel)
+	s.LongConnServer.SetGatewayRouteCache(cache.NewGatewayRouteCache(rdb))
 	msggateway.RegisterMsgGatewayServer(server, s)
 	return nil
 }
@@ -176,6 +177,7 @@ func (s *Server) SuperGroupOnlineBatchPushOneMsg(
 		results.Resp = resp
 		singleUserResults = append(singleUserResults, results)
 	}
+	s.LongConnServer.BufferPush(ctx, req.PushToUserIDs, req.MsgData)
 
 	return &msggateway.OnlineBatchPushOneMsgResp{
 		SinglePushResult: singleUserResults,
@@ -40,6 +40,16 @@ func RunWsAndServer(rpcPort, wsPort, prometheusPort int) error {
 		WithHandshakeTimeout(time.Duration(config.Config.LongConnSvr.WebsocketTimeout)*time.Second),
 		WithMessageMaxMsgLength(config.Config.LongConnSvr.WebsocketMaxMsgLen),
 		WithWriteBufferSize(config.Config.LongConnSvr.WebsocketWriteBufferSize),
+		WithHeartbeat(
+			config.Config.LongConnSvr.Heartbeat.PingInterval,
+			config.Config.LongConnSvr.Heartbeat.IdleTimeout,
+			config.Config.LongConnSvr.Heartbeat.PlatformIdleTimeout,
+		),
+		WithSessionResume(
+			config.Config.LongConnSvr.SessionResume.Enable,
+			config.Config.LongConnSvr.SessionResume.Window,
+			config.Config.LongConnSvr.SessionResume.BufferSize,
+		),
 	)
 	if err != nil {
 		return err
@@ -36,6 +36,7 @@ import (
 
 	"github.com/OpenIMSDK/protocol/constant"
 	"github.com/OpenIMSDK/protocol/msggateway"
+	"github.com/OpenIMSDK/protocol/sdkws"
 	"github.com/OpenIMSDK/tools/discoveryregistry"
 	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/log"
@@ -56,7 +57,10 @@ type LongConnServer interface {
 	Validate(s any) error
 	SetCacheHandler(cache cache.MsgModel)
 	SetDiscoveryRegistry(client discoveryregistry.SvcDiscoveryRegistry)
+	SetGatewayRouteCache(routeCache cache.GatewayRouteCache)
 	KickUserConn(client *Client) error
+	// BufferPush keeps a push for the users whose session is waiting to be resumed.
+	BufferPush(ctx context.Context, userIDs []string, msgData *sdkws.MsgData)
 	UnRegister(c *Client)
 	SetKickHandlerInfo(i *kickHandler)
 	Compressor
@@ -82,10 +86,13 @@ type WsServer struct {
 	onlineUserConnNum atomic.Int64
 	handshakeTimeout  time.Duration
 	writeBufferSize   int
+	heartbeat         *heartbeatPolicy
+	sessions          *sessionStore
 	validate          *validator.Validate
 	cache             cache.MsgModel
 	userClient        *rpcclient.UserRpcClient
 	disCov            discoveryregistry.SvcDiscoveryRegistry
+	route             *gatewayRoute
 	Compressor
 	Encoder
 	MessageHandler
@@ -154,6 +161,8 @@ func NewWsServer(opts ...Option) (*WsServer, error) {
 		wsMaxConnNum:     config.maxConnNum,
 		writeBufferSize:  config.writeBufferSize,
 		handshakeTimeout: config.handshakeTimeout,
+		heartbeat:        config.heartbeat,
+		sessions:         config.sessions,
 		clientPool: sync.Pool{
 			New: func() any {
 				return new(Client)
@@ -176,7 +185,14 @@ func (ws *WsServer) Run() error {
 
 		sigs = make(chan os.Signal, 1)
 		done = make(chan struct{}, 1)
+
+		sweep <-chan time.Time
 	)
+	if ws.sessions != nil {
+		ticker := time.NewTicker(sessionSweepInterval)
+		defer ticker.Stop()
+		sweep = ticker.C
+	}
 
 	server := http.Server{Addr: ":" + utils.IntToString(ws.port), Handler: nil}
 
@@ -192,6 +208,8 @@ func (ws *WsServer) Run() error {
 				ws.unregisterClient(client)
 			case onlineInfo := <-ws.kickHandlerChan:
 				ws.multiTerminalLoginChecker(onlineInfo.clientOK, onlineInfo.oldClients, onlineInfo.newClient)
+			case <-sweep:
+				ws.sweepSessions()
 			}
 		}
 	})
@@ -290,6 +308,8 @@ func (ws *WsServer) registerClient(client *Client) {
 			ws.onlineUserConnNum.Add(1)
 		}
 	}
+	ws.publishUserRoute(client.ctx, client.UserID)
+	ws.attachSession(client)
 
 	wg := sync.WaitGroup{}
 	if config.Config.Envs.Discovery == "zookeeper" {
@@ -330,7 +350,9 @@ func getRemoteAdders(client []*Client) string {
 }
 
 func (ws *WsServer) KickUserConn(client *Client) error {
-	ws.clients.deleteClients(client.UserID, []*Client{client})
+	if ws.clients.deleteClients(client.UserID, []*Client{client}) {
+		ws.withdrawUserRoute(client.ctx, client.UserID)
+	}
 	return client.KickOnlineMessage()
 }
 
@@ -410,10 +432,15 @@ func (ws *WsServer) multiTerminalLoginChecker(clientOK bool, oldClients []*Clien
 
 func (ws *WsServer) unregisterClient(client *Client) {
 	defer ws.clientPool.Put(client)
+	parked := ws.parkSession(client)
 	isDeleteUser := ws.clients.delete(client.UserID, client.ctx.GetRemoteAddr())
 	if isDeleteUser {
 		ws.onlineUserNum.Add(-1)
 		prommetrics.OnlineUserGauge.Dec()
+		// a parked session keeps the route until it expires, so its pushes still come here
+		if !parked {
+			ws.withdrawUserRoute(client.ctx, client.UserID)
+		}
 	}
 	ws.onlineUserConnNum.Add(-1)
 	ws.SetUserOnlineStatus(client.ctx, client, constant.Offline)
@@ -456,6 +483,14 @@ func (ws *WsServer) ParseWSArgs(r *http.Request) (args *WSArgs, err error) {
 	if r.Header.Get(Compression) == GzipCompressionProtocol {
 		v.Compression = true
 	}
+	if v.Encoding = query.Get(Encoding); v.Encoding == "" {
+		v.Encoding = GobEncoding
+	}
+	if _, ok := GetEncoder(v.Encoding); !ok {
+		return nil, errs.ErrConnArgsErr.Wrap("encoding not supported: " + v.Encoding)
+	}
+	v.SessionResume, _ = strconv.ParseBool(query.Get(SessionResume))
+	v.ResumeToken = query.Get(ResumeToken)
 	m, err := ws.cache.GetTokensWithoutError(context.Background(), v.UserID, platformID)
 	if err != nil {
 		return nil, err
@@ -479,7 +514,12 @@ type WSArgs struct {
 	UserID      string
 	PlatformID  int
 	Compression bool
+	Encoding    string
 	MsgResp     bool
+	// SessionResume asks for a session that can be resumed after a disconnect
+	SessionResume bool
+	// ResumeToken resumes the session it was issued for
+	ResumeToken string
 }
 
 func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
@@ -517,7 +557,12 @@ func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 	client := ws.clientPool.Get().(*Client)
-	client.ResetClient(connContext, wsLongConn, connContext.GetBackground(), args.Compression, ws, args.Token)
+	client.ResetClient(connContext, wsLongConn, connContext.GetBackground(), args.Compression, args.Encoding, ws, args.Token, ws.heartbeat, args.SessionResume, args.ResumeToken)
+	// bound before the client is registered, it may be unregistered and reset for another connection at any time after
+	keepAlive := client.keepAlive()
 	ws.registerChan <- client
 	go client.readMessage()
+	if keepAlive != nil {
+		go keepAlive()
+	}
 }
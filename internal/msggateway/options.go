@@ -29,6 +29,10 @@ type (
 		messageMaxMsgLength int
 		// websocket write buffer, default: 4096, 4kb.
 		writeBufferSize int
+		// 心跳策略
+		heartbeat *heartbeatPolicy
+		// 可恢复会话
+		sessions *sessionStore
 	}
 )
 
@@ -61,3 +65,18 @@ func WithWriteBufferSize(size int) Option {
 		opt.writeBufferSize = size
 	}
 }
+
+// WithHeartbeat sets the ping interval and the idle timeouts in seconds, platformIdleTimeouts is keyed by platform name.
+func WithHeartbeat(pingInterval, idleTimeout int, platformIdleTimeouts map[string]int) Option {
+	return func(opt *configs) {
+		opt.heartbeat = newHeartbeatPolicy(pingInterval, idleTimeout, platformIdleTimeouts)
+	}
+}
+
+// WithSessionResume lets clients resume their session within window seconds of a disconnect,
+// with up to bufferSize of the pushes they missed replayed.
+func WithSessionResume(enable bool, window, bufferSize int) Option {
+	return func(opt *configs) {
+		opt.sessions = newSessionStore(enable, window, bufferSize)
+	}
+}
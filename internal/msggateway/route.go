@@ -0,0 +1,127 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mcontext"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+)
+
+const (
+	routeOperationTimeout = 3 * time.Second
+	// all local users are published again at this interval, well within the expiry of the routes
+	routeResyncInterval = time.Hour
+)
+
+// gatewayRoute publishes the users connected to this node, so the pusher only sends their messages here.
+// Whenever a route can not be written the heartbeat stops until all users are published again,
+// and pushers broadcast to every gateway in the meantime.
+type gatewayRoute struct {
+	cache cache.GatewayRouteCache
+	dirty atomic.Bool
+}
+
+func heartbeatInterval() time.Duration {
+	if interval := config.Config.LongConnSvr.GatewayRoute.HeartbeatInterval; interval > 0 {
+		return time.Duration(interval) * time.Second
+	}
+	return 10 * time.Second
+}
+
+func (ws *WsServer) SetGatewayRouteCache(routeCache cache.GatewayRouteCache) {
+	if !config.Config.LongConnSvr.GatewayRoute.Enable {
+		return
+	}
+	ws.route = &gatewayRoute{cache: routeCache}
+	// users connected before discovery was ready are not published yet
+	ws.route.dirty.Store(true)
+	go ws.runRouteHeartbeat()
+}
+
+func (ws *WsServer) selfNode() string {
+	if ws.disCov == nil {
+		return ""
+	}
+	return ws.disCov.GetSelfConnTarget()
+}
+
+func (ws *WsServer) publishUserRoute(ctx context.Context, userID string) {
+	if ws.route == nil {
+		return
+	}
+	node := ws.selfNode()
+	if node == "" {
+		ws.route.dirty.Store(true)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, routeOperationTimeout)
+	defer cancel()
+	if err := ws.route.cache.AddUserRoutes(ctx, node, []string{userID}); err != nil {
+		log.ZWarn(ctx, "publish user gateway route failed", err, "userID", userID)
+		ws.route.dirty.Store(true)
+	}
+}
+
+func (ws *WsServer) withdrawUserRoute(ctx context.Context, userID string) {
+	if ws.route == nil {
+		return
+	}
+	node := ws.selfNode()
+	if node == "" {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, routeOperationTimeout)
+	defer cancel()
+	// a route left behind only costs the pusher a useless call to this node
+	if err := ws.route.cache.DelUserRoute(ctx, node, userID); err != nil {
+		log.ZWarn(ctx, "withdraw user gateway route failed", err, "userID", userID)
+	}
+}
+
+func (ws *WsServer) runRouteHeartbeat() {
+	interval := heartbeatInterval()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	lastResync := time.Now()
+	for ; ; <-ticker.C {
+		ctx := mcontext.NewCtx("gatewayRouteHeartbeat")
+		node := ws.selfNode()
+		if node == "" {
+			continue
+		}
+		if ws.route.dirty.Load() || time.Since(lastResync) > routeResyncInterval {
+			ws.route.dirty.Store(false)
+			if err := ws.route.cache.AddUserRoutes(ctx, node, ws.clients.UserIDs()); err != nil {
+				log.ZWarn(ctx, "resync gateway routes failed", err, "node", node)
+				ws.route.dirty.Store(true)
+				continue
+			}
+			lastResync = time.Now()
+		}
+		if ws.route.dirty.Load() {
+			continue
+		}
+		if err := ws.route.cache.SetNodeHeartbeat(ctx, node, interval*3); err != nil {
+			log.ZWarn(ctx, "gateway route heartbeat failed", err, "node", node)
+		}
+	}
+}
@@ -0,0 +1,350 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mcontext"
+)
+
+const (
+	defaultResumeWindow     = 30 * time.Second
+	defaultResumeBufferSize = 100
+)
+
+// SessionInfo is the data of the WsSessionMsg frame sent to a client that asked for a resumable session.
+type SessionInfo struct {
+	ResumeToken string `json:"resumeToken"`
+	// Resumed is set when the connection took over the session of the token it carried,
+	// the buffered pushes are replayed right after this frame.
+	Resumed bool `json:"resumed"`
+	// Window is how many seconds after a disconnect the session can still be resumed.
+	Window int64 `json:"window"`
+}
+
+type bufferedPush struct {
+	resp Resp
+	time time.Time
+}
+
+// resumeSession is what a client keeps across reconnects within the resume window.
+type resumeSession struct {
+	token      string
+	userID     string
+	platformID int
+	window     time.Duration
+	bufferSize int
+
+	// guarded by sessionStore.lock
+	client       *Client // nil while parked
+	isBackground bool
+	expireTime   time.Time
+
+	lock   sync.Mutex
+	pushes []bufferedPush
+}
+
+// sessionStore holds the resumable sessions of this node, those of connected clients and
+// those parked after a disconnect, which keep buffering the pushes of their user until they expire.
+type sessionStore struct {
+	window     time.Duration
+	bufferSize int
+
+	lock     sync.Mutex
+	sessions map[string]*resumeSession
+	// parked sessions by userID and token
+	parked map[string]map[string]*resumeSession
+}
+
+// newSessionStore takes the window in seconds, it returns nil when resuming is disabled.
+func newSessionStore(enable bool, window, bufferSize int) *sessionStore {
+	if !enable {
+		return nil
+	}
+	s := &sessionStore{
+		window:     time.Duration(window) * time.Second,
+		bufferSize: bufferSize,
+		sessions:   make(map[string]*resumeSession),
+		parked:     make(map[string]map[string]*resumeSession),
+	}
+	if s.window <= 0 {
+		s.window = defaultResumeWindow
+	}
+	if s.bufferSize <= 0 {
+		s.bufferSize = defaultResumeBufferSize
+	}
+	return s
+}
+
+func newResumeToken() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Open starts a new session for client.
+func (s *sessionStore) Open(client *Client) *resumeSession {
+	session := &resumeSession{
+		token:      newResumeToken(),
+		userID:     client.UserID,
+		platformID: client.PlatformID,
+		window:     s.window,
+		bufferSize: s.bufferSize,
+		client:     client,
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.sessions[session.token] = session
+	return session
+}
+
+// Resume hands the session of token to client, it fails when the session expired or belongs to another user or platform.
+// A session still held by a connection that did not notice it was gone is taken over, and that connection is returned.
+func (s *sessionStore) Resume(token string, client *Client, now time.Time) (session *resumeSession, old *Client, ok bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	session, ok = s.sessions[token]
+	if !ok || session.userID != client.UserID || session.platformID != client.PlatformID {
+		return nil, nil, false
+	}
+	if session.client == nil {
+		if now.After(session.expireTime) {
+			return nil, nil, false
+		}
+		s.unpark(session)
+		client.IsBackground = session.isBackground
+	}
+	old = session.client
+	session.client = client
+	return session, old, true
+}
+
+// Park keeps the session of client for the resume window after it disconnected,
+// false when client had no session or lost it to a newer connection.
+func (s *sessionStore) Park(client *Client, now time.Time) bool {
+	session := client.session.Load()
+	if session == nil {
+		return false
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if session.client != client {
+		return false
+	}
+	session.client = nil
+	session.isBackground = client.IsBackground
+	session.expireTime = now.Add(s.window)
+	tokens, ok := s.parked[session.userID]
+	if !ok {
+		tokens = make(map[string]*resumeSession)
+		s.parked[session.userID] = tokens
+	}
+	tokens[session.token] = session
+	return true
+}
+
+// Drop ends the session of client, for connections that were kicked, logged out or closed on purpose.
+func (s *sessionStore) Drop(client *Client) {
+	session := client.session.Load()
+	if session == nil {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if session.client == client {
+		delete(s.sessions, session.token)
+	}
+}
+
+// HasParked reports whether any of userIDs has a parked session.
+func (s *sessionStore) HasParked(userIDs []string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, userID := range userIDs {
+		if len(s.parked[userID]) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// BufferParked buffers resp in every parked session of userID.
+func (s *sessionStore) BufferParked(userID string, resp Resp, now time.Time) {
+	s.lock.Lock()
+	sessions := make([]*resumeSession, 0, len(s.parked[userID]))
+	for _, session := range s.parked[userID] {
+		sessions = append(sessions, session)
+	}
+	s.lock.Unlock()
+	for _, session := range sessions {
+		session.buffer(resp, now)
+	}
+}
+
+// Sweep removes the parked sessions that expired and returns the users left without any parked session.
+func (s *sessionStore) Sweep(now time.Time) []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var userIDs []string
+	for userID, tokens := range s.parked {
+		for token, session := range tokens {
+			if now.After(session.expireTime) {
+				delete(tokens, token)
+				delete(s.sessions, token)
+			}
+		}
+		if len(tokens) == 0 {
+			delete(s.parked, userID)
+			userIDs = append(userIDs, userID)
+		}
+	}
+	return userIDs
+}
+
+func (s *sessionStore) unpark(session *resumeSession) {
+	tokens := s.parked[session.userID]
+	delete(tokens, session.token)
+	if len(tokens) == 0 {
+		delete(s.parked, session.userID)
+	}
+}
+
+// buffer keeps resp for replay, dropping the pushes older than the window and the oldest beyond the buffer size.
+func (r *resumeSession) buffer(resp Resp, now time.Time) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.pushes = append(r.pushes, bufferedPush{resp: resp, time: now})
+	r.trim(now)
+	if len(r.pushes) > r.bufferSize {
+		r.pushes = append(r.pushes[:0], r.pushes[len(r.pushes)-r.bufferSize:]...)
+	}
+}
+
+// replay takes the pushes buffered within the window out of the buffer, oldest first.
+func (r *resumeSession) replay(now time.Time) []Resp {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.trim(now)
+	resps := make([]Resp, 0, len(r.pushes))
+	for _, push := range r.pushes {
+		resps = append(resps, push.resp)
+	}
+	r.pushes = nil
+	return resps
+}
+
+// requeue puts back the replayed pushes that could not be written, ahead of those buffered since.
+func (r *resumeSession) requeue(resps []Resp, now time.Time) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	pushes := make([]bufferedPush, 0, len(resps)+len(r.pushes))
+	for _, resp := range resps {
+		pushes = append(pushes, bufferedPush{resp: resp, time: now})
+	}
+	r.pushes = append(pushes, r.pushes...)
+	if len(r.pushes) > r.bufferSize {
+		r.pushes = r.pushes[len(r.pushes)-r.bufferSize:]
+	}
+}
+
+func (r *resumeSession) trim(now time.Time) {
+	i := 0
+	for i < len(r.pushes) && now.Sub(r.pushes[i].time) > r.window {
+		i++
+	}
+	if i > 0 {
+		r.pushes = append(r.pushes[:0], r.pushes[i:]...)
+	}
+}
+
+const sessionSweepInterval = time.Second
+
+// attachSession gives a client that asked for a resumable session the session of the resume token it carried,
+// or a new one when that can not be resumed, then sends it the session followed by the pushes it missed.
+func (ws *WsServer) attachSession(client *Client) {
+	if ws.sessions == nil || (!client.sessionResume && client.resumeToken == "") {
+		return
+	}
+	now := time.Now()
+	var (
+		session *resumeSession
+		replay  []Resp
+		resumed bool
+	)
+	if client.resumeToken != "" {
+		var old *Client
+		session, old, resumed = ws.sessions.Resume(client.resumeToken, client, now)
+		if old != nil && old != client {
+			log.ZInfo(client.ctx, "session resumed from a connection still open", "old remote addr", old.ctx.GetRemoteAddr())
+			old.close()
+		}
+		if resumed {
+			replay = session.replay(now)
+		} else {
+			log.ZDebug(client.ctx, "session can not be resumed, a new one is opened")
+		}
+	}
+	if session == nil {
+		session = ws.sessions.Open(client)
+	}
+	client.session.Store(session)
+	info := SessionInfo{ResumeToken: session.token, Resumed: resumed, Window: int64(ws.sessions.window / time.Second)}
+	go client.sendSession(info, replay)
+}
+
+// parkSession keeps the session of a client that dropped off unexpectedly for the resume window,
+// the session of a client that was kicked or left on purpose ends with it.
+func (ws *WsServer) parkSession(client *Client) bool {
+	if ws.sessions == nil {
+		return false
+	}
+	if !client.resumable.Load() {
+		ws.sessions.Drop(client)
+		return false
+	}
+	return ws.sessions.Park(client, time.Now())
+}
+
+// sweepSessions drops the parked sessions that expired, withdrawing the route of users left with nothing on this node.
+func (ws *WsServer) sweepSessions() {
+	for _, userID := range ws.sessions.Sweep(time.Now()) {
+		if _, ok := ws.clients.GetAll(userID); !ok {
+			ws.withdrawUserRoute(mcontext.NewCtx("sweepSessions"), userID)
+		}
+	}
+}
+
+// BufferPush buffers a push for the parked sessions of userIDs, to be replayed when they resume.
+func (ws *WsServer) BufferPush(ctx context.Context, userIDs []string, msgData *sdkws.MsgData) {
+	if ws.sessions == nil || !ws.sessions.HasParked(userIDs) {
+		return
+	}
+	resp, err := newPushResp(ctx, msgData)
+	if err != nil {
+		log.ZWarn(ctx, "buffer push for parked sessions failed", err)
+		return
+	}
+	now := time.Now()
+	for _, userID := range userIDs {
+		ws.sessions.BufferParked(userID, resp, now)
+	}
+}
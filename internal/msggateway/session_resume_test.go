@@ -0,0 +1,85 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionStoreResume(t *testing.T) {
+	store := newSessionStore(true, 30, 2)
+	now := time.Now()
+
+	old := &Client{UserID: "u1", PlatformID: 1}
+	session := store.Open(old)
+	old.session.Store(session)
+	session.buffer(Resp{MsgIncr: "1"}, now.Add(-time.Minute))
+
+	assert.True(t, store.Park(old, now))
+	assert.True(t, store.HasParked([]string{"u1"}))
+	store.BufferParked("u1", Resp{MsgIncr: "2"}, now)
+	store.BufferParked("u1", Resp{MsgIncr: "3"}, now)
+	store.BufferParked("u1", Resp{MsgIncr: "4"}, now)
+
+	_, _, ok := store.Resume(session.token, &Client{UserID: "u2", PlatformID: 1}, now)
+	assert.False(t, ok)
+
+	client := &Client{UserID: "u1", PlatformID: 1}
+	resumed, taken, ok := store.Resume(session.token, client, now)
+	assert.True(t, ok)
+	assert.Nil(t, taken)
+	assert.False(t, store.HasParked([]string{"u1"}))
+	var incrs []string
+	for _, resp := range resumed.replay(now) {
+		incrs = append(incrs, resp.MsgIncr)
+	}
+	// the push older than the window and the oldest beyond the buffer size are gone
+	assert.Equal(t, []string{"3", "4"}, incrs)
+	// replayed pushes are not replayed again, unless they could not be written
+	assert.Empty(t, resumed.replay(now))
+	resumed.buffer(Resp{MsgIncr: "5"}, now)
+	resumed.requeue([]Resp{{MsgIncr: "4"}}, now)
+	incrs = nil
+	for _, resp := range resumed.replay(now) {
+		incrs = append(incrs, resp.MsgIncr)
+	}
+	assert.Equal(t, []string{"4", "5"}, incrs)
+
+	// a connection still holding the session is taken over
+	next := &Client{UserID: "u1", PlatformID: 1}
+	_, taken, ok = store.Resume(session.token, next, now)
+	assert.True(t, ok)
+	assert.Equal(t, client, taken)
+	client.session.Store(session)
+	assert.False(t, store.Park(client, now))
+}
+
+func TestSessionStoreExpire(t *testing.T) {
+	store := newSessionStore(true, 30, 10)
+	now := time.Now()
+	client := &Client{UserID: "u1", PlatformID: 1}
+	session := store.Open(client)
+	client.session.Store(session)
+	assert.True(t, store.Park(client, now))
+
+	later := now.Add(time.Minute)
+	_, _, ok := store.Resume(session.token, &Client{UserID: "u1", PlatformID: 1}, later)
+	assert.False(t, ok)
+	assert.Equal(t, []string{"u1"}, store.Sweep(later))
+	assert.Empty(t, store.sessions)
+}
@@ -38,6 +38,16 @@ func (u *UserMap) GetAll(key string) ([]*Client, bool) {
 	return nil, ok
 }
 
+// UserIDs returns the users that have connections on this node.
+func (u *UserMap) UserIDs() []string {
+	var userIDs []string
+	u.m.Range(func(key, _ any) bool {
+		userIDs = append(userIDs, key.(string))
+		return true
+	})
+	return userIDs
+}
+
 func (u *UserMap) Get(key string, platformID int) ([]*Client, bool, bool) {
 	allClients, userExisted := u.m.Load(key)
 	if userExisted {
@@ -18,22 +18,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
-	"net/http"
-	"sync"
 
 	"github.com/OpenIMSDK/tools/errs"
 
-	"github.com/OpenIMSDK/tools/mw"
-	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/collectors"
-	"github.com/prometheus/client_golang/prometheus/promhttp"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mw"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
@@ -41,11 +36,17 @@ import (
 	kdisc "github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 type MsgTransfer struct {
 	historyCH      *OnlineHistoryRedisConsumerHandler // 这个消费者聚合消息, 订阅的topic：ws2ms_chat, 修改通知发往msg_to_modify topic, 消息存入redis后Incr Redis, 再发消息到ms2pschat topic推送， 发消息到msg_to_mongo topic持久化
 	historyMongoCH *OnlineHistoryMongoConsumerHandler // mongoDB批量插入, 成功后删除redis中消息，以及处理删除通知消息删除的 订阅的topic: msg_to_mongo
+	searchCH       *OnlineMsgSearchConsumerHandler    // 消息写入检索索引, 撤回的消息从索引删除 订阅的topic: msg_to_mongo
 	// modifyCH       *ModifyMsgConsumerHandler          // 负责消费修改消息通知的consumer, 订阅的topic: msg_to_modify
 }
 
@@ -78,21 +79,34 @@ func StartTransfer(prometheusPort int) error {
 	client.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"LoadBalancingPolicy": "%s"}`, "round_robin")))
 	msgModel := cache.NewMsgCacheModel(rdb)
 	msgDocModel := unrelation.NewMsgMongoDriver(mongo.GetDatabase())
-	msgDatabase, err := controller.NewCommonMsgDatabase(msgDocModel, msgModel)
+	// thread replies update their root msg here, the archive rehydrates an archived root before the write
+	msgArchive, err := controller.InitMsgArchive(rdb, mongo.GetDatabase())
 	if err != nil {
 		return err
 	}
+	msgDatabase, err := controller.NewCommonMsgDatabase(msgDocModel, msgModel, msgArchive, nil)
+	if err != nil {
+		return err
+	}
+	searchIndex, err := controller.NewMsgSearchIndex(rdb)
+	if err != nil {
+		return err
+	}
+	defer searchIndex.Close()
+	searchDatabase := controller.NewMsgSearchDatabase(searchIndex, msgDatabase)
 	conversationRpcClient := rpcclient.NewConversationRpcClient(client)
 	groupRpcClient := rpcclient.NewGroupRpcClient(client)
-	msgTransfer, err := NewMsgTransfer(msgDatabase, &conversationRpcClient, &groupRpcClient)
+	msgRpcClient := rpcclient.NewMessageRpcClient(client)
+	notificationSender := rpcclient.NewNotificationSender(rpcclient.WithRpcClient(&msgRpcClient))
+	msgTransfer, err := NewMsgTransfer(msgDatabase, searchDatabase, &conversationRpcClient, &groupRpcClient, notificationSender)
 	if err != nil {
 		return err
 	}
 	return msgTransfer.Start(prometheusPort)
 }
 
-func NewMsgTransfer(msgDatabase controller.CommonMsgDatabase, conversationRpcClient *rpcclient.ConversationRpcClient, groupRpcClient *rpcclient.GroupRpcClient) (*MsgTransfer, error) {
-	historyCH, err := NewOnlineHistoryRedisConsumerHandler(msgDatabase, conversationRpcClient, groupRpcClient)
+func NewMsgTransfer(msgDatabase controller.CommonMsgDatabase, searchDatabase controller.MsgSearchDatabase, conversationRpcClient *rpcclient.ConversationRpcClient, groupRpcClient *rpcclient.GroupRpcClient, notificationSender *rpcclient.NotificationSender) (*MsgTransfer, error) {
+	historyCH, err := NewOnlineHistoryRedisConsumerHandler(msgDatabase, conversationRpcClient, groupRpcClient, notificationSender)
 	if err != nil {
 		return nil, err
 	}
@@ -100,10 +114,15 @@ func NewMsgTransfer(msgDatabase controller.CommonMsgDatabase, conversationRpcCli
 	if err != nil {
 		return nil, err
 	}
+	searchCH, err := NewOnlineMsgSearchConsumerHandler(searchDatabase)
+	if err != nil {
+		return nil, err
+	}
 
 	return &MsgTransfer{
 		historyCH:      historyCH,
 		historyMongoCH: historyMongoCH,
+		searchCH:       searchCH,
 	}, nil
 }
 
@@ -130,6 +149,13 @@ func (m *MsgTransfer) Start(prometheusPort int) error {
 		m.historyMongoCH.historyConsumerGroup.RegisterHandleAndConsumer(ctx, m.historyMongoCH)
 	}()
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		m.searchCH.searchConsumerGroup.RegisterHandleAndConsumer(ctx, m.searchCH)
+	}()
+
 	if config.Config.Prometheus.Enable {
 		go func() {
 			proreg := prometheus.NewRegistry()
@@ -152,6 +178,7 @@ func (m *MsgTransfer) Start(prometheusPort int) error {
 	// graceful close kafka client.
 	go m.historyCH.historyConsumerGroup.Close()
 	go m.historyMongoCH.historyConsumerGroup.Close()
+	go m.searchCH.searchConsumerGroup.Close()
 
 	done := make(chan struct{}, 1)
 	go func() {
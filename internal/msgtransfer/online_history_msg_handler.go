@@ -26,7 +26,6 @@ import (
 
 	"github.com/OpenIMSDK/tools/errs"
 
-	"github.com/IBM/sarama"
 	"github.com/go-redis/redis"
 	"google.golang.org/protobuf/proto"
 
@@ -38,7 +37,8 @@ import (
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
-	"github.com/openimsdk/open-im-server/v3/pkg/common/kafka"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/mq"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/msgext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 )
 
@@ -57,7 +57,7 @@ type MsgChannelValue struct {
 
 type TriggerChannelValue struct {
 	ctx      context.Context
-	cMsgList []*sarama.ConsumerMessage
+	cMsgList []*mq.Message
 }
 
 type Cmd2Value struct {
@@ -70,7 +70,7 @@ type ContextMsg struct {
 }
 
 type OnlineHistoryRedisConsumerHandler struct {
-	historyConsumerGroup *kafka.MConsumerGroup
+	historyConsumerGroup mq.MQConsumer
 	chArrays             [ChannelNum]chan Cmd2Value
 	msgDistributionCh    chan Cmd2Value
 
@@ -82,12 +82,14 @@ type OnlineHistoryRedisConsumerHandler struct {
 	msgDatabase           controller.CommonMsgDatabase
 	conversationRpcClient *rpcclient.ConversationRpcClient
 	groupRpcClient        *rpcclient.GroupRpcClient
+	notificationSender    *rpcclient.NotificationSender
 }
 
 func NewOnlineHistoryRedisConsumerHandler(
 	database controller.CommonMsgDatabase,
 	conversationRpcClient *rpcclient.ConversationRpcClient,
 	groupRpcClient *rpcclient.GroupRpcClient,
+	notificationSender *rpcclient.NotificationSender,
 ) (*OnlineHistoryRedisConsumerHandler, error) {
 	var och OnlineHistoryRedisConsumerHandler
 	och.msgDatabase = database
@@ -99,12 +101,10 @@ func NewOnlineHistoryRedisConsumerHandler(
 	}
 	och.conversationRpcClient = conversationRpcClient
 	och.groupRpcClient = groupRpcClient
+	och.notificationSender = notificationSender
 	var err error
-	och.historyConsumerGroup, err = kafka.NewMConsumerGroup(&kafka.MConsumerGroupConfig{
-		KafkaVersion:   sarama.V2_0_0_0,
-		OffsetsInitial: sarama.OffsetNewest, IsReturnErr: false,
-	}, []string{config.Config.Kafka.LatestMsgToRedis.Topic},
-		config.Config.Kafka.Addr, config.Config.Kafka.ConsumerGroupID.MsgToRedis)
+	och.historyConsumerGroup, err = mq.NewMQConsumer([]string{config.Config.Kafka.LatestMsgToRedis.Topic},
+		config.Config.Kafka.ConsumerGroupID.MsgToRedis)
 	// statistics.NewStatistics(&och.singleMsgSuccessCount, config.Config.ModuleName.MsgTransferName, fmt.Sprintf("%d
 	// second singleMsgCount insert to mongo", constant.StatisticsTimeInterval), constant.StatisticsTimeInterval)
 	return &och, err
@@ -277,6 +277,10 @@ func (och *OnlineHistoryRedisConsumerHandler) handleMsg(
 	key, conversationID string,
 	storageList, notStorageList []*sdkws.MsgData,
 ) {
+	if msgprocessor.IsThread(conversationID) {
+		och.handleThreadMsg(ctx, key, conversationID, storageList)
+		return
+	}
 	och.toPushTopic(ctx, key, conversationID, notStorageList)
 	if len(storageList) > 0 {
 		lastSeq, isNewConversation, err := och.msgDatabase.BatchInsertChat2Cache(ctx, conversationID, storageList)
@@ -321,6 +325,38 @@ func (och *OnlineHistoryRedisConsumerHandler) handleMsg(
 	}
 }
 
+// handleThreadMsg stores the replies of a thread in its own seq space, updates the root msg and notifies the
+// group with a thread reply notification for each reply, the replies themselves are not pushed.
+func (och *OnlineHistoryRedisConsumerHandler) handleThreadMsg(ctx context.Context, key, threadConversationID string, storageList []*sdkws.MsgData) {
+	if len(storageList) == 0 {
+		return
+	}
+	lastSeq, _, err := och.msgDatabase.BatchInsertChat2Cache(ctx, threadConversationID, storageList)
+	if err != nil && errs.Unwrap(err) != redis.Nil {
+		log.ZError(ctx, "batch thread data insert to redis err", err, "threadConversationID", threadConversationID)
+		return
+	}
+	if err := och.msgDatabase.MsgToMongoMQ(ctx, key, threadConversationID, storageList, lastSeq); err != nil {
+		log.ZError(ctx, "MsgToMongoMQ error", err)
+	}
+	if err := och.msgDatabase.UpdateThreadRoot(ctx, threadConversationID, storageList); err != nil {
+		log.ZError(ctx, "update thread root failed", err, "threadConversationID", threadConversationID)
+	}
+	conversationID, rootSeq, _ := msgprocessor.ParseThreadConversationID(threadConversationID)
+	for _, msg := range storageList {
+		tips := msgext.MsgThreadReplyTips{
+			ConversationID:       conversationID,
+			RootSeq:              rootSeq,
+			ThreadConversationID: threadConversationID,
+			ReplyCount:           msg.Seq,
+			Reply:                msg,
+		}
+		if err := och.notificationSender.NotificationWithSesstionType(ctx, msg.SendID, msg.GroupID, msgprocessor.MsgThreadReplyNotification, constant.SuperGroupChatType, &tips); err != nil {
+			log.ZError(ctx, "thread reply notification failed", err, "threadConversationID", threadConversationID, "seq", msg.Seq)
+		}
+	}
+}
+
 func (och *OnlineHistoryRedisConsumerHandler) MessagesDistributionHandle() {
 	for {
 		aggregationMsgs := make(map[string][]*ContextMsg, ChannelNum)
@@ -343,17 +379,17 @@ func (och *OnlineHistoryRedisConsumerHandler) MessagesDistributionHandle() {
 					}
 					var arr []string
 					for i, header := range consumerMessages[i].Headers {
-						arr = append(arr, strconv.Itoa(i), string(header.Key), string(header.Value))
+						arr = append(arr, strconv.Itoa(i), header.Key, header.Value)
 					}
 					log.ZInfo(
 						ctx,
-						"consumer.kafka.GetContextWithMQHeader",
+						"consumer.mq.GetContextFromMsg",
 						"len",
 						len(consumerMessages[i].Headers),
 						"header",
 						strings.Join(arr, ", "),
 					)
-					ctxMsg.ctx = kafka.GetContextWithMQHeader(consumerMessages[i].Headers)
+					ctxMsg.ctx = mq.GetContextFromMsg(consumerMessages[i])
 					ctxMsg.message = msgFromMQ
 					log.ZDebug(
 						ctx,
@@ -412,14 +448,9 @@ func withAggregationCtx(ctx context.Context, values []*ContextMsg) context.Conte
 	return mcontext.SetOperationID(ctx, allMessageOperationID)
 }
 
-func (och *OnlineHistoryRedisConsumerHandler) Setup(_ sarama.ConsumerGroupSession) error { return nil }
-func (och *OnlineHistoryRedisConsumerHandler) Cleanup(_ sarama.ConsumerGroupSession) error {
-	return nil
-}
-
 func (och *OnlineHistoryRedisConsumerHandler) ConsumeClaim(
-	sess sarama.ConsumerGroupSession,
-	claim sarama.ConsumerGroupClaim,
+	sess mq.Session,
+	claim mq.Claim,
 ) error { // a instance in the consumer group
 	for {
 		if sess == nil {
@@ -435,7 +466,7 @@ func (och *OnlineHistoryRedisConsumerHandler) ConsumeClaim(
 	var (
 		split    = 1000
 		rwLock   = new(sync.RWMutex)
-		messages = make([]*sarama.ConsumerMessage, 0, 1000)
+		messages = make([]*mq.Message, 0, 1000)
 		ticker   = time.NewTicker(time.Millisecond * 100)
 
 		wg      = sync.WaitGroup{}
@@ -459,7 +490,7 @@ func (och *OnlineHistoryRedisConsumerHandler) ConsumeClaim(
 				}
 
 				rwLock.Lock()
-				buffer := make([]*sarama.ConsumerMessage, 0, len(messages))
+				buffer := make([]*mq.Message, 0, len(messages))
 				buffer = append(buffer, messages...)
 
 				// reuse slice, set cap to 0
@@ -507,7 +538,7 @@ func (och *OnlineHistoryRedisConsumerHandler) ConsumeClaim(
 				messages = append(messages, msg)
 				rwLock.Unlock()
 
-				sess.MarkMessage(msg, "")
+				sess.MarkMessage(msg)
 
 			case <-sess.Context().Done():
 				running.Store(false)
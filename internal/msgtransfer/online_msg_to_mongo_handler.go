@@ -16,60 +16,83 @@ package msgtransfer
 
 import (
 	"context"
+	"time"
 
-	"github.com/IBM/sarama"
 	"google.golang.org/protobuf/proto"
 
 	pbmsg "github.com/OpenIMSDK/protocol/msg"
 	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/utils"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
-	kfk "github.com/openimsdk/open-im-server/v3/pkg/common/kafka"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/mq"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 )
 
+const (
+	defaultMongoInsertBackoff    = 500 * time.Millisecond
+	defaultMongoInsertMaxBackoff = 30 * time.Second
+)
+
 type OnlineHistoryMongoConsumerHandler struct {
-	historyConsumerGroup *kfk.MConsumerGroup
+	historyConsumerGroup mq.MQConsumer
 	msgDatabase          controller.CommonMsgDatabase
+	deadLetter           mq.MQProducer // receives the batches that still fail to be written to mongo after all retries
+	maxRetry             int
+	backoff              time.Duration
+	maxBackoff           time.Duration
 }
 
 func NewOnlineHistoryMongoConsumerHandler(database controller.CommonMsgDatabase) (*OnlineHistoryMongoConsumerHandler, error) {
-	historyConsumerGroup, err := kfk.NewMConsumerGroup(&kfk.MConsumerGroupConfig{
-		KafkaVersion:   sarama.V2_0_0_0,
-		OffsetsInitial: sarama.OffsetNewest, IsReturnErr: false,
-	}, []string{config.Config.Kafka.MsgToMongo.Topic},
-		config.Config.Kafka.Addr, config.Config.Kafka.ConsumerGroupID.MsgToMongo)
+	historyConsumerGroup, err := mq.NewMQConsumer([]string{config.Config.Kafka.MsgToMongo.Topic},
+		config.Config.Kafka.ConsumerGroupID.MsgToMongo)
 	if err != nil {
 		return nil, err
 	}
-
+	deadLetter, err := mq.NewMQProducer(config.Config.Kafka.MsgToMongoDeadLetter.Topic)
+	if err != nil {
+		return nil, err
+	}
+	conf := config.Config.Kafka.MsgToMongo
 	mc := &OnlineHistoryMongoConsumerHandler{
 		historyConsumerGroup: historyConsumerGroup,
 		msgDatabase:          database,
+		deadLetter:           deadLetter,
+		maxRetry:             conf.MaxRetry,
+		backoff:              time.Duration(conf.Backoff) * time.Millisecond,
+		maxBackoff:           time.Duration(conf.MaxBackoff) * time.Millisecond,
+	}
+	if mc.backoff <= 0 {
+		mc.backoff = defaultMongoInsertBackoff
+	}
+	if mc.maxBackoff < mc.backoff {
+		mc.maxBackoff = utils.Max(mc.backoff, defaultMongoInsertMaxBackoff)
 	}
 	return mc, nil
 }
 
+// handleChatWs2Mongo returns false when the batch reached neither mongo nor the dead letter topic before the
+// session ended, the msg must not be marked then so it is consumed again.
 func (mc *OnlineHistoryMongoConsumerHandler) handleChatWs2Mongo(
 	ctx context.Context,
-	cMsg *sarama.ConsumerMessage,
+	cMsg *mq.Message,
 	key string,
-	session sarama.ConsumerGroupSession,
-) {
+	session mq.Session,
+) bool {
 	msg := cMsg.Value
 	msgFromMQ := pbmsg.MsgDataToMongoByMQ{}
 	err := proto.Unmarshal(msg, &msgFromMQ)
 	if err != nil {
 		log.ZError(ctx, "unmarshall failed", err, "key", key, "len", len(msg))
-		return
+		return true
 	}
 	if len(msgFromMQ.MsgData) == 0 {
 		log.ZError(ctx, "msgFromMQ.MsgData is empty", nil, "cMsg", cMsg)
-		return
+		return true
 	}
 	log.ZInfo(ctx, "mongo consumer recv msg", "msgs", msgFromMQ.String())
-	err = mc.msgDatabase.BatchInsertChat2DB(ctx, msgFromMQ.ConversationID, msgFromMQ.MsgData, msgFromMQ.LastSeq)
+	err = mc.batchInsertChat2DB(ctx, &msgFromMQ)
 	if err != nil {
 		log.ZError(
 			ctx,
@@ -81,9 +104,14 @@ func (mc *OnlineHistoryMongoConsumerHandler) handleChatWs2Mongo(
 			msgFromMQ.ConversationID,
 		)
 		prommetrics.MsgInsertMongoFailedCounter.Inc()
-	} else {
-		prommetrics.MsgInsertMongoSuccessCounter.Inc()
+		// the msgs are kept in redis so they can still be pulled until the dead letter is re-driven
+		var done <-chan struct{}
+		if session != nil {
+			done = session.Context().Done()
+		}
+		return mc.sendDeadLetter(ctx, done, key, &msgFromMQ)
 	}
+	prommetrics.MsgInsertMongoSuccessCounter.Inc()
 	var seqs []int64
 	for _, msg := range msgFromMQ.MsgData {
 		seqs = append(seqs, msg.Seq)
@@ -101,25 +129,64 @@ func (mc *OnlineHistoryMongoConsumerHandler) handleChatWs2Mongo(
 		)
 	}
 	mc.msgDatabase.DelUserDeleteMsgsList(ctx, msgFromMQ.ConversationID, seqs)
+	return true
 }
 
-func (OnlineHistoryMongoConsumerHandler) Setup(_ sarama.ConsumerGroupSession) error   { return nil }
-func (OnlineHistoryMongoConsumerHandler) Cleanup(_ sarama.ConsumerGroupSession) error { return nil }
+// sendDeadLetter retries with backoff until the batch is in the dead letter topic, it only gives up when done is closed.
+func (mc *OnlineHistoryMongoConsumerHandler) sendDeadLetter(ctx context.Context, done <-chan struct{}, key string, msgFromMQ *pbmsg.MsgDataToMongoByMQ) bool {
+	backoff := mc.backoff
+	for {
+		_, _, err := mc.deadLetter.SendMessage(ctx, key, msgFromMQ)
+		if err == nil {
+			return true
+		}
+		log.ZError(ctx, "send msgs to mongo dead letter err, retry", err, "msg", msgFromMQ.MsgData,
+			"conversationID", msgFromMQ.ConversationID, "backoff", backoff)
+		select {
+		case <-done:
+			return false
+		case <-time.After(backoff):
+		}
+		backoff = utils.Min(backoff*2, mc.maxBackoff)
+	}
+}
+
+// batchInsertChat2DB writes the msgs to mongo, retrying with exponential backoff.
+// Retrying is safe because BatchInsertChat2DB overwrites the msgs by seq.
+func (mc *OnlineHistoryMongoConsumerHandler) batchInsertChat2DB(ctx context.Context, msgFromMQ *pbmsg.MsgDataToMongoByMQ) error {
+	backoff := mc.backoff
+	for attempt := 0; ; attempt++ {
+		err := mc.msgDatabase.BatchInsertChat2DB(ctx, msgFromMQ.ConversationID, msgFromMQ.MsgData, msgFromMQ.LastSeq)
+		if err == nil || attempt >= mc.maxRetry {
+			return err
+		}
+		log.ZWarn(ctx, "insert msgs to mongo failed, retry", err, "conversationID", msgFromMQ.ConversationID,
+			"attempt", attempt+1, "backoff", backoff)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff = utils.Min(backoff*2, mc.maxBackoff)
+	}
+}
 
 func (mc *OnlineHistoryMongoConsumerHandler) ConsumeClaim(
-	sess sarama.ConsumerGroupSession,
-	claim sarama.ConsumerGroupClaim,
+	sess mq.Session,
+	claim mq.Claim,
 ) error { // a instance in the consumer group
 	log.ZDebug(context.Background(), "online new session msg come", "highWaterMarkOffset",
 		claim.HighWaterMarkOffset(), "topic", claim.Topic(), "partition", claim.Partition())
 	for msg := range claim.Messages() {
-		ctx := mc.historyConsumerGroup.GetContextFromMsg(msg)
+		ctx := mq.GetContextFromMsg(msg)
 		if len(msg.Value) != 0 {
-			mc.handleChatWs2Mongo(ctx, msg, string(msg.Key), sess)
+			if !mc.handleChatWs2Mongo(ctx, msg, string(msg.Key), sess) {
+				return nil
+			}
 		} else {
 			log.ZError(ctx, "mongo msg get from kafka but is nil", nil, "conversationID", msg.Key)
 		}
-		sess.MarkMessage(msg, "")
+		sess.MarkMessage(msg)
 	}
 	return nil
 }
@@ -0,0 +1,170 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgtransfer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pbmsg "github.com/OpenIMSDK/protocol/msg"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/mq"
+)
+
+type fakeMsgDatabase struct {
+	controller.CommonMsgDatabase
+	failures     int
+	inserts      int
+	deletedSeqs  []int64
+	delUserLists int
+}
+
+func (f *fakeMsgDatabase) BatchInsertChat2DB(ctx context.Context, conversationID string, msgs []*sdkws.MsgData, currentMaxSeq int64) error {
+	f.inserts++
+	if f.inserts <= f.failures {
+		return errors.New("mongo unavailable")
+	}
+	return nil
+}
+
+func (f *fakeMsgDatabase) DeleteMessagesFromCache(ctx context.Context, conversationID string, seqs []int64) error {
+	f.deletedSeqs = append(f.deletedSeqs, seqs...)
+	return nil
+}
+
+func (f *fakeMsgDatabase) DelUserDeleteMsgsList(ctx context.Context, conversationID string, seqs []int64) {
+	f.delUserLists++
+}
+
+type fakeDeadLetter struct {
+	failures int
+	sends    int
+	keys     []string
+	msgs     []*pbmsg.MsgDataToMongoByMQ
+}
+
+func (f *fakeDeadLetter) SendMessage(ctx context.Context, key string, msg proto.Message) (int32, int64, error) {
+	f.sends++
+	if f.failures < 0 || f.sends <= f.failures {
+		return 0, 0, errors.New("kafka unavailable")
+	}
+	f.keys = append(f.keys, key)
+	f.msgs = append(f.msgs, msg.(*pbmsg.MsgDataToMongoByMQ))
+	return 0, 0, nil
+}
+
+func newTestMongoMsg(t *testing.T) *mq.Message {
+	value, err := proto.Marshal(&pbmsg.MsgDataToMongoByMQ{
+		ConversationID: "si_a_b",
+		LastSeq:        11,
+		MsgData:        []*sdkws.MsgData{{Seq: 10}, {Seq: 11}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &mq.Message{Key: []byte("si_a_b"), Value: value}
+}
+
+func TestHandleChatWs2MongoRetry(t *testing.T) {
+	db := &fakeMsgDatabase{failures: 2}
+	deadLetter := &fakeDeadLetter{}
+	mc := &OnlineHistoryMongoConsumerHandler{
+		msgDatabase: db,
+		deadLetter:  deadLetter,
+		maxRetry:    3,
+		backoff:     time.Millisecond,
+		maxBackoff:  2 * time.Millisecond,
+	}
+	mc.handleChatWs2Mongo(context.Background(), newTestMongoMsg(t), "si_a_b", nil)
+	if db.inserts != 3 {
+		t.Errorf("inserts = %d, want 3", db.inserts)
+	}
+	if len(deadLetter.msgs) != 0 {
+		t.Errorf("dead letters = %d, want 0", len(deadLetter.msgs))
+	}
+	if len(db.deletedSeqs) != 2 || db.delUserLists != 1 {
+		t.Errorf("deletedSeqs = %v, delUserLists = %d", db.deletedSeqs, db.delUserLists)
+	}
+}
+
+func TestHandleChatWs2MongoDeadLetter(t *testing.T) {
+	db := &fakeMsgDatabase{failures: 10}
+	deadLetter := &fakeDeadLetter{}
+	mc := &OnlineHistoryMongoConsumerHandler{
+		msgDatabase: db,
+		deadLetter:  deadLetter,
+		maxRetry:    2,
+		backoff:     time.Millisecond,
+		maxBackoff:  2 * time.Millisecond,
+	}
+	if !mc.handleChatWs2Mongo(context.Background(), newTestMongoMsg(t), "si_a_b", nil) {
+		t.Fatal("handled = false, want true")
+	}
+	if db.inserts != 3 {
+		t.Errorf("inserts = %d, want 3", db.inserts)
+	}
+	if len(db.deletedSeqs) != 0 || db.delUserLists != 0 {
+		t.Errorf("the msgs should stay in cache, deletedSeqs = %v, delUserLists = %d", db.deletedSeqs, db.delUserLists)
+	}
+	if len(deadLetter.msgs) != 1 {
+		t.Fatalf("dead letters = %d, want 1", len(deadLetter.msgs))
+	}
+	if msg := deadLetter.msgs[0]; deadLetter.keys[0] != "si_a_b" || msg.ConversationID != "si_a_b" || msg.LastSeq != 11 || len(msg.MsgData) != 2 {
+		t.Errorf("dead letter = %s %v", deadLetter.keys[0], msg)
+	}
+}
+
+type fakeSession struct {
+	ctx context.Context
+}
+
+func (f fakeSession) Context() context.Context { return f.ctx }
+
+func (f fakeSession) MarkMessage(msg *mq.Message) {}
+
+func TestHandleChatWs2MongoDeadLetterRetry(t *testing.T) {
+	db := &fakeMsgDatabase{failures: 10}
+	deadLetter := &fakeDeadLetter{failures: 2}
+	mc := &OnlineHistoryMongoConsumerHandler{
+		msgDatabase: db,
+		deadLetter:  deadLetter,
+		maxRetry:    0,
+		backoff:     time.Millisecond,
+		maxBackoff:  2 * time.Millisecond,
+	}
+	if !mc.handleChatWs2Mongo(context.Background(), newTestMongoMsg(t), "si_a_b", fakeSession{ctx: context.Background()}) {
+		t.Fatal("handled = false, want true")
+	}
+	if deadLetter.sends != 3 || len(deadLetter.msgs) != 1 {
+		t.Errorf("dead letter sends = %d, msgs = %d", deadLetter.sends, len(deadLetter.msgs))
+	}
+
+	// the dead letter topic stays down until the session ends, the msg must not be marked
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	deadLetter = &fakeDeadLetter{failures: -1}
+	mc.deadLetter = deadLetter
+	if mc.handleChatWs2Mongo(context.Background(), newTestMongoMsg(t), "si_a_b", fakeSession{ctx: ctx}) {
+		t.Fatal("handled = true, want false")
+	}
+	if len(deadLetter.msgs) != 0 || len(db.deletedSeqs) != 0 {
+		t.Errorf("dead letters = %d, deletedSeqs = %v", len(deadLetter.msgs), db.deletedSeqs)
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgtransfer
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	pbmsg "github.com/OpenIMSDK/protocol/msg"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/utils"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/mq"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+)
+
+const (
+	searchBatchSize     = 1000
+	searchFlushInterval = time.Second
+)
+
+// OnlineMsgSearchConsumerHandler feeds the message search index from the same topic the mongo
+// consumer persists from, in its own consumer group so indexing never slows down persistence.
+type OnlineMsgSearchConsumerHandler struct {
+	searchConsumerGroup mq.MQConsumer
+	searchDatabase      controller.MsgSearchDatabase
+}
+
+func NewOnlineMsgSearchConsumerHandler(database controller.MsgSearchDatabase) (*OnlineMsgSearchConsumerHandler, error) {
+	searchConsumerGroup, err := mq.NewMQConsumer([]string{config.Config.Kafka.MsgToMongo.Topic},
+		config.Config.Kafka.ConsumerGroupID.MsgToSearch)
+	if err != nil {
+		return nil, err
+	}
+	return &OnlineMsgSearchConsumerHandler{
+		searchConsumerGroup: searchConsumerGroup,
+		searchDatabase:      database,
+	}, nil
+}
+
+type searchBatch struct {
+	ctx     context.Context
+	size    int
+	msgs    map[string][]*sdkws.MsgData
+	revokes map[string][]int64
+	edits   map[string][]int64
+	last    *mq.Message
+}
+
+func newSearchBatch() *searchBatch {
+	return &searchBatch{
+		msgs:    make(map[string][]*sdkws.MsgData),
+		revokes: make(map[string][]int64),
+		edits:   make(map[string][]int64),
+	}
+}
+
+func (mc *OnlineMsgSearchConsumerHandler) addMsg(ctx context.Context, batch *searchBatch, cMsg *mq.Message) {
+	batch.ctx = ctx
+	batch.last = cMsg
+	msgFromMQ := pbmsg.MsgDataToMongoByMQ{}
+	if err := proto.Unmarshal(cMsg.Value, &msgFromMQ); err != nil {
+		log.ZError(ctx, "unmarshall failed", err, "key", string(cMsg.Key), "len", len(cMsg.Value))
+		return
+	}
+	if !msgprocessor.IsNotification(msgFromMQ.ConversationID) {
+		batch.msgs[msgFromMQ.ConversationID] = append(batch.msgs[msgFromMQ.ConversationID], msgFromMQ.MsgData...)
+		batch.size += len(msgFromMQ.MsgData)
+		return
+	}
+	// revoked messages leave the index and edited ones are indexed again,
+	// the tips of both are sent to the notification conversation
+	for _, msg := range msgFromMQ.MsgData {
+		var target map[string][]int64
+		switch msg.ContentType {
+		case constant.MsgRevokeNotification:
+			target = batch.revokes
+		case msgprocessor.MsgEditNotification:
+			target = batch.edits
+		default:
+			continue
+		}
+		var (
+			elem sdkws.NotificationElem
+			tips struct {
+				ConversationID string `json:"conversationID"`
+				Seq            int64  `json:"seq"`
+			}
+		)
+		if err := json.Unmarshal(msg.Content, &elem); err != nil {
+			continue
+		}
+		if err := json.Unmarshal([]byte(elem.Detail), &tips); err != nil || tips.ConversationID == "" || tips.Seq == 0 {
+			continue
+		}
+		target[tips.ConversationID] = append(target[tips.ConversationID], tips.Seq)
+		batch.size++
+	}
+}
+
+func (mc *OnlineMsgSearchConsumerHandler) flush(sess mq.Session, batch *searchBatch) {
+	if batch.last == nil {
+		return
+	}
+	if err := mc.searchDatabase.IndexMsgs(batch.ctx, batch.msgs); err != nil {
+		log.ZError(batch.ctx, "index msgs failed", err, "size", batch.size)
+	}
+	for conversationID, seqs := range batch.revokes {
+		if err := mc.searchDatabase.DeleteMsgs(batch.ctx, conversationID, seqs); err != nil {
+			log.ZError(batch.ctx, "delete revoked msgs from index failed", err, "conversationID", conversationID, "seqs", seqs)
+		}
+	}
+	for conversationID, seqs := range batch.edits {
+		if err := mc.searchDatabase.ReindexMsgs(batch.ctx, conversationID, utils.Distinct(seqs)); err != nil {
+			log.ZError(batch.ctx, "reindex edited msgs failed", err, "conversationID", conversationID, "seqs", seqs)
+		}
+	}
+	sess.MarkMessage(batch.last)
+	*batch = *newSearchBatch()
+}
+
+func (mc *OnlineMsgSearchConsumerHandler) ConsumeClaim(
+	sess mq.Session,
+	claim mq.Claim,
+) error {
+	log.ZDebug(context.Background(), "online search session msg come", "highWaterMarkOffset",
+		claim.HighWaterMarkOffset(), "topic", claim.Topic(), "partition", claim.Partition())
+	ticker := time.NewTicker(searchFlushInterval)
+	defer ticker.Stop()
+	batch := newSearchBatch()
+	for {
+		select {
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				mc.flush(sess, batch)
+				return nil
+			}
+			ctx := mq.GetContextFromMsg(msg)
+			if len(msg.Value) != 0 {
+				mc.addMsg(ctx, batch, msg)
+			} else {
+				log.ZError(ctx, "search msg get from kafka but is nil", nil, "conversationID", msg.Key)
+				batch.ctx, batch.last = ctx, msg
+			}
+			if batch.size >= searchBatchSize {
+				mc.flush(sess, batch)
+			}
+		case <-ticker.C:
+			mc.flush(sess, batch)
+		case <-sess.Context().Done():
+			mc.flush(sess, batch)
+			return nil
+		}
+	}
+}
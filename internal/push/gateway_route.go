@@ -0,0 +1,73 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/protocol/msggateway"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/log"
+	"google.golang.org/grpc"
+)
+
+// routeOnlinePush pushes msg only to the gateways the routing table lists for the users.
+// It returns false when the table can not be trusted, i.e. some gateway has no heartbeat, and the caller should broadcast.
+func (p *Pusher) routeOnlinePush(ctx context.Context, msg *sdkws.MsgData, conns []*grpc.ClientConn, pushToUserIDs []string) ([]*msggateway.SingleMsgToUserResults, bool) {
+	if p.gatewayRoute == nil || len(conns) == 0 || len(pushToUserIDs) == 0 {
+		return nil, false
+	}
+	nodeConns := make(map[string]*grpc.ClientConn, len(conns))
+	nodes := make([]string, 0, len(conns))
+	for _, conn := range conns {
+		nodeConns[conn.Target()] = conn
+		nodes = append(nodes, conn.Target())
+	}
+	alive, err := p.gatewayRoute.GetAliveNodes(ctx, nodes)
+	if err != nil {
+		log.ZWarn(ctx, "get gateway heartbeat failed, broadcast", err)
+		return nil, false
+	}
+	for _, node := range nodes {
+		if !alive[node] {
+			log.ZDebug(ctx, "gateway route is stale, broadcast", "node", node)
+			return nil, false
+		}
+	}
+	routes, err := p.gatewayRoute.GetUserRoutes(ctx, pushToUserIDs)
+	if err != nil {
+		log.ZWarn(ctx, "get gateway routes failed, broadcast", err)
+		return nil, false
+	}
+	var (
+		usersConns = make(map[*grpc.ClientConn][]string)
+		// users on no gateway still get a result, so they are pushed offline like after a broadcast
+		wsResults []*msggateway.SingleMsgToUserResults
+	)
+	for _, userID := range pushToUserIDs {
+		var routed bool
+		for _, node := range routes[userID] {
+			if conn, ok := nodeConns[node]; ok {
+				usersConns[conn] = append(usersConns[conn], userID)
+				routed = true
+			}
+		}
+		if !routed {
+			wsResults = append(wsResults, &msggateway.SingleMsgToUserResults{UserID: userID})
+		}
+	}
+	log.ZDebug(ctx, "route online push", "gateways", len(usersConns), "offline users", len(wsResults))
+	return append(wsResults, p.batchPushToConns(ctx, msg, usersConns)...), true
+}
@@ -0,0 +1,108 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/msggateway"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+)
+
+const defaultMsgDeliveryStatusExpire = 7 * 24 * time.Hour
+
+// getOnlineDeliveryStatus works out from the online push results how msg reached each of userIDs, the sender excluded.
+// A user the results do not mention, e.g. on a gateway that failed to answer, is counted as offline.
+func getOnlineDeliveryStatus(msg *sdkws.MsgData, userIDs []string, wsResults []*msggateway.SingleMsgToUserResults, now int64) map[string]*cache.MsgDeliveryStatus {
+	statuses := make(map[string]*cache.MsgDeliveryStatus, len(userIDs))
+	for _, userID := range userIDs {
+		if userID == msg.SendID {
+			continue
+		}
+		statuses[userID] = &cache.MsgDeliveryStatus{Status: msgprocessor.MsgDeliveryOffline, UpdateTime: now}
+	}
+	for _, result := range wsResults {
+		status, ok := statuses[result.UserID]
+		if !ok || !result.OnlinePush {
+			continue
+		}
+		// every gateway answers for every user, so a result is only ever upgraded to online
+		status.Status = msgprocessor.MsgDeliveryOnline
+		for _, platform := range result.Resp {
+			if platform.ResultCode == 0 {
+				status.PlatformIDs = append(status.PlatformIDs, platform.RecvPlatFormID)
+			}
+		}
+	}
+	return statuses
+}
+
+// recordOnlineDelivery records how the online push of msg reached userIDs,
+// only for single chats and groups of at most maxRecipients members.
+func (p *Pusher) recordOnlineDelivery(ctx context.Context, msg *sdkws.MsgData, userIDs []string, wsResults []*msggateway.SingleMsgToUserResults) {
+	conf := config.Config.Push.DeliveryStatus
+	if !conf.Enable || msg.Seq == 0 {
+		return
+	}
+	switch msg.SessionType {
+	case constant.SingleChatType:
+	case constant.SuperGroupChatType:
+		if len(userIDs) > conf.MaxRecipients {
+			return
+		}
+	default:
+		return
+	}
+	expire := defaultMsgDeliveryStatusExpire
+	if conf.Expire > 0 {
+		expire = time.Duration(conf.Expire) * time.Second
+	}
+	statuses := getOnlineDeliveryStatus(msg, userIDs, wsResults, time.Now().UnixMilli())
+	if err := p.database.SetMsgDeliveryStatus(ctx, msgprocessor.GetConversationIDByMsg(msg), msg.Seq, statuses, expire); err != nil {
+		log.ZWarn(ctx, "SetMsgDeliveryStatus failed", err, "seq", msg.Seq)
+	}
+}
+
+// recordOfflineDelivery records the outcome of the offline push of msg to userIDs,
+// a msg whose online push was not recorded is left alone.
+func (p *Pusher) recordOfflineDelivery(ctx context.Context, msg *sdkws.MsgData, userIDs []string, pushErr error) {
+	if !config.Config.Push.DeliveryStatus.Enable || msg.Seq == 0 || len(userIDs) == 0 {
+		return
+	}
+	failed := make(map[string]struct{})
+	for _, userID := range offlinepush.FailedUserIDs(pushErr, userIDs) {
+		failed[userID] = struct{}{}
+	}
+	now := time.Now().UnixMilli()
+	statuses := make(map[string]*cache.MsgDeliveryStatus, len(userIDs))
+	for _, userID := range userIDs {
+		status := int32(msgprocessor.MsgDeliveryOfflinePushed)
+		if _, ok := failed[userID]; ok {
+			status = msgprocessor.MsgDeliveryOfflinePushFailed
+		}
+		statuses[userID] = &cache.MsgDeliveryStatus{Status: status, UpdateTime: now}
+	}
+	if err := p.database.UpdateMsgDeliveryStatus(ctx, msgprocessor.GetConversationIDByMsg(msg), msg.Seq, statuses); err != nil {
+		log.ZWarn(ctx, "UpdateMsgDeliveryStatus failed", err, "seq", msg.Seq)
+	}
+}
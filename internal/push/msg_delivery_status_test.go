@@ -0,0 +1,51 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/OpenIMSDK/protocol/msggateway"
+	"github.com/OpenIMSDK/protocol/sdkws"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+)
+
+func TestGetOnlineDeliveryStatus(t *testing.T) {
+	msg := &sdkws.MsgData{SendID: "sender"}
+	wsResults := []*msggateway.SingleMsgToUserResults{
+		{UserID: "sender", OnlinePush: true},
+		{UserID: "online", OnlinePush: true, Resp: []*msggateway.SingleMsgToUserPlatform{
+			{RecvID: "online", RecvPlatFormID: 1},
+			{RecvID: "online", RecvPlatFormID: 5, ResultCode: -2},
+		}},
+		// another gateway the user is not connected to
+		{UserID: "online"},
+		{UserID: "offline"},
+	}
+	statuses := getOnlineDeliveryStatus(msg, []string{"sender", "online", "offline", "missing"}, wsResults, 1)
+	if _, ok := statuses["sender"]; ok {
+		t.Error("the sender should not be recorded")
+	}
+	if s := statuses["online"]; s == nil || s.Status != msgprocessor.MsgDeliveryOnline || !reflect.DeepEqual(s.PlatformIDs, []int32{1}) {
+		t.Errorf("online = %+v", s)
+	}
+	for _, userID := range []string{"offline", "missing"} {
+		if s := statuses[userID]; s == nil || s.Status != msgprocessor.MsgDeliveryOffline {
+			t.Errorf("%s = %+v", userID, s)
+		}
+	}
+}
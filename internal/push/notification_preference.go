@@ -0,0 +1,47 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/utils"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/userext"
+)
+
+// applyNotificationPreferences drops the users whose preferences exclude this offline push
+// and returns the platforms each remaining user turned offline pushes off on.
+func (p *Pusher) applyNotificationPreferences(ctx context.Context, msg *sdkws.MsgData, userIDs []string) ([]string, map[string][]int32) {
+	isGroup := msg.SessionType == constant.SuperGroupChatType || msg.SessionType == constant.GroupChatType
+	atAll := utils.IsContain(constant.AtAllString, msg.AtUserIDList)
+	disabledPlatformIDs := make(map[string][]int32)
+	pushUserIDs := userext.FilterPushUserIDs(ctx, userIDs, p.userRpcClient.GetNotificationPreferences, func(userID string, preference *userext.NotificationPreference) bool {
+		if isGroup && preference.GetGroupMentionOnly() && !atAll && !utils.IsContain(userID, msg.AtUserIDList) {
+			return false
+		}
+		if platformIDs := preference.GetDisabledPushPlatformIDs(); len(platformIDs) > 0 {
+			disabledPlatformIDs[userID] = platformIDs
+		}
+		return true
+	})
+	if len(pushUserIDs) < len(userIDs) {
+		log.ZDebug(ctx, "offline push users excluded by notification preferences", "before", len(userIDs), "after", len(pushUserIDs))
+	}
+	return pushUserIDs, disabledPlatformIDs
+}
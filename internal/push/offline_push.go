@@ -0,0 +1,107 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/conversation"
+	"github.com/OpenIMSDK/protocol/msggateway"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/utils"
+)
+
+// superGroupOfflineTargets is who should get a group message offline, worked out from the online push results
+// alone so every discovery mode decides the same way.
+type superGroupOfflineTargets struct {
+	// members the message did not reach on a mobile platform, the sender excluded
+	offlineUserIDs []string
+	// members whose pc or web connection failed to receive the message
+	webAndPcBackgroundUserIDs []string
+}
+
+func getSuperGroupOfflineTargets(msg *sdkws.MsgData, pushToUserIDs []string, wsResults []*msggateway.SingleMsgToUserResults) *superGroupOfflineTargets {
+	var (
+		targets              = &superGroupOfflineTargets{}
+		onlineSuccessUserIDs = []string{msg.SendID}
+	)
+	for _, v := range wsResults {
+		if v.OnlinePush {
+			if v.UserID != msg.SendID {
+				onlineSuccessUserIDs = append(onlineSuccessUserIDs, v.UserID)
+			}
+			continue
+		}
+		for _, singleResult := range v.Resp {
+			if singleResult.ResultCode != -2 {
+				continue
+			}
+			isPC := constant.PlatformIDToName(int(singleResult.RecvPlatFormID)) == constant.TerminalPC
+			isWebID := singleResult.RecvPlatFormID == constant.WebPlatformID
+			if isPC || isWebID {
+				targets.webAndPcBackgroundUserIDs = append(targets.webAndPcBackgroundUserIDs, v.UserID)
+			}
+		}
+	}
+	// members missing from the results, e.g. on a gateway that failed to answer, are pushed offline too
+	targets.offlineUserIDs = utils.DifferenceString(onlineSuccessUserIDs, pushToUserIDs)
+	return targets
+}
+
+// offlinePush2SuperGroup pushes a group message offline to the members it did not reach online,
+// after the offline push webhook and the conversation receive options filtered them,
+// and pushes it online again to their pc and web connections.
+func (p *Pusher) offlinePush2SuperGroup(ctx context.Context, groupID string, msg *sdkws.MsgData, pushToUserIDs []string, wsResults []*msggateway.SingleMsgToUserResults) error {
+	targets := getSuperGroupOfflineTargets(msg, pushToUserIDs, wsResults)
+	needOfflinePushUserIDs := targets.offlineUserIDs
+	if len(needOfflinePushUserIDs) == 0 {
+		return nil
+	}
+	var offlinePushUserIDs []string
+	if err := callbackOfflinePush(ctx, needOfflinePushUserIDs, msg, &offlinePushUserIDs); err != nil {
+		return err
+	}
+	if len(offlinePushUserIDs) > 0 {
+		needOfflinePushUserIDs = offlinePushUserIDs
+	}
+	if msg.ContentType == constant.SignalingNotification {
+		return nil
+	}
+	resp, err := p.conversationRpcClient.Client.GetConversationOfflinePushUserIDs(
+		ctx,
+		&conversation.GetConversationOfflinePushUserIDsReq{ConversationID: utils.GenGroupConversationID(groupID), UserIDs: needOfflinePushUserIDs},
+	)
+	if err != nil {
+		return err
+	}
+	if len(resp.UserIDs) == 0 {
+		return nil
+	}
+	if err := p.offlinePushMsg(ctx, groupID, msg, resp.UserIDs); err != nil {
+		log.ZError(ctx, "offlinePushMsg failed", err, "groupID", groupID, "msg", msg)
+		return err
+	}
+	rePushUserIDs := utils.IntersectString(resp.UserIDs, targets.webAndPcBackgroundUserIDs)
+	if len(rePushUserIDs) == 0 {
+		return nil
+	}
+	if _, err := p.GetConnsAndOnlinePush(ctx, msg, rePushUserIDs); err != nil {
+		log.ZError(ctx, "offlinePushMsg failed", err, "groupID", groupID, "msg", msg, "userIDs", rePushUserIDs)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mcontext"
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+)
+
+const (
+	offlinePushRetryInterval    = time.Second
+	offlinePushRetryBatch       = 100
+	offlinePushRetryConcurrency = 10
+	// a claimed task is retried by another instance if it is not settled within the lease
+	offlinePushRetryLease = time.Minute
+)
+
+// offlinePushTask is an offline push waiting in the retry queue.
+type offlinePushTask struct {
+	ID             string   `json:"id"`
+	ConversationID string   `json:"conversationID"`
+	UserIDs        []string `json:"userIDs"`
+	Msg            []byte   `json:"msg"`
+	Attempts       int32    `json:"attempts"`
+	LastError      string   `json:"lastError"`
+	OperationID    string   `json:"operationID"`
+	CreateTime     int64    `json:"createTime"`
+}
+
+func offlinePushRetryBackoff(attempts int32) time.Duration {
+	conf := config.Config.Push.Retry
+	backoff := time.Duration(conf.Backoff) * time.Second
+	maxBackoff := time.Duration(conf.MaxBackoff) * time.Second
+	for i := int32(1); i < attempts && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if maxBackoff > 0 && backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	return backoff
+}
+
+// addOfflinePushRetry queues a push that failed on its first attempt.
+func (p *Pusher) addOfflinePushRetry(ctx context.Context, conversationID string, msg *sdkws.MsgData, userIDs []string, pushErr error) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	task := &offlinePushTask{
+		ID:             uuid.New().String(),
+		ConversationID: conversationID,
+		UserIDs:        userIDs,
+		Msg:            data,
+		Attempts:       1,
+		LastError:      pushErr.Error(),
+		OperationID:    mcontext.GetOperationID(ctx),
+		CreateTime:     time.Now().UnixMilli(),
+	}
+	val, err := json.Marshal(task)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return p.retryDatabase.AddRetry(ctx, string(val), time.Now().Add(offlinePushRetryBackoff(task.Attempts)))
+}
+
+// runOfflinePushRetry keeps retrying the due offline pushes of all push instances.
+func (p *Pusher) runOfflinePushRetry() {
+	ticker := time.NewTicker(offlinePushRetryInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		ctx := mcontext.NewCtx("offlinePushRetry")
+		for {
+			tasks, err := p.retryDatabase.ClaimRetry(ctx, offlinePushRetryLease, offlinePushRetryBatch)
+			if err != nil {
+				log.ZError(ctx, "ClaimRetry failed", err)
+				break
+			}
+			var wg errgroup.Group
+			wg.SetLimit(offlinePushRetryConcurrency)
+			for _, task := range tasks {
+				task := task
+				wg.Go(func() error {
+					p.retryOfflinePush(task)
+					return nil
+				})
+			}
+			_ = wg.Wait()
+			if len(tasks) < offlinePushRetryBatch {
+				break
+			}
+		}
+	}
+}
+
+func (p *Pusher) retryOfflinePush(val string) {
+	var task offlinePushTask
+	if err := json.Unmarshal([]byte(val), &task); err != nil {
+		ctx := mcontext.NewCtx("offlinePushRetry")
+		log.ZError(ctx, "invalid offline push retry task, dropped", err, "task", val)
+		if err := p.retryDatabase.RemoveRetry(ctx, val); err != nil {
+			log.ZError(ctx, "RemoveRetry failed", err)
+		}
+		return
+	}
+	ctx := mcontext.NewCtx(task.OperationID)
+	var msg sdkws.MsgData
+	err := proto.Unmarshal(task.Msg, &msg)
+	if err == nil {
+		var failedUserIDs []string
+		if failedUserIDs, err = p.pushOfflineRetry(ctx, &task, &msg); err != nil {
+			task.UserIDs = failedUserIDs
+		}
+	}
+	p.settleOfflinePushRetry(ctx, val, &task, &msg, err)
+}
+
+// pushOfflineRetry pushes msg to the users of task again and returns the users it failed for.
+func (p *Pusher) pushOfflineRetry(ctx context.Context, task *offlinePushTask, msg *sdkws.MsgData) ([]string, error) {
+	batches, opts, err := p.prepareOfflinePush(ctx, task.ConversationID, msg, task.UserIDs)
+	if err != nil {
+		return task.UserIDs, err
+	}
+	// only the users the pusher reports failed are retried, the others were notified already
+	var failedUserIDs []string
+	for _, batch := range batches {
+		perr := p.offlinePusher.Push(ctx, batch.userIDs, batch.title, batch.content, opts)
+		p.recordOfflineDelivery(ctx, msg, batch.userIDs, perr)
+		if perr != nil {
+			err = perr
+			failedUserIDs = append(failedUserIDs, offlinepush.FailedUserIDs(perr, batch.userIDs)...)
+		}
+	}
+	return failedUserIDs, err
+}
+
+// settleOfflinePushRetry removes the claimed task val after a successful push, otherwise it queues the task again
+// or, after the last attempt, moves it to the dead letters.
+func (p *Pusher) settleOfflinePushRetry(ctx context.Context, val string, task *offlinePushTask, msg *sdkws.MsgData, err error) {
+	if err == nil {
+		log.ZInfo(ctx, "offline push retry succeeded", "id", task.ID, "attempts", task.Attempts+1)
+		if err := p.retryDatabase.RemoveRetry(ctx, val); err != nil {
+			log.ZError(ctx, "RemoveRetry failed", err, "id", task.ID)
+		}
+		return
+	}
+	task.Attempts++
+	task.LastError = err.Error()
+	if task.Attempts >= int32(config.Config.Push.Retry.MaxAttempts) {
+		log.ZWarn(ctx, "offline push retries exhausted, moved to dead letter", err, "id", task.ID, "attempts", task.Attempts)
+		letter := &relation.OfflinePushDeadLetterModel{
+			ID:             task.ID,
+			ConversationID: msgprocessor.GetConversationIDByMsg(msg),
+			UserIDs:        task.UserIDs,
+			ClientMsgID:    msg.ClientMsgID,
+			SendID:         msg.SendID,
+			ContentType:    msg.ContentType,
+			Msg:            task.Msg,
+			Attempts:       task.Attempts,
+			LastError:      task.LastError,
+			CreateTime:     time.UnixMilli(task.CreateTime),
+			DeadTime:       time.Now(),
+		}
+		if err := p.retryDatabase.MoveToDeadLetter(ctx, val, letter); err != nil {
+			log.ZError(ctx, "MoveToDeadLetter failed", err, "id", task.ID)
+		}
+		return
+	}
+	log.ZWarn(ctx, "offline push retry failed", err, "id", task.ID, "attempts", task.Attempts)
+	next, err := json.Marshal(task)
+	if err != nil {
+		log.ZError(ctx, "marshal offline push retry task failed", err, "id", task.ID)
+		return
+	}
+	if err := p.retryDatabase.RescheduleRetry(ctx, val, string(next), time.Now().Add(offlinePushRetryBackoff(task.Attempts))); err != nil {
+		log.ZError(ctx, "RescheduleRetry failed", err, "id", task.ID)
+	}
+}
+
+// newReplayTask turns a dead letter back into a task with a fresh set of attempts.
+func newReplayTask(ctx context.Context, letter *relation.OfflinePushDeadLetterModel) (string, error) {
+	var msg sdkws.MsgData
+	if err := proto.Unmarshal(letter.Msg, &msg); err != nil {
+		return "", errs.Wrap(err)
+	}
+	// the same key offlinePushMsg was called with
+	conversationID := msg.SendID
+	if msg.SessionType == constant.SuperGroupChatType {
+		conversationID = msg.GroupID
+	}
+	val, err := json.Marshal(&offlinePushTask{
+		ID:             letter.ID,
+		ConversationID: conversationID,
+		UserIDs:        letter.UserIDs,
+		Msg:            letter.Msg,
+		LastError:      letter.LastError,
+		OperationID:    mcontext.GetOperationID(ctx),
+		CreateTime:     letter.CreateTime.UnixMilli(),
+	})
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	return string(val), nil
+}
@@ -0,0 +1,191 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/userext"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
+)
+
+func TestOfflinePushRetryBackoff(t *testing.T) {
+	config.Config.Push.Retry.Backoff = 10
+	config.Config.Push.Retry.MaxBackoff = 60
+	for attempts, want := range map[int32]time.Duration{
+		1: 10 * time.Second,
+		2: 20 * time.Second,
+		3: 40 * time.Second,
+		4: 60 * time.Second,
+		9: 60 * time.Second,
+	} {
+		if got := offlinePushRetryBackoff(attempts); got != want {
+			t.Errorf("offlinePushRetryBackoff(%d) = %s, want %s", attempts, got, want)
+		}
+	}
+}
+
+// fakeOfflinePusher fails the push for the users in failed and records who was pushed.
+type fakeOfflinePusher struct {
+	failed map[string]bool
+	pushed []string
+}
+
+func (f *fakeOfflinePusher) Push(ctx context.Context, userIDs []string, title, content string, opts *offlinepush.Opts) error {
+	var failedUserIDs []string
+	for _, userID := range userIDs {
+		if f.failed[userID] {
+			failedUserIDs = append(failedUserIDs, userID)
+		} else {
+			f.pushed = append(f.pushed, userID)
+		}
+	}
+	if len(failedUserIDs) > 0 {
+		return &offlinepush.PushError{FailedUserIDs: failedUserIDs, Err: errors.New("push failed")}
+	}
+	return nil
+}
+
+type fakeUserExtClient struct {
+	userext.UserExtClient
+}
+
+func (fakeUserExtClient) GetNotificationPreferences(ctx context.Context, req *userext.GetNotificationPreferencesReq, opts ...grpc.CallOption) (*userext.GetNotificationPreferencesResp, error) {
+	return &userext.GetNotificationPreferencesResp{}, nil
+}
+
+// fakeOfflinePushRetryDatabase is a retry queue without leases, it records how claimed tasks were settled.
+type fakeOfflinePushRetryDatabase struct {
+	controller.OfflinePushRetryDatabase
+	tasks       map[string]time.Time
+	deadLetters []*relation.OfflinePushDeadLetterModel
+}
+
+func (f *fakeOfflinePushRetryDatabase) AddRetry(ctx context.Context, task string, dueAt time.Time) error {
+	f.tasks[task] = dueAt
+	return nil
+}
+
+func (f *fakeOfflinePushRetryDatabase) ClaimRetry(ctx context.Context, lease time.Duration, limit int64) ([]string, error) {
+	var tasks []string
+	for task, dueAt := range f.tasks {
+		if !dueAt.After(time.Now()) && int64(len(tasks)) < limit {
+			tasks = append(tasks, task)
+			f.tasks[task] = time.Now().Add(lease)
+		}
+	}
+	return tasks, nil
+}
+
+func (f *fakeOfflinePushRetryDatabase) RescheduleRetry(ctx context.Context, task string, next string, dueAt time.Time) error {
+	delete(f.tasks, task)
+	f.tasks[next] = dueAt
+	return nil
+}
+
+func (f *fakeOfflinePushRetryDatabase) RemoveRetry(ctx context.Context, tasks ...string) error {
+	for _, task := range tasks {
+		delete(f.tasks, task)
+	}
+	return nil
+}
+
+func (f *fakeOfflinePushRetryDatabase) MoveToDeadLetter(ctx context.Context, task string, letter *relation.OfflinePushDeadLetterModel) error {
+	delete(f.tasks, task)
+	f.deadLetters = append(f.deadLetters, letter)
+	return nil
+}
+
+// claimOfflinePushRetry claims the due tasks and retries them like runOfflinePushRetry.
+func claimOfflinePushRetry(t *testing.T, p *Pusher) int {
+	tasks, err := p.retryDatabase.ClaimRetry(context.Background(), offlinePushRetryLease, offlinePushRetryBatch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, task := range tasks {
+		p.retryOfflinePush(task)
+	}
+	return len(tasks)
+}
+
+func TestOfflinePushRetryOnlyFailedUsers(t *testing.T) {
+	config.Config.Push.Retry.Backoff = 0
+	config.Config.Push.Retry.MaxBackoff = 0
+	config.Config.Push.Retry.MaxAttempts = 3
+	msg := &sdkws.MsgData{SendID: "s", RecvID: "u1", SessionType: constant.SingleChatType, ContentType: constant.Text, Seq: 1}
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pusher := &fakeOfflinePusher{failed: map[string]bool{"u2": true}}
+	retryDatabase := &fakeOfflinePushRetryDatabase{tasks: make(map[string]time.Time)}
+	p := &Pusher{
+		offlinePusher: pusher,
+		retryDatabase: retryDatabase,
+		userRpcClient: &rpcclient.UserRpcClient{ExtClient: fakeUserExtClient{}},
+	}
+	task, err := json.Marshal(&offlinePushTask{ID: "t1", ConversationID: "s", UserIDs: []string{"u1", "u2"}, Msg: data, Attempts: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	retryDatabase.tasks[string(task)] = time.Now()
+
+	// the claimed task is pushed again, u1 is notified and only u2 is queued again
+	if n := claimOfflinePushRetry(t, p); n != 1 {
+		t.Fatalf("claimed %d tasks", n)
+	}
+	if len(pusher.pushed) != 1 || pusher.pushed[0] != "u1" {
+		t.Fatalf("pushed %v", pusher.pushed)
+	}
+	if len(retryDatabase.tasks) != 1 {
+		t.Fatalf("queued %d tasks", len(retryDatabase.tasks))
+	}
+	for val := range retryDatabase.tasks {
+		var next offlinePushTask
+		if err := json.Unmarshal([]byte(val), &next); err != nil {
+			t.Fatal(err)
+		}
+		if next.Attempts != 2 || len(next.UserIDs) != 1 || next.UserIDs[0] != "u2" {
+			t.Fatalf("queued task %+v", next)
+		}
+	}
+
+	// the last attempt fails as well, the task is moved to the dead letters with only u2
+	if n := claimOfflinePushRetry(t, p); n != 1 {
+		t.Fatalf("claimed %d tasks", n)
+	}
+	if len(pusher.pushed) != 1 {
+		t.Fatalf("notified user pushed again, %v", pusher.pushed)
+	}
+	if len(retryDatabase.tasks) != 0 || len(retryDatabase.deadLetters) != 1 {
+		t.Fatalf("tasks %d dead letters %d", len(retryDatabase.tasks), len(retryDatabase.deadLetters))
+	}
+	if letter := retryDatabase.deadLetters[0]; letter.Attempts != 3 || len(letter.UserIDs) != 1 || letter.UserIDs[0] != "u2" {
+		t.Fatalf("dead letter %+v", letter)
+	}
+}
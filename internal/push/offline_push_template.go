@@ -0,0 +1,122 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush"
+	"github.com/openimsdk/open-im-server/v3/internal/push/pushtemplate"
+)
+
+const offlinePushPreviewLen = 100
+
+// offlinePushBatch is the recipients sharing one rendering of an offline push.
+type offlinePushBatch struct {
+	userIDs []string
+	title   string
+	content string
+}
+
+// prepareOfflinePush builds the offline pushes of msg, one per locale of the recipients left after their notification preferences.
+func (p *Pusher) prepareOfflinePush(ctx context.Context, conversationID string, msg *sdkws.MsgData, userIDs []string) ([]*offlinePushBatch, *offlinepush.Opts, error) {
+	title, content, opts, err := p.getOfflinePushInfos(conversationID, msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	userIDs, opts.DisabledPlatformIDs = p.applyNotificationPreferences(ctx, msg, userIDs)
+	if len(userIDs) == 0 {
+		return nil, opts, nil
+	}
+	return p.localizeOfflinePush(ctx, msg, userIDs, title, content), opts, nil
+}
+
+// localizeOfflinePush renders the push in the locale of each recipient, falling back to title and content
+// when the sender set its own offline push info or no template applies.
+func (p *Pusher) localizeOfflinePush(ctx context.Context, msg *sdkws.MsgData, userIDs []string, title, content string) []*offlinePushBatch {
+	fallback := []*offlinePushBatch{{userIDs: userIDs, title: title, content: content}}
+	if p.templates.Empty() || (msg.OfflinePushInfo != nil && msg.OfflinePushInfo.Title != "") {
+		return fallback
+	}
+	locales, err := p.userRpcClient.GetUsersLocale(ctx, userIDs)
+	if err != nil {
+		log.ZWarn(ctx, "GetUsersLocale failed, push in the default locale", err, "userIDs", userIDs)
+		locales = map[string]string{}
+	}
+	groups := make(map[string][]string)
+	var order []string
+	for _, userID := range userIDs {
+		locale := p.templates.Locale(msg.ContentType, locales[userID])
+		if _, ok := groups[locale]; !ok {
+			order = append(order, locale)
+		}
+		groups[locale] = append(groups[locale], userID)
+	}
+	data := &pushtemplate.Data{
+		SenderNickname: msg.SenderNickname,
+		Preview:        offlinePushPreview(msg, title),
+		Default:        title,
+	}
+	if msg.SessionType == constant.SuperGroupChatType || msg.SessionType == constant.GroupChatType {
+		if group, err := p.groupRpcClient.GetGroupInfoCache(ctx, msg.GroupID); err == nil {
+			data.GroupName = group.GroupName
+		} else {
+			log.ZWarn(ctx, "GetGroupInfoCache failed", err, "groupID", msg.GroupID)
+		}
+	}
+	batches := make([]*offlinePushBatch, 0, len(order))
+	for _, locale := range order {
+		batch := &offlinePushBatch{userIDs: groups[locale], title: title, content: content}
+		if t, c, ok, err := p.templates.Render(msg.ContentType, locale, data); err != nil {
+			log.ZWarn(ctx, "render push template failed", err, "contentType", msg.ContentType, "locale", locale)
+		} else if ok {
+			batch.title, batch.content = t, c
+		}
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+// offlinePushPreview is the text of msg cut to offlinePushPreviewLen runes, or def for messages without text.
+func offlinePushPreview(msg *sdkws.MsgData, def string) string {
+	var elem struct {
+		Content string `json:"content"`
+		Text    string `json:"text"`
+	}
+	switch msg.ContentType {
+	case constant.Text, constant.AtText, constant.Quote:
+		if err := json.Unmarshal(msg.Content, &elem); err != nil {
+			return def
+		}
+	default:
+		return def
+	}
+	text := elem.Content
+	if text == "" {
+		text = elem.Text
+	}
+	if text == "" {
+		return def
+	}
+	if runes := []rune(text); len(runes) > offlinePushPreviewLen {
+		return string(runes[:offlinePushPreviewLen]) + "…"
+	}
+	return text
+}
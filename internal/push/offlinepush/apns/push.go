@@ -0,0 +1,214 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+)
+
+const (
+	productionHost  = "https://api.push.apple.com"
+	developmentHost = "https://api.sandbox.push.apple.com"
+
+	maxConcurrentRequests = 16
+	requestTimeout        = 10 * time.Second
+)
+
+var Terminal = []int{constant.IOSPlatformID, constant.IPadPlatformID}
+
+// tokenCache is the part of cache.MsgModel the pusher uses.
+type tokenCache interface {
+	GetApnsToken(ctx context.Context, account string, platformID int) (string, error)
+	DelApnsToken(ctx context.Context, account string, platformID int) error
+	IncrUserBadgeUnreadCountSum(ctx context.Context, userID string) (int, error)
+	GetUserBadgeUnreadCountSum(ctx context.Context, userID string) (int, error)
+}
+
+// Apns pushes to Apple Push Notification service over HTTP/2 with token based authentication.
+type Apns struct {
+	host   string
+	topic  string
+	client *http.Client
+	signer *tokenSigner
+	cache  tokenCache
+}
+
+func NewClient(cache cache.MsgModel) (*Apns, error) {
+	conf := config.Config.Push.Apns
+	key, err := loadPrivateKey(filepath.Join(config.GetProjectRoot(), "config", conf.KeyFile))
+	if err != nil {
+		return nil, err
+	}
+	host := developmentHost
+	if config.Config.IOSPush.Production {
+		host = productionHost
+	}
+	// the default transport negotiates HTTP/2 with the TLS server
+	client := &http.Client{Transport: &http.Transport{ForceAttemptHTTP2: true}, Timeout: requestTimeout}
+	return newApns(host, conf.BundleID, client, &tokenSigner{keyID: conf.KeyID, teamID: conf.TeamID, key: key}, cache), nil
+}
+
+func newApns(host string, topic string, client *http.Client, signer *tokenSigner, cache tokenCache) *Apns {
+	return &Apns{host: host, topic: topic, client: client, signer: signer, cache: cache}
+}
+
+type alert struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body,omitempty"`
+}
+
+type aps struct {
+	Alert alert  `json:"alert"`
+	Sound string `json:"sound,omitempty"`
+	Badge *int   `json:"badge,omitempty"`
+}
+
+type payload struct {
+	Aps aps    `json:"aps"`
+	Ex  string `json:"ex,omitempty"`
+}
+
+type errResp struct {
+	Reason string `json:"reason"`
+}
+
+func (a *Apns) Push(ctx context.Context, userIDs []string, title, content string, opts *offlinepush.Opts) error {
+	sound := opts.IOSPushSound
+	if sound == "" {
+		sound = config.Config.IOSPush.PushSound
+	}
+	var (
+		failed atomic.Int64
+		wg     errgroup.Group
+	)
+	wg.SetLimit(maxConcurrentRequests)
+	for _, userID := range userIDs {
+		var tokens = make(map[int]string)
+		for _, platformID := range Terminal {
+			if opts.PlatformDisabled(userID, platformID) {
+				continue
+			}
+			token, err := a.cache.GetApnsToken(ctx, userID, platformID)
+			if err == nil && token != "" {
+				tokens[platformID] = token
+			}
+		}
+		if len(tokens) == 0 {
+			continue
+		}
+		badge, err := a.badge(ctx, userID, opts.IOSBadgeCount)
+		if err != nil {
+			log.ZWarn(ctx, "apns get badge failed", err, "userID", userID)
+			failed.Add(int64(len(tokens)))
+			continue
+		}
+		body, err := json.Marshal(payload{Aps: aps{Alert: alert{Title: title, Body: content}, Sound: sound, Badge: badge}, Ex: opts.Ex})
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		for platformID, token := range tokens {
+			userID, platformID, token := userID, platformID, token
+			wg.Go(func() error {
+				if err := a.send(ctx, userID, platformID, token, opts.CollapseID, body); err != nil {
+					log.ZWarn(ctx, "apns push failed", err, "userID", userID, "platformID", platformID)
+					failed.Add(1)
+				}
+				return nil
+			})
+		}
+	}
+	_ = wg.Wait()
+	if n := failed.Load(); n > 0 {
+		return errs.ErrInternalServer.Wrap(fmt.Sprintf("apns push failed for %d devices", n))
+	}
+	return nil
+}
+
+func (a *Apns) badge(ctx context.Context, userID string, incr bool) (*int, error) {
+	if incr {
+		count, err := a.cache.IncrUserBadgeUnreadCountSum(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		return &count, nil
+	}
+	count, err := a.cache.GetUserBadgeUnreadCountSum(ctx, userID)
+	if err != nil && errs.Unwrap(err) != redis.Nil {
+		return nil, err
+	}
+	if count == 0 {
+		count = 1
+	}
+	return &count, nil
+}
+
+func (a *Apns) send(ctx context.Context, userID string, platformID int, deviceToken string, collapseID string, body []byte) error {
+	authToken, err := a.signer.Token()
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.host+"/3/device/"+deviceToken, bytes.NewReader(body))
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	req.Header.Set("authorization", "bearer "+authToken)
+	req.Header.Set("apns-topic", a.topic)
+	req.Header.Set("apns-push-type", "alert")
+	req.Header.Set("apns-priority", "10")
+	if collapseID != "" {
+		req.Header.Set("apns-collapse-id", collapseID)
+	}
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+	var reason errResp
+	data, _ := io.ReadAll(resp.Body)
+	_ = json.Unmarshal(data, &reason)
+	switch {
+	case resp.StatusCode == http.StatusGone:
+		// the app was uninstalled or the token is no longer valid for the topic
+		log.ZInfo(ctx, "apns token is no longer valid, removed", "userID", userID, "platformID", platformID, "reason", reason.Reason)
+		if err := a.cache.DelApnsToken(ctx, userID, platformID); err != nil {
+			log.ZWarn(ctx, "DelApnsToken failed", err, "userID", userID, "platformID", platformID)
+		}
+		return nil
+	case resp.StatusCode == http.StatusForbidden && reason.Reason == "ExpiredProviderToken":
+		a.signer.Expire(authToken)
+	}
+	return errs.ErrInternalServer.Wrap(fmt.Sprintf("apns status %d reason %s", resp.StatusCode, reason.Reason))
+}
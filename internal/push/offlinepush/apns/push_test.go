@@ -0,0 +1,217 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apns
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush"
+)
+
+type fakeCache struct {
+	mu     sync.Mutex
+	tokens map[string]string
+	badges map[string]int
+}
+
+func tokenKey(account string, platformID int) string {
+	return account + ":" + constant.PlatformIDToName(platformID)
+}
+
+func (c *fakeCache) GetApnsToken(_ context.Context, account string, platformID int) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.tokens[tokenKey(account, platformID)], nil
+}
+
+func (c *fakeCache) DelApnsToken(_ context.Context, account string, platformID int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.tokens, tokenKey(account, platformID))
+	return nil
+}
+
+func (c *fakeCache) IncrUserBadgeUnreadCountSum(_ context.Context, userID string) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.badges[userID]++
+	return c.badges[userID], nil
+}
+
+func (c *fakeCache) GetUserBadgeUnreadCountSum(_ context.Context, userID string) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.badges[userID], nil
+}
+
+type request struct {
+	proto  int
+	path   string
+	header http.Header
+	body   payload
+}
+
+func newStub(t *testing.T, key *ecdsa.PrivateKey, gone map[string]bool) (*httptest.Server, func() []request) {
+	var (
+		mu       sync.Mutex
+		requests []request
+	)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		var body payload
+		if err := json.Unmarshal(data, &body); err != nil {
+			t.Errorf("invalid payload %s", data)
+		}
+		mu.Lock()
+		requests = append(requests, request{proto: r.ProtoMajor, path: r.URL.Path, header: r.Header.Clone(), body: body})
+		mu.Unlock()
+		auth := strings.TrimPrefix(r.Header.Get("authorization"), "bearer ")
+		_, err := jwt.Parse(auth, func(token *jwt.Token) (any, error) {
+			return &key.PublicKey, nil
+		}, jwt.WithValidMethods([]string{"ES256"}))
+		if err != nil {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"reason":"InvalidProviderToken"}`))
+			return
+		}
+		if gone[strings.TrimPrefix(r.URL.Path, "/3/device/")] {
+			w.WriteHeader(http.StatusGone)
+			_, _ = w.Write([]byte(`{"reason":"Unregistered"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	return server, func() []request {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]request(nil), requests...)
+	}
+}
+
+func TestPush(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server, requests := newStub(t, key, map[string]bool{"stale": true})
+	cache := &fakeCache{
+		tokens: map[string]string{
+			tokenKey("u1", constant.IOSPlatformID):  "iphone",
+			tokenKey("u1", constant.IPadPlatformID): "stale",
+		},
+		badges: map[string]int{},
+	}
+	signer := &tokenSigner{keyID: "KEY123", teamID: "TEAM123", key: key}
+	client := newApns(server.URL, "io.openim.app", server.Client(), signer, cache)
+
+	opts := &offlinepush.Opts{IOSPushSound: "default", IOSBadgeCount: true, Ex: "ex", CollapseID: "client-msg-id"}
+	if err := client.Push(context.Background(), []string{"u1", "u2"}, "title", "content", opts); err != nil {
+		t.Fatal(err)
+	}
+
+	reqs := requests()
+	if len(reqs) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(reqs))
+	}
+	for _, req := range reqs {
+		if req.proto != 2 {
+			t.Errorf("expected HTTP/2, got HTTP/%d", req.proto)
+		}
+		if v := req.header.Get("apns-topic"); v != "io.openim.app" {
+			t.Errorf("apns-topic = %q", v)
+		}
+		if v := req.header.Get("apns-collapse-id"); v != "client-msg-id" {
+			t.Errorf("apns-collapse-id = %q", v)
+		}
+		if v := req.header.Get("apns-push-type"); v != "alert" {
+			t.Errorf("apns-push-type = %q", v)
+		}
+		token, _, err := jwt.NewParser().ParseUnverified(strings.TrimPrefix(req.header.Get("authorization"), "bearer "), jwt.MapClaims{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token.Header["kid"] != "KEY123" || token.Claims.(jwt.MapClaims)["iss"] != "TEAM123" {
+			t.Errorf("unexpected provider token %v %v", token.Header, token.Claims)
+		}
+		if req.body.Aps.Alert.Title != "title" || req.body.Aps.Alert.Body != "content" || req.body.Ex != "ex" {
+			t.Errorf("unexpected payload %+v", req.body)
+		}
+		if req.body.Aps.Badge == nil || *req.body.Aps.Badge != 1 {
+			t.Errorf("unexpected badge %v", req.body.Aps.Badge)
+		}
+	}
+	if _, ok := cache.tokens[tokenKey("u1", constant.IPadPlatformID)]; ok {
+		t.Error("token rejected with 410 was not removed")
+	}
+	if _, ok := cache.tokens[tokenKey("u1", constant.IOSPlatformID)]; !ok {
+		t.Error("valid token was removed")
+	}
+}
+
+func TestPushInvalidProviderToken(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server, _ := newStub(t, key, nil)
+	cache := &fakeCache{tokens: map[string]string{tokenKey("u1", constant.IOSPlatformID): "iphone"}, badges: map[string]int{}}
+	client := newApns(server.URL, "io.openim.app", server.Client(), &tokenSigner{keyID: "KEY123", teamID: "TEAM123", key: other}, cache)
+	if err := client.Push(context.Background(), []string{"u1"}, "title", "content", &offlinepush.Opts{}); err == nil {
+		t.Fatal("expected error for rejected provider token")
+	}
+	if _, ok := cache.tokens[tokenKey("u1", constant.IOSPlatformID)]; !ok {
+		t.Error("device token must be kept when the provider token is rejected")
+	}
+}
+
+func TestTokenSignerCache(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer := &tokenSigner{keyID: "KEY123", teamID: "TEAM123", key: key}
+	first, err := signer.Token()
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, _ := signer.Token()
+	if first != second {
+		t.Error("provider token should be reused until it expires")
+	}
+	signer.Expire(first)
+	if signer.token != "" {
+		t.Error("expired provider token should be dropped")
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apns
+
+import (
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// APNs rejects provider tokens older than an hour and refreshing more often than every 20 minutes.
+const tokenRefreshInterval = 50 * time.Minute
+
+// tokenSigner issues the provider authentication tokens signed with the .p8 key of the team.
+type tokenSigner struct {
+	keyID    string
+	teamID   string
+	key      *ecdsa.PrivateKey
+	mu       sync.Mutex
+	token    string
+	issuedAt time.Time
+}
+
+func loadPrivateKey(path string) (*ecdsa.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return parsePrivateKey(data)
+}
+
+func parsePrivateKey(data []byte) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("apns key is not pem encoded")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("apns key is not an ecdsa key")
+	}
+	return ecKey, nil
+}
+
+// Token returns the current provider token, signing a new one when it is about to expire.
+func (s *tokenSigner) Token() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != "" && time.Since(s.issuedAt) < tokenRefreshInterval {
+		return s.token, nil
+	}
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"iss": s.teamID,
+		"iat": now.Unix(),
+	})
+	token.Header["kid"] = s.keyID
+	signed, err := token.SignedString(s.key)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	s.token, s.issuedAt = signed, now
+	return signed, nil
+}
+
+// Expire drops the current token after APNs rejected it, so the next push signs a new one.
+func (s *tokenSigner) Expire(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token == token {
+		s.token = ""
+	}
+}
@@ -62,6 +62,9 @@ func (f *Fcm) Push(ctx context.Context, userIDs []string, title, content string,
 	for _, account := range userIDs {
 		var personTokens []string
 		for _, v := range Terminal {
+			if opts.PlatformDisabled(account, v) {
+				continue
+			}
 			Token, err := f.cache.GetFcmToken(ctx, account, v)
 			if err == nil {
 				personTokens = append(personTokens, Token)
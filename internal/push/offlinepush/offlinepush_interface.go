@@ -16,22 +16,65 @@ package offlinepush
 
 import (
 	"context"
+	"errors"
 )
 
 // OfflinePusher Offline Pusher.
 type OfflinePusher interface {
+	// Push returns a *PushError when it knows the users the push failed for, other errors fail all userIDs.
 	Push(ctx context.Context, userIDs []string, title, content string, opts *Opts) error
 }
 
+// PushError is a push that reached every user but FailedUserIDs.
+type PushError struct {
+	FailedUserIDs []string
+	Err           error
+}
+
+func (e *PushError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PushError) Unwrap() error {
+	return e.Err
+}
+
+// FailedUserIDs returns the users among userIDs the push failed for, nil when err is nil.
+func FailedUserIDs(err error, userIDs []string) []string {
+	if err == nil {
+		return nil
+	}
+	var pushErr *PushError
+	if errors.As(err, &pushErr) {
+		return pushErr.FailedUserIDs
+	}
+	return userIDs
+}
+
 // Opts opts.
 type Opts struct {
 	Signal        *Signal
 	IOSPushSound  string
 	IOSBadgeCount bool
 	Ex            string
+	// pushes with the same CollapseID replace each other on the device
+	CollapseID string
+	// platforms each user turned offline pushes off on, pushers that push per device skip them,
+	// getui and jpush push per user alias and the user rpc rejects these settings for them
+	DisabledPlatformIDs map[string][]int32
 }
 
 // Signal message id.
 type Signal struct {
 	ClientMsgID string
 }
+
+// PlatformDisabled reports whether userID turned offline pushes off on platformID.
+func (o *Opts) PlatformDisabled(userID string, platformID int) bool {
+	for _, id := range o.DisabledPlatformIDs[userID] {
+		if int(id) == platformID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,201 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/utils"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+)
+
+const (
+	// TimestampHeader carries the unix seconds the payload was signed at.
+	TimestampHeader = "X-OpenIM-Timestamp"
+	// SignatureHeader carries hex(HMAC-SHA256(secret, timestamp + "." + body)).
+	SignatureHeader = "X-OpenIM-Signature"
+
+	defaultBatchSize = 500
+	defaultTimeout   = 5 * time.Second
+	baseBackoff      = 200 * time.Millisecond
+	maxBackoff       = 5 * time.Second
+)
+
+type Opts struct {
+	ClientMsgID   string `json:"clientMsgID,omitempty"`
+	IOSPushSound  string `json:"iosPushSound,omitempty"`
+	IOSBadgeCount bool   `json:"iosBadgeCount"`
+	Ex            string `json:"ex,omitempty"`
+	CollapseID    string `json:"collapseID,omitempty"`
+	// platforms each user turned offline pushes off on
+	DisabledPlatformIDs map[string][]int32 `json:"disabledPlatformIDs,omitempty"`
+}
+
+// Req is the body posted to the push gateway.
+type Req struct {
+	UserIDs []string `json:"userIDs"`
+	Title   string   `json:"title"`
+	Content string   `json:"content"`
+	Opts    Opts     `json:"opts"`
+}
+
+// Resp is the body the push gateway answers with, FailedUserIDs are the users it could not deliver to.
+type Resp struct {
+	FailedUserIDs []string `json:"failedUserIDs"`
+}
+
+// Webhook hands offline pushes to a self-hosted push gateway over http.
+type Webhook struct {
+	url       string
+	secret    string
+	batchSize int
+	maxRetry  int
+	client    *http.Client
+}
+
+func NewClient() *Webhook {
+	conf := config.Config.Push.Webhook
+	timeout := defaultTimeout
+	if conf.Timeout > 0 {
+		timeout = time.Duration(conf.Timeout) * time.Second
+	}
+	return newWebhook(conf.Url, conf.Secret, conf.BatchSize, conf.MaxRetry, &http.Client{Timeout: timeout})
+}
+
+func newWebhook(url string, secret string, batchSize int, maxRetry int, client *http.Client) *Webhook {
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+	return &Webhook{url: url, secret: secret, batchSize: batchSize, maxRetry: maxRetry, client: client}
+}
+
+func (w *Webhook) Push(ctx context.Context, userIDs []string, title, content string, opts *offlinepush.Opts) error {
+	req := Req{
+		Title:   title,
+		Content: content,
+		Opts: Opts{
+			IOSPushSound:  opts.IOSPushSound,
+			IOSBadgeCount: opts.IOSBadgeCount,
+			Ex:            opts.Ex,
+			CollapseID:    opts.CollapseID,
+		},
+	}
+	if opts.Signal != nil {
+		req.Opts.ClientMsgID = opts.Signal.ClientMsgID
+	}
+	var failedUserIDs []string
+	for i := 0; i < len(userIDs); i += w.batchSize {
+		req.UserIDs = userIDs[i:utils.Min(i+w.batchSize, len(userIDs))]
+		req.Opts.DisabledPlatformIDs = nil
+		for _, userID := range req.UserIDs {
+			if platformIDs, ok := opts.DisabledPlatformIDs[userID]; ok {
+				if req.Opts.DisabledPlatformIDs == nil {
+					req.Opts.DisabledPlatformIDs = make(map[string][]int32)
+				}
+				req.Opts.DisabledPlatformIDs[userID] = platformIDs
+			}
+		}
+		resp, err := w.post(ctx, &req)
+		if err != nil {
+			log.ZWarn(ctx, "webhook offline push failed", err, "userIDs", req.UserIDs)
+			failedUserIDs = append(failedUserIDs, req.UserIDs...)
+			continue
+		}
+		failedUserIDs = append(failedUserIDs, resp.FailedUserIDs...)
+	}
+	if len(failedUserIDs) > 0 {
+		return &offlinepush.PushError{
+			FailedUserIDs: failedUserIDs,
+			Err:           errs.ErrInternalServer.Wrap(fmt.Sprintf("webhook offline push failed for users %v", failedUserIDs)),
+		}
+	}
+	return nil
+}
+
+// post sends one batch, retrying with exponential backoff on transport errors, 429 and 5xx.
+func (w *Webhook) post(ctx context.Context, req *Req) (*Resp, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	backoff := baseBackoff
+	for attempt := 0; ; attempt++ {
+		resp, retry, err := w.do(ctx, body)
+		if err == nil || !retry || attempt >= w.maxRetry {
+			return resp, err
+		}
+		log.ZDebug(ctx, "webhook offline push retry", "attempt", attempt+1, "backoff", backoff, "err", err)
+		select {
+		case <-ctx.Done():
+			return nil, errs.Wrap(ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff = utils.Min(backoff*2, maxBackoff)
+	}
+}
+
+func (w *Webhook) do(ctx context.Context, body []byte) (resp *Resp, retry bool, err error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, false, errs.Wrap(err)
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	httpReq.Header.Set("Content-Type", "application/json; charset=utf-8")
+	httpReq.Header.Set(TimestampHeader, timestamp)
+	httpReq.Header.Set(SignatureHeader, Sign(w.secret, timestamp, body))
+	httpResp, err := w.client.Do(httpReq)
+	if err != nil {
+		return nil, true, errs.Wrap(err)
+	}
+	defer httpResp.Body.Close()
+	data, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, true, errs.Wrap(err)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		retry = httpResp.StatusCode == http.StatusTooManyRequests || httpResp.StatusCode >= http.StatusInternalServerError
+		return nil, retry, errs.ErrInternalServer.Wrap(fmt.Sprintf("webhook status %d body %s", httpResp.StatusCode, data))
+	}
+	resp = &Resp{}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, resp); err != nil {
+			return nil, false, errs.Wrap(err)
+		}
+	}
+	return resp, false, nil
+}
+
+// Sign computes the signature the push gateway compares against SignatureHeader.
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,103 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush"
+)
+
+func TestPush(t *testing.T) {
+	var calls atomic.Int32
+	var batches [][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get(SignatureHeader) != Sign("secret", r.Header.Get(TimestampHeader), body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		// the first attempt fails so the batch is retried
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var req Req
+		if err := json.Unmarshal(body, &req); err != nil {
+			t.Error(err)
+		}
+		if req.Title != "title" || req.Content != "content" || req.Opts.ClientMsgID != "client-msg-id" {
+			t.Errorf("unexpected request %+v", req)
+		}
+		batches = append(batches, req.UserIDs)
+		_ = json.NewEncoder(w).Encode(Resp{})
+	}))
+	defer server.Close()
+
+	client := newWebhook(server.URL, "secret", 2, 3, server.Client())
+	opts := &offlinepush.Opts{Signal: &offlinepush.Signal{ClientMsgID: "client-msg-id"}}
+	if err := client.Push(context.Background(), []string{"u1", "u2", "u3"}, "title", "content", opts); err != nil {
+		t.Fatal(err)
+	}
+	if len(batches) != 2 || len(batches[0]) != 2 || len(batches[1]) != 1 {
+		t.Fatalf("unexpected batches %v", batches)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("expected 3 calls, got %d", calls.Load())
+	}
+}
+
+func TestPushFailedUsers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Resp{FailedUserIDs: []string{"u2"}})
+	}))
+	defer server.Close()
+
+	client := newWebhook(server.URL, "secret", 0, 0, server.Client())
+	err := client.Push(context.Background(), []string{"u1", "u2"}, "title", "content", &offlinepush.Opts{})
+	if err == nil {
+		t.Fatal("expected error for failed users")
+	}
+	if failed := offlinepush.FailedUserIDs(err, []string{"u1", "u2"}); len(failed) != 1 || failed[0] != "u2" {
+		t.Fatalf("failed users %v", failed)
+	}
+}
+
+func TestPushNoRetryOnClientError(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	client := newWebhook(server.URL, "secret", 0, 3, server.Client())
+	err := client.Push(context.Background(), []string{"u1"}, "title", "content", &offlinepush.Opts{})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if failed := offlinepush.FailedUserIDs(err, []string{"u1"}); len(failed) != 1 || failed[0] != "u1" {
+		t.Fatalf("failed users %v", failed)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("4xx must not be retried, got %d calls", calls.Load())
+	}
+}
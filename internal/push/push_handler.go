@@ -17,7 +17,6 @@ package push
 import (
 	"context"
 
-	"github.com/IBM/sarama"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/OpenIMSDK/protocol/constant"
@@ -27,11 +26,11 @@ import (
 	"github.com/OpenIMSDK/tools/utils"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
-	kfk "github.com/openimsdk/open-im-server/v3/pkg/common/kafka"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/mq"
 )
 
 type ConsumerHandler struct {
-	pushConsumerGroup *kfk.MConsumerGroup
+	pushConsumerGroup mq.MQConsumer
 	pusher            *Pusher
 }
 
@@ -39,10 +38,7 @@ func NewConsumerHandler(pusher *Pusher) (*ConsumerHandler, error) {
 	var consumerHandler ConsumerHandler
 	consumerHandler.pusher = pusher
 	var err error
-	consumerHandler.pushConsumerGroup, err = kfk.NewMConsumerGroup(&kfk.MConsumerGroupConfig{
-		KafkaVersion:   sarama.V2_0_0_0,
-		OffsetsInitial: sarama.OffsetNewest, IsReturnErr: false,
-	}, []string{config.Config.Kafka.MsgToPush.Topic}, config.Config.Kafka.Addr,
+	consumerHandler.pushConsumerGroup, err = mq.NewMQConsumer([]string{config.Config.Kafka.MsgToPush.Topic},
 		config.Config.Kafka.ConsumerGroupID.MsgToPush)
 	if err != nil {
 		return nil, err
@@ -88,15 +84,12 @@ func (c *ConsumerHandler) handleMs2PsChat(ctx context.Context, msg []byte) {
 		}
 	}
 }
-func (ConsumerHandler) Setup(_ sarama.ConsumerGroupSession) error   { return nil }
-func (ConsumerHandler) Cleanup(_ sarama.ConsumerGroupSession) error { return nil }
-func (c *ConsumerHandler) ConsumeClaim(sess sarama.ConsumerGroupSession,
-	claim sarama.ConsumerGroupClaim,
-) error {
+
+func (c *ConsumerHandler) ConsumeClaim(sess mq.Session, claim mq.Claim) error {
 	for msg := range claim.Messages() {
-		ctx := c.pushConsumerGroup.GetContextFromMsg(msg)
+		ctx := mq.GetContextFromMsg(msg)
 		c.handleMs2PsChat(ctx, msg.Value)
-		sess.MarkMessage(msg, "")
+		sess.MarkMessage(msg)
 	}
 	return nil
 }
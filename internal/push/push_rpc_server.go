@@ -16,6 +16,10 @@ package push
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
 	"sync"
 
 	"github.com/OpenIMSDK/tools/utils"
@@ -25,11 +29,19 @@ import (
 	"github.com/OpenIMSDK/protocol/constant"
 	pbpush "github.com/OpenIMSDK/protocol/push"
 	"github.com/OpenIMSDK/tools/discoveryregistry"
+	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/log"
 
+	"github.com/openimsdk/open-im-server/v3/internal/push/pushtemplate"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/localcache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/unrelation"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/pushext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 )
 
@@ -43,28 +55,67 @@ func Start(client discoveryregistry.SvcDiscoveryRegistry, server *grpc.Server) e
 		return err
 	}
 	cacheModel := cache.NewMsgCacheModel(rdb)
-	offlinePusher := NewOfflinePusher(cacheModel)
+	offlinePusher, err := NewOfflinePusher(cacheModel)
+	if err != nil {
+		return err
+	}
 	database := controller.NewPushDatabase(cacheModel)
+	var retryDatabase controller.OfflinePushRetryDatabase
+	if config.Config.Push.Retry.Enable {
+		mongo, err := unrelation.NewMongo()
+		if err != nil {
+			return err
+		}
+		deadLetterDB, err := mgo.NewOfflinePushDeadLetterMongo(mongo.GetDatabase())
+		if err != nil {
+			return err
+		}
+		retryDatabase = controller.NewOfflinePushRetryDatabase(cache.NewOfflinePushRetryCache(rdb), deadLetterDB)
+	}
 	groupRpcClient := rpcclient.NewGroupRpcClient(client)
 	conversationRpcClient := rpcclient.NewConversationRpcClient(client)
 	msgRpcClient := rpcclient.NewMessageRpcClient(client)
+	userRpcClient := rpcclient.NewUserRpcClient(client)
+	var templates *pushtemplate.Registry
+	if file := config.Config.Push.Template.File; file != "" {
+		templates, err = pushtemplate.Load(filepath.Join(config.GetProjectRoot(), "config", file))
+		if errors.Is(err, fs.ErrNotExist) {
+			// deployments without the file keep pushing the same text to every locale
+			log.ZWarn(context.Background(), "push template file not found, offline pushes are not localized", err, "file", file)
+		} else if err != nil {
+			return err
+		}
+	}
+	var gatewayRoute cache.GatewayRouteCache
+	if config.Config.LongConnSvr.GatewayRoute.Enable {
+		gatewayRoute = cache.NewGatewayRouteCache(rdb)
+	}
 	pusher := NewPusher(
 		client,
 		offlinePusher,
 		database,
+		retryDatabase,
+		gatewayRoute,
 		localcache.NewGroupLocalCache(&groupRpcClient),
 		localcache.NewConversationLocalCache(&conversationRpcClient),
 		&conversationRpcClient,
 		&groupRpcClient,
 		&msgRpcClient,
+		&userRpcClient,
+		templates,
 	)
+	if retryDatabase != nil {
+		go pusher.runOfflinePushRetry()
+	}
 	var wg sync.WaitGroup
 	wg.Add(2)
 	go func() {
 		defer wg.Done()
-		pbpush.RegisterPushMsgServiceServer(server, &pushServer{
+		s := &pushServer{
 			pusher: pusher,
-		})
+		}
+		pbpush.RegisterPushMsgServiceServer(server, s)
+		pushext.RegisterPushExtServer(server, s)
 	}()
 	consumer, err := NewConsumer(pusher)
 	if err != nil {
@@ -109,5 +160,68 @@ func (r *pushServer) DelUserPushToken(
 	if err = r.pusher.database.DelFcmToken(ctx, req.UserID, int(req.PlatformID)); err != nil {
 		return nil, err
 	}
+	if err = r.pusher.database.DelApnsToken(ctx, req.UserID, int(req.PlatformID)); err != nil {
+		return nil, err
+	}
 	return &pbpush.DelUserPushTokenResp{}, nil
 }
+
+func (r *pushServer) GetOfflinePushDeadLetters(ctx context.Context, req *pushext.GetOfflinePushDeadLettersReq) (*pushext.GetOfflinePushDeadLettersResp, error) {
+	if err := authverify.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if r.pusher.retryDatabase == nil {
+		return nil, errs.ErrInternalServer.Wrap("offline push retry is not enabled")
+	}
+	total, letters, err := r.pusher.retryDatabase.SearchDeadLetters(ctx, req.SendID, req.ConversationID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pushext.GetOfflinePushDeadLettersResp{
+		Total:       total,
+		DeadLetters: make([]*pushext.OfflinePushDeadLetter, 0, len(letters)),
+	}
+	for _, letter := range letters {
+		resp.DeadLetters = append(resp.DeadLetters, &pushext.OfflinePushDeadLetter{
+			Id:             letter.ID,
+			ConversationID: letter.ConversationID,
+			UserIDs:        letter.UserIDs,
+			ClientMsgID:    letter.ClientMsgID,
+			SendID:         letter.SendID,
+			ContentType:    letter.ContentType,
+			Attempts:       letter.Attempts,
+			LastError:      letter.LastError,
+			CreateTime:     letter.CreateTime.UnixMilli(),
+			DeadTime:       letter.DeadTime.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
+
+func (r *pushServer) ReplayOfflinePushDeadLetters(ctx context.Context, req *pushext.ReplayOfflinePushDeadLettersReq) (*pushext.ReplayOfflinePushDeadLettersResp, error) {
+	if err := authverify.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if r.pusher.retryDatabase == nil {
+		return nil, errs.ErrInternalServer.Wrap("offline push retry is not enabled")
+	}
+	letters, err := r.pusher.retryDatabase.FindDeadLetters(ctx, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	if ids := utils.Single(req.Ids, utils.Slice(letters, func(letter *relation.OfflinePushDeadLetterModel) string { return letter.ID })); len(ids) > 0 {
+		return nil, errs.ErrRecordNotFound.Wrap(fmt.Sprintf("dead letters %v not found", ids))
+	}
+	tasks := make([]string, 0, len(letters))
+	for _, letter := range letters {
+		task, err := newReplayTask(ctx, letter)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	if err := r.pusher.retryDatabase.ReplayDeadLetters(ctx, req.Ids, tasks); err != nil {
+		return nil, err
+	}
+	return &pushext.ReplayOfflinePushDeadLettersResp{}, nil
+}
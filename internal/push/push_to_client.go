@@ -25,7 +25,6 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/OpenIMSDK/protocol/constant"
-	"github.com/OpenIMSDK/protocol/conversation"
 	"github.com/OpenIMSDK/protocol/msggateway"
 	"github.com/OpenIMSDK/protocol/sdkws"
 	"github.com/OpenIMSDK/tools/discoveryregistry"
@@ -34,10 +33,13 @@ import (
 	"github.com/OpenIMSDK/tools/utils"
 
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/apns"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/dummy"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/fcm"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/getui"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/jpush"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/webhook"
+	"github.com/openimsdk/open-im-server/v3/internal/push/pushtemplate"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
@@ -49,6 +51,8 @@ import (
 
 type Pusher struct {
 	database               controller.PushDatabase
+	retryDatabase          controller.OfflinePushRetryDatabase
+	gatewayRoute           cache.GatewayRouteCache
 	discov                 discoveryregistry.SvcDiscoveryRegistry
 	offlinePusher          offlinepush.OfflinePusher
 	groupLocalCache        *localcache.GroupLocalCache
@@ -56,39 +60,50 @@ type Pusher struct {
 	msgRpcClient           *rpcclient.MessageRpcClient
 	conversationRpcClient  *rpcclient.ConversationRpcClient
 	groupRpcClient         *rpcclient.GroupRpcClient
+	userRpcClient          *rpcclient.UserRpcClient
+	templates              *pushtemplate.Registry
 }
 
 var errNoOfflinePusher = errors.New("no offlinePusher is configured")
 
 func NewPusher(discov discoveryregistry.SvcDiscoveryRegistry, offlinePusher offlinepush.OfflinePusher, database controller.PushDatabase,
+	retryDatabase controller.OfflinePushRetryDatabase, gatewayRoute cache.GatewayRouteCache,
 	groupLocalCache *localcache.GroupLocalCache, conversationLocalCache *localcache.ConversationLocalCache,
 	conversationRpcClient *rpcclient.ConversationRpcClient, groupRpcClient *rpcclient.GroupRpcClient, msgRpcClient *rpcclient.MessageRpcClient,
+	userRpcClient *rpcclient.UserRpcClient, templates *pushtemplate.Registry,
 ) *Pusher {
 	return &Pusher{
 		discov:                 discov,
 		database:               database,
+		retryDatabase:          retryDatabase,
+		gatewayRoute:           gatewayRoute,
 		offlinePusher:          offlinePusher,
 		groupLocalCache:        groupLocalCache,
 		conversationLocalCache: conversationLocalCache,
 		msgRpcClient:           msgRpcClient,
 		conversationRpcClient:  conversationRpcClient,
 		groupRpcClient:         groupRpcClient,
+		userRpcClient:          userRpcClient,
+		templates:              templates,
 	}
 }
 
-func NewOfflinePusher(cache cache.MsgModel) offlinepush.OfflinePusher {
-	var offlinePusher offlinepush.OfflinePusher
+func NewOfflinePusher(cache cache.MsgModel) (offlinePusher offlinepush.OfflinePusher, err error) {
 	switch config.Config.Push.Enable {
 	case "getui":
 		offlinePusher = getui.NewClient(cache)
 	case "fcm":
 		offlinePusher = fcm.NewClient(cache)
+	case "apns":
+		offlinePusher, err = apns.NewClient(cache)
 	case "jpush":
 		offlinePusher = jpush.NewClient()
+	case "webhook":
+		offlinePusher = webhook.NewClient()
 	default:
 		offlinePusher = dummy.NewClient()
 	}
-	return offlinePusher
+	return offlinePusher, err
 }
 
 func (p *Pusher) DeleteMemberAndSetConversationSeq(ctx context.Context, groupID string, userIDs []string) error {
@@ -110,6 +125,7 @@ func (p *Pusher) Push2User(ctx context.Context, userIDs []string, msg *sdkws.Msg
 	if err != nil {
 		return err
 	}
+	p.recordOnlineDelivery(ctx, msg, userIDs, wsResults)
 
 	isOfflinePush := utils.GetSwitchFromOptions(msg.Options, constant.IsOfflinePush)
 	log.ZDebug(ctx, "push_result", "ws push result", wsResults, "sendData", msg, "isOfflinePush", isOfflinePush, "push_to_userID", userIDs)
@@ -150,47 +166,6 @@ func (p *Pusher) UnmarshalNotificationElem(bytes []byte, t any) error {
 	return json.Unmarshal([]byte(notification.Detail), t)
 }
 
-/*
-k8s deployment,offline push group messages function.
-*/
-func (p *Pusher) k8sOfflinePush2SuperGroup(ctx context.Context, groupID string, msg *sdkws.MsgData, wsResults []*msggateway.SingleMsgToUserResults) error {
-
-	var needOfflinePushUserIDs []string
-	for _, v := range wsResults {
-		if !v.OnlinePush {
-			needOfflinePushUserIDs = append(needOfflinePushUserIDs, v.UserID)
-		}
-	}
-	if len(needOfflinePushUserIDs) > 0 {
-		var offlinePushUserIDs []string
-		err := callbackOfflinePush(ctx, needOfflinePushUserIDs, msg, &offlinePushUserIDs)
-		if err != nil {
-			return err
-		}
-
-		if len(offlinePushUserIDs) > 0 {
-			needOfflinePushUserIDs = offlinePushUserIDs
-		}
-		if msg.ContentType != constant.SignalingNotification {
-			resp, err := p.conversationRpcClient.Client.GetConversationOfflinePushUserIDs(
-				ctx,
-				&conversation.GetConversationOfflinePushUserIDsReq{ConversationID: utils.GenGroupConversationID(groupID), UserIDs: needOfflinePushUserIDs},
-			)
-			if err != nil {
-				return err
-			}
-			if len(resp.UserIDs) > 0 {
-				err = p.offlinePushMsg(ctx, groupID, msg, resp.UserIDs)
-				if err != nil {
-					log.ZError(ctx, "offlinePushMsg failed", err, "groupID", groupID, "msg", msg)
-					return err
-				}
-			}
-		}
-
-	}
-	return nil
-}
 func (p *Pusher) Push2SuperGroup(ctx context.Context, groupID string, msg *sdkws.MsgData) (err error) {
 	log.ZDebug(ctx, "Get super group msg from msg_transfer and push msg", "msg", msg.String(), "groupID", groupID)
 	var pushToUserIDs []string
@@ -254,82 +229,13 @@ func (p *Pusher) Push2SuperGroup(ctx context.Context, groupID string, msg *sdkws
 	if err != nil {
 		return err
 	}
+	p.recordOnlineDelivery(ctx, msg, pushToUserIDs, wsResults)
 
 	log.ZDebug(ctx, "get conn and online push success", "result", wsResults, "msg", msg)
-	isOfflinePush := utils.GetSwitchFromOptions(msg.Options, constant.IsOfflinePush)
-	if isOfflinePush && config.Config.Envs.Discovery == "k8s" {
-		return p.k8sOfflinePush2SuperGroup(ctx, groupID, msg, wsResults)
-	}
-	if isOfflinePush && config.Config.Envs.Discovery == "zookeeper" {
-		var (
-			onlineSuccessUserIDs      = []string{msg.SendID}
-			webAndPcBackgroundUserIDs []string
-		)
-
-		for _, v := range wsResults {
-			if v.OnlinePush && v.UserID != msg.SendID {
-				onlineSuccessUserIDs = append(onlineSuccessUserIDs, v.UserID)
-			}
-
-			if v.OnlinePush {
-				continue
-			}
-
-			if len(v.Resp) == 0 {
-				continue
-			}
-
-			for _, singleResult := range v.Resp {
-				if singleResult.ResultCode != -2 {
-					continue
-				}
-
-				isPC := constant.PlatformIDToName(int(singleResult.RecvPlatFormID)) == constant.TerminalPC
-				isWebID := singleResult.RecvPlatFormID == constant.WebPlatformID
-
-				if isPC || isWebID {
-					webAndPcBackgroundUserIDs = append(webAndPcBackgroundUserIDs, v.UserID)
-				}
-			}
-		}
-
-		needOfflinePushUserIDs := utils.DifferenceString(onlineSuccessUserIDs, pushToUserIDs)
-
-		// Use offline push messaging
-		if len(needOfflinePushUserIDs) > 0 {
-			var offlinePushUserIDs []string
-			err = callbackOfflinePush(ctx, needOfflinePushUserIDs, msg, &offlinePushUserIDs)
-			if err != nil {
-				return err
-			}
-
-			if len(offlinePushUserIDs) > 0 {
-				needOfflinePushUserIDs = offlinePushUserIDs
-			}
-			if msg.ContentType != constant.SignalingNotification {
-				resp, err := p.conversationRpcClient.Client.GetConversationOfflinePushUserIDs(
-					ctx,
-					&conversation.GetConversationOfflinePushUserIDsReq{ConversationID: utils.GenGroupConversationID(groupID), UserIDs: needOfflinePushUserIDs},
-				)
-				if err != nil {
-					return err
-				}
-				if len(resp.UserIDs) > 0 {
-					err = p.offlinePushMsg(ctx, groupID, msg, resp.UserIDs)
-					if err != nil {
-						log.ZError(ctx, "offlinePushMsg failed", err, "groupID", groupID, "msg", msg)
-						return err
-					}
-					if _, err := p.GetConnsAndOnlinePush(ctx, msg, utils.IntersectString(resp.UserIDs, webAndPcBackgroundUserIDs)); err != nil {
-						log.ZError(ctx, "offlinePushMsg failed", err, "groupID", groupID, "msg", msg, "userIDs", utils.IntersectString(needOfflinePushUserIDs, webAndPcBackgroundUserIDs))
-						return err
-					}
-				}
-			}
-
-		}
+	if !utils.GetSwitchFromOptions(msg.Options, constant.IsOfflinePush) {
+		return nil
 	}
-	return nil
+	return p.offlinePush2SuperGroup(ctx, groupID, msg, pushToUserIDs, wsResults)
 }
 
 func (p *Pusher) k8sOnlinePush(ctx context.Context, msg *sdkws.MsgData, pushToUserIDs []string) (wsResults []*msggateway.SingleMsgToUserResults, err error) {
@@ -354,6 +260,11 @@ func (p *Pusher) k8sOnlinePush(ctx context.Context, msg *sdkws.MsgData, pushToUs
 		tconn, _ := p.discov.GetConn(ctx, host)
 		usersConns[tconn] = userIds
 	}
+	return p.batchPushToConns(ctx, msg, usersConns), nil
+}
+
+// batchPushToConns pushes msg to the gateways, each gateway only for its users.
+func (p *Pusher) batchPushToConns(ctx context.Context, msg *sdkws.MsgData, usersConns map[*grpc.ClientConn][]string) (wsResults []*msggateway.SingleMsgToUserResults) {
 	var (
 		mu         sync.Mutex
 		wg         = errgroup.Group{}
@@ -383,8 +294,9 @@ func (p *Pusher) k8sOnlinePush(ctx context.Context, msg *sdkws.MsgData, pushToUs
 		})
 	}
 	_ = wg.Wait()
-	return wsResults, nil
+	return wsResults
 }
+
 func (p *Pusher) GetConnsAndOnlinePush(ctx context.Context, msg *sdkws.MsgData, pushToUserIDs []string) (wsResults []*msggateway.SingleMsgToUserResults, err error) {
 	if config.Config.Envs.Discovery == "k8s" {
 		return p.k8sOnlinePush(ctx, msg, pushToUserIDs)
@@ -394,6 +306,9 @@ func (p *Pusher) GetConnsAndOnlinePush(ctx context.Context, msg *sdkws.MsgData,
 	if err != nil {
 		return nil, err
 	}
+	if wsResults, ok := p.routeOnlinePush(ctx, msg, conns, pushToUserIDs); ok {
+		return wsResults, nil
+	}
 
 	var (
 		mu         sync.Mutex
@@ -436,20 +351,36 @@ func (p *Pusher) GetConnsAndOnlinePush(ctx context.Context, msg *sdkws.MsgData,
 }
 
 func (p *Pusher) offlinePushMsg(ctx context.Context, conversationID string, msg *sdkws.MsgData, offlinePushUserIDs []string) error {
-	title, content, opts, err := p.getOfflinePushInfos(conversationID, msg)
+	batches, opts, err := p.prepareOfflinePush(ctx, conversationID, msg, offlinePushUserIDs)
 	if err != nil {
 		return err
 	}
-	err = p.offlinePusher.Push(ctx, offlinePushUserIDs, title, content, opts)
-	if err != nil {
+	var pushErr error
+	for _, batch := range batches {
+		err := p.offlinePusher.Push(ctx, batch.userIDs, batch.title, batch.content, opts)
+		p.recordOfflineDelivery(ctx, msg, batch.userIDs, err)
+		if err == nil {
+			continue
+		}
 		prommetrics.MsgOfflinePushFailedCounter.Inc()
-		return err
+		if p.retryDatabase == nil {
+			pushErr = err
+			continue
+		}
+		// the users the pusher reached are not pushed again
+		failedUserIDs := offlinepush.FailedUserIDs(err, batch.userIDs)
+		if rerr := p.addOfflinePushRetry(ctx, conversationID, msg, failedUserIDs, err); rerr != nil {
+			log.ZError(ctx, "addOfflinePushRetry failed", rerr, "userIDs", failedUserIDs)
+			pushErr = err
+			continue
+		}
+		log.ZWarn(ctx, "offline push failed, queued for retry", err, "userIDs", failedUserIDs)
 	}
-	return nil
+	return pushErr
 }
 
 func (p *Pusher) GetOfflinePushOpts(msg *sdkws.MsgData) (opts *offlinepush.Opts, err error) {
-	opts = &offlinepush.Opts{Signal: &offlinepush.Signal{}}
+	opts = &offlinepush.Opts{Signal: &offlinepush.Signal{}, CollapseID: msg.ClientMsgID}
 	// if msg.ContentType > constant.SignalingNotificationBegin && msg.ContentType < constant.SignalingNotificationEnd {
 	// 	req := &sdkws.SignalReq{}
 	// 	if err := proto.Unmarshal(msg.Content, req); err != nil {
@@ -0,0 +1,188 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pushtemplate renders offline push titles and bodies in the locale of each recipient.
+package pushtemplate
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"gopkg.in/yaml.v3"
+)
+
+// AnyContentType is the content type key of templates used for every content type without its own templates.
+const AnyContentType = 0
+
+// Data is what a template can render.
+type Data struct {
+	SenderNickname string
+	// GroupName is empty for single chats
+	GroupName string
+	// Preview is the text of the message, or its type such as [Picture] for messages without text
+	Preview string
+	// Default is the title used when no template applies
+	Default string
+}
+
+type pushTemplate struct {
+	title *template.Template
+	body  *template.Template
+}
+
+// Registry holds the templates keyed by content type and locale.
+type Registry struct {
+	defaultLocale string
+	templates     map[int32]map[string]*pushTemplate
+}
+
+type templateFile struct {
+	DefaultLocale string                                   `yaml:"defaultLocale"`
+	Templates     map[int32]map[string]templateFileContent `yaml:"templates"`
+}
+
+type templateFileContent struct {
+	Title string `yaml:"title"`
+	Body  string `yaml:"body"`
+}
+
+// Load reads the registry from a yaml file.
+func Load(path string) (*Registry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return Parse(data)
+}
+
+// Parse builds the registry from yaml such as
+//
+//	defaultLocale: en
+//	templates:
+//	  101:
+//	    en: {title: "{{.SenderNickname}}", body: "{{.Preview}}"}
+//	    zh-CN: {title: "{{.SenderNickname}}", body: "{{.Preview}}"}
+//	  0:
+//	    en: {title: "New message"}
+func Parse(data []byte) (*Registry, error) {
+	var f templateFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	r := &Registry{
+		defaultLocale: normalize(f.DefaultLocale),
+		templates:     make(map[int32]map[string]*pushTemplate, len(f.Templates)),
+	}
+	for contentType, locales := range f.Templates {
+		r.templates[contentType] = make(map[string]*pushTemplate, len(locales))
+		for locale, content := range locales {
+			name := fmt.Sprintf("%d/%s", contentType, locale)
+			if content.Title == "" {
+				return nil, errs.Wrap(fmt.Errorf("push template %s has no title", name))
+			}
+			title, err := template.New(name + "/title").Option("missingkey=zero").Parse(content.Title)
+			if err != nil {
+				return nil, errs.Wrap(err)
+			}
+			t := &pushTemplate{title: title}
+			if content.Body != "" {
+				if t.body, err = template.New(name + "/body").Option("missingkey=zero").Parse(content.Body); err != nil {
+					return nil, errs.Wrap(err)
+				}
+			}
+			r.templates[contentType][normalize(locale)] = t
+		}
+	}
+	return r, nil
+}
+
+// Empty reports whether the registry has no templates, so pushes need no locale lookup.
+func (r *Registry) Empty() bool {
+	return r == nil || len(r.templates) == 0
+}
+
+// Render renders the push of contentType in locale, ok is false when no template applies.
+// The body falls back to the title when the template has none.
+func (r *Registry) Render(contentType int32, locale string, data *Data) (title, body string, ok bool, err error) {
+	t := r.lookup(contentType, locale)
+	if t == nil {
+		return "", "", false, nil
+	}
+	if title, err = execute(t.title, data); err != nil {
+		return "", "", false, err
+	}
+	body = title
+	if t.body != nil {
+		if body, err = execute(t.body, data); err != nil {
+			return "", "", false, err
+		}
+	}
+	return title, body, true, nil
+}
+
+// Locale returns the key pushes to locale are grouped by, locales rendering the same templates share a key.
+func (r *Registry) Locale(contentType int32, locale string) string {
+	for _, candidate := range r.candidates(locale) {
+		if r.templates[contentType][candidate] != nil || r.templates[AnyContentType][candidate] != nil {
+			return candidate
+		}
+	}
+	return ""
+}
+
+func (r *Registry) lookup(contentType int32, locale string) *pushTemplate {
+	if r.Empty() {
+		return nil
+	}
+	for _, candidate := range r.candidates(locale) {
+		if t := r.templates[contentType][candidate]; t != nil {
+			return t
+		}
+		if t := r.templates[AnyContentType][candidate]; t != nil {
+			return t
+		}
+	}
+	return nil
+}
+
+// candidates lists the locales tried in order: the locale, its language, the default locale and its language.
+func (r *Registry) candidates(locale string) []string {
+	candidates := make([]string, 0, 4)
+	for _, l := range []string{normalize(locale), r.defaultLocale} {
+		if l == "" {
+			continue
+		}
+		candidates = append(candidates, l)
+		if i := strings.IndexByte(l, '-'); i > 0 {
+			candidates = append(candidates, l[:i])
+		}
+	}
+	return candidates
+}
+
+func normalize(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
+
+func execute(t *template.Template, data *Data) (string, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return buf.String(), nil
+}
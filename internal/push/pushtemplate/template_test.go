@@ -0,0 +1,116 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pushtemplate
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+const testTemplates = `
+defaultLocale: en
+templates:
+  101:
+    en:
+      title: "{{.SenderNickname}}"
+      body: "{{.Preview}}"
+    zh-CN:
+      title: "{{.SenderNickname}} 发来消息"
+      body: "{{.Preview}}"
+    zh:
+      title: "{{.SenderNickname}}"
+      body: "中文: {{.Preview}}"
+  0:
+    en:
+      title: "{{.Default}}"
+    de:
+      title: "Neue Nachricht"
+`
+
+func TestRender(t *testing.T) {
+	r, err := Parse([]byte(testTemplates))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := &Data{SenderNickname: "alice", Preview: "hi", Default: "[NEWMSG]"}
+	cases := []struct {
+		contentType int32
+		locale      string
+		title, body string
+	}{
+		{101, "zh-CN", "alice 发来消息", "hi"},
+		{101, "zh_cn", "alice 发来消息", "hi"},
+		{101, "zh-TW", "alice", "中文: hi"},
+		{101, "fr", "alice", "hi"},
+		{101, "", "alice", "hi"},
+		{102, "de-AT", "Neue Nachricht", "Neue Nachricht"},
+		{102, "ja", "[NEWMSG]", "[NEWMSG]"},
+	}
+	for _, c := range cases {
+		title, body, ok, err := r.Render(c.contentType, c.locale, data)
+		if err != nil || !ok {
+			t.Fatalf("Render(%d, %q) ok=%v err=%v", c.contentType, c.locale, ok, err)
+		}
+		if title != c.title || body != c.body {
+			t.Errorf("Render(%d, %q) = %q, %q, want %q, %q", c.contentType, c.locale, title, body, c.title, c.body)
+		}
+	}
+}
+
+func TestLocaleGrouping(t *testing.T) {
+	r, err := Parse([]byte(testTemplates))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// locales falling back to the same templates share a group so they are pushed in one batch
+	if a, b := r.Locale(101, "fr"), r.Locale(101, "ja"); a != b || a != "en" {
+		t.Errorf("fr and ja should both use en, got %q and %q", a, b)
+	}
+	if a, b := r.Locale(101, "zh-TW"), r.Locale(101, "zh-HK"); a != b || a != "zh" {
+		t.Errorf("zh-TW and zh-HK should both use zh, got %q and %q", a, b)
+	}
+	if got := r.Locale(101, "zh-CN"); got != "zh-cn" {
+		t.Errorf("zh-CN has its own templates, got %q", got)
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	var r *Registry
+	if !r.Empty() {
+		t.Error("nil registry should be empty")
+	}
+	if _, _, ok, err := r.Render(101, "en", &Data{}); ok || err != nil {
+		t.Errorf("nil registry rendered ok=%v err=%v", ok, err)
+	}
+	if _, err := Parse([]byte("templates:\n  101:\n    en:\n      body: x\n")); err == nil {
+		t.Error("templates without a title should be rejected")
+	}
+}
+
+func TestShippedTemplates(t *testing.T) {
+	_, file, _, _ := runtime.Caller(0)
+	r, err := Load(filepath.Join(filepath.Dir(file), "../../../deployments/templates/push-template.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	title, body, ok, err := r.Render(101, "zh-CN", &Data{SenderNickname: "bob", GroupName: "team", Preview: "hello"})
+	if err != nil || !ok {
+		t.Fatalf("ok=%v err=%v", ok, err)
+	}
+	if title != "team" || body != "bob: hello" {
+		t.Errorf("got %q, %q", title, body)
+	}
+}
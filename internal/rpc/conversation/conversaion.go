@@ -39,6 +39,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
 	tablerelation "github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/userext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient/notification"
 )
@@ -423,9 +424,6 @@ func (c *conversationServer) GetConversationOfflinePushUserIDs(
 	if err != nil {
 		return nil, err
 	}
-	if len(userIDs) == 0 {
-		return &pbconversation.GetConversationOfflinePushUserIDsResp{UserIDs: req.UserIDs}, nil
-	}
 	userIDSet := make(map[string]struct{})
 	for _, userID := range req.UserIDs {
 		userIDSet[userID] = struct{}{}
@@ -433,7 +431,8 @@ func (c *conversationServer) GetConversationOfflinePushUserIDs(
 	for _, userID := range userIDs {
 		delete(userIDSet, userID)
 	}
-	return &pbconversation.GetConversationOfflinePushUserIDsResp{UserIDs: utils.Keys(userIDSet)}, nil
+	pushUserIDs := userext.FilterPushUserIDs(ctx, utils.Keys(userIDSet), c.user.GetNotificationPreferences, nil)
+	return &pbconversation.GetConversationOfflinePushUserIDsResp{UserIDs: pushUserIDs}, nil
 }
 
 func (c *conversationServer) conversationSort(
@@ -34,6 +34,7 @@ import (
 
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/groupext"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient/notification"
 
@@ -97,6 +98,7 @@ func Start(client discoveryregistry.SvcDiscoveryRegistry, server *grpc.Server) e
 	gs.conversationRpcClient = conversationRpcClient
 	gs.msgRpcClient = msgRpcClient
 	pbgroup.RegisterGroupServer(server, &gs)
+	groupext.RegisterGroupExtServer(server, &gs)
 	return nil
 }
 
@@ -152,6 +154,13 @@ func (s *groupServer) CheckGroupAdmin(ctx context.Context, groupID string) error
 	return nil
 }
 
+func (s *groupServer) VerifyGroupAdmin(ctx context.Context, req *groupext.VerifyGroupAdminReq) (*groupext.VerifyGroupAdminResp, error) {
+	if err := s.CheckGroupAdmin(ctx, req.GroupID); err != nil {
+		return nil, err
+	}
+	return &groupext.VerifyGroupAdminResp{}, nil
+}
+
 func (s *groupServer) GetPublicUserInfoMap(ctx context.Context, userIDs []string, complete bool) (map[string]*sdkws.PublicUserInfo, error) {
 	if len(userIDs) == 0 {
 		return map[string]*sdkws.PublicUserInfo{}, nil
@@ -30,6 +30,7 @@ import (
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/http"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/msgext"
 )
 
 func cbURL() string {
@@ -204,3 +205,25 @@ func CallbackAfterRevokeMsg(ctx context.Context, req *pbchat.RevokeMsgReq) error
 	}
 	return nil
 }
+
+func CallbackBeforeEditMsg(ctx context.Context, req *msgext.EditMsgReq, msg *sdkws.MsgData) error {
+	if !config.Config.Callback.CallbackBeforeEditMsg.Enable {
+		return nil
+	}
+	callbackReq := &cbapi.CallbackBeforeEditMsgReq{
+		CallbackCommand: cbapi.CallbackBeforeEditMsgCommand,
+		ConversationID:  req.ConversationID,
+		Seq:             req.Seq,
+		UserID:          req.UserID,
+		SendID:          msg.SendID,
+		SessionType:     msg.SessionType,
+		ContentType:     msg.ContentType,
+		Content:         req.Content,
+	}
+	resp := &cbapi.CallbackBeforeEditMsgResp{}
+	if err := http.CallBackPostReturn(ctx, cbURL(), callbackReq, resp, config.Config.Callback.CallbackBeforeEditMsg); err != nil {
+		return err
+	}
+	utils.NotNilReplace(&req.Content, resp.Content)
+	return nil
+}
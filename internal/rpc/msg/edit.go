@@ -0,0 +1,163 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/utils"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	unrelationtb "github.com/openimsdk/open-im-server/v3/pkg/common/db/table/unrelation"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/msgext"
+)
+
+func (m *msgServer) checkConversationMember(ctx context.Context, userID, conversationID string) error {
+	if authverify.IsAppManagerUid(ctx) {
+		return nil
+	}
+	// thread membership follows the conversation the thread belongs to
+	if parentConversationID, _, ok := msgprocessor.ParseThreadConversationID(conversationID); ok {
+		conversationID = parentConversationID
+	}
+	conversationIDs, err := m.ConversationLocalCache.GetConversationIDs(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !utils.Contain(conversationID, conversationIDs...) {
+		return errs.ErrNoPermission.Wrap("not in conversation")
+	}
+	return nil
+}
+
+func (m *msgServer) EditMsg(ctx context.Context, req *msgext.EditMsgReq) (*msgext.EditMsgResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := m.checkConversationMember(ctx, req.UserID, req.ConversationID); err != nil {
+		return nil, err
+	}
+	user, err := m.User.GetUserInfo(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, req.ConversationID, []int64{req.Seq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil {
+		return nil, errs.ErrRecordNotFound.Wrap("msg not found")
+	}
+	msg := msgs[0]
+	switch msg.ContentType {
+	case constant.Text, constant.AtText, constant.Quote:
+	case constant.MsgRevokeNotification:
+		return nil, errs.ErrMsgAlreadyRevoke.Wrap("msg already revoke")
+	default:
+		return nil, errs.ErrArgs.Wrap("msg content type can not be edited")
+	}
+	if !authverify.IsAppManagerUid(ctx) {
+		if msg.SendID != req.UserID {
+			return nil, errs.ErrNoPermission.Wrap("only the sender can edit the msg")
+		}
+		window := config.Config.MsgEdit.TimeWindow
+		if window > 0 && time.Since(time.UnixMilli(msg.SendTime)) > time.Duration(window)*time.Second {
+			return nil, errs.ErrNoPermission.Wrap("msg edit time window has passed")
+		}
+	}
+	if err := CallbackBeforeEditMsg(ctx, req, msg); err != nil {
+		return nil, err
+	}
+	if !json.Valid([]byte(req.Content)) {
+		return nil, errs.ErrArgs.Wrap("content is not json")
+	}
+	content, words, text, err := m.moderateContent(msg.ContentType, []byte(req.Content))
+	if err != nil {
+		return nil, err
+	}
+	req.Content = string(content)
+	record := newModerationRecord(msg, req.ConversationID, text, words)
+	now := time.Now().UnixMilli()
+	err = m.MsgDatabase.EditMsg(ctx, req.ConversationID, req.Seq, &unrelationtb.EditModel{
+		UserID:      req.UserID,
+		Nickname:    user.Nickname,
+		Content:     req.Content,
+		PrevContent: string(msg.Content),
+		Time:        now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	m.recordModeration(ctx, record)
+	tips := msgext.MsgEditedTips{
+		EditorUserID:   req.UserID,
+		ClientMsgID:    msg.ClientMsgID,
+		Seq:            req.Seq,
+		ConversationID: req.ConversationID,
+		SessionType:    msg.SessionType,
+		ContentType:    msg.ContentType,
+		Content:        req.Content,
+		EditTime:       now,
+	}
+	var recvID string
+	if msg.SessionType == constant.SuperGroupChatType {
+		recvID = msg.GroupID
+	} else {
+		recvID = msg.RecvID
+	}
+	if err := m.notificationSender.NotificationWithSesstionType(ctx, req.UserID, recvID, msgprocessor.MsgEditNotification, msg.SessionType, &tips); err != nil {
+		return nil, err
+	}
+	return &msgext.EditMsgResp{EditTime: now}, nil
+}
+
+func (m *msgServer) GetMsgEditHistory(ctx context.Context, req *msgext.GetMsgEditHistoryReq) (*msgext.GetMsgEditHistoryResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := m.checkConversationMember(ctx, req.UserID, req.ConversationID); err != nil {
+		return nil, err
+	}
+	msg, edits, err := m.MsgDatabase.GetMsgEditHistory(ctx, req.UserID, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	if msg.ContentType == constant.MsgRevokeNotification {
+		return nil, errs.ErrMsgAlreadyRevoke.Wrap("msg already revoke")
+	}
+	versions := make([]*msgext.MsgEditVersion, 0, len(edits)+1)
+	versions = append(versions, &msgext.MsgEditVersion{
+		EditorUserID:   msg.SendID,
+		EditorNickname: msg.SenderNickname,
+		Content:        string(msg.Content),
+		EditTime:       msg.SendTime,
+	})
+	for i, edit := range edits {
+		versions = append(versions, &msgext.MsgEditVersion{
+			Version:        int32(i + 1),
+			EditorUserID:   edit.UserID,
+			EditorNickname: edit.Nickname,
+			Content:        edit.Content,
+			EditTime:       edit.Time,
+		})
+	}
+	return &msgext.GetMsgEditHistoryResp{Versions: versions}, nil
+}
@@ -0,0 +1,102 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/mcontext"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/msgext"
+)
+
+func (m *msgServer) PlaceLegalHold(ctx context.Context, req *msgext.PlaceLegalHoldReq) (*msgext.PlaceLegalHoldResp, error) {
+	if err := authverify.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	hold := &relation.LegalHoldModel{
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		Reason:     req.Reason,
+		OpUserID:   mcontext.GetOpUserID(ctx),
+		CreateTime: time.Now(),
+	}
+	if err := m.LegalHoldDatabase.PlaceHold(ctx, hold); err != nil {
+		return nil, err
+	}
+	return &msgext.PlaceLegalHoldResp{}, nil
+}
+
+func (m *msgServer) ReleaseLegalHold(ctx context.Context, req *msgext.ReleaseLegalHoldReq) (*msgext.ReleaseLegalHoldResp, error) {
+	if err := authverify.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := m.LegalHoldDatabase.ReleaseHold(ctx, req.TargetType, req.TargetID, mcontext.GetOpUserID(ctx), req.Reason); err != nil {
+		return nil, err
+	}
+	return &msgext.ReleaseLegalHoldResp{}, nil
+}
+
+func (m *msgServer) GetLegalHolds(ctx context.Context, req *msgext.GetLegalHoldsReq) (*msgext.GetLegalHoldsResp, error) {
+	if err := authverify.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	total, holds, err := m.LegalHoldDatabase.PageHolds(ctx, req.TargetType, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msgext.GetLegalHoldsResp{
+		Total: total,
+		Holds: make([]*msgext.LegalHold, 0, len(holds)),
+	}
+	for _, hold := range holds {
+		resp.Holds = append(resp.Holds, &msgext.LegalHold{
+			TargetType: hold.TargetType,
+			TargetID:   hold.TargetID,
+			Reason:     hold.Reason,
+			OpUserID:   hold.OpUserID,
+			CreateTime: hold.CreateTime.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
+
+func (m *msgServer) GetLegalHoldLogs(ctx context.Context, req *msgext.GetLegalHoldLogsReq) (*msgext.GetLegalHoldLogsResp, error) {
+	if err := authverify.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	total, logs, err := m.LegalHoldDatabase.SearchLogs(ctx, req.TargetType, req.TargetID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msgext.GetLegalHoldLogsResp{
+		Total: total,
+		Logs:  make([]*msgext.LegalHoldLog, 0, len(logs)),
+	}
+	for _, record := range logs {
+		resp.Logs = append(resp.Logs, &msgext.LegalHoldLog{
+			TargetType: record.TargetType,
+			TargetID:   record.TargetID,
+			Action:     record.Action,
+			Reason:     record.Reason,
+			OpUserID:   record.OpUserID,
+			CreateTime: record.CreateTime.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
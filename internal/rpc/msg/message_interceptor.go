@@ -16,15 +16,26 @@ package msg
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/OpenIMSDK/protocol/constant"
 	"github.com/OpenIMSDK/protocol/msg"
 	"github.com/OpenIMSDK/protocol/sdkws"
 	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mcontext"
+	"github.com/OpenIMSDK/tools/utils"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 )
 
+// MsgRateLimited is returned when a send is rejected by the rate limiter, clients should back off before retrying.
+const MsgRateLimited = 1405
+
+var ErrMsgRateLimited = errs.NewCodeError(MsgRateLimited, "MsgRateLimited")
+
 type MessageInterceptorFunc func(ctx context.Context, req *msg.SendMsgReq) (*sdkws.MsgData, error)
 
 func MessageHasReadEnabled(_ context.Context, req *msg.SendMsgReq) (*sdkws.MsgData, error) {
@@ -42,3 +53,52 @@ func MessageHasReadEnabled(_ context.Context, req *msg.SendMsgReq) (*sdkws.MsgDa
 	}
 	return req.MsgData, nil
 }
+
+// MessageRateLimit rejects sends that exceed the msgRateLimit token buckets of the sender or the conversation.
+// Notifications and messages sent by im-admin are not limited.
+func MessageRateLimit(limiter cache.RateLimiter) MessageInterceptorFunc {
+	return func(ctx context.Context, req *msg.SendMsgReq) (*sdkws.MsgData, error) {
+		conf := config.Config.MsgRateLimit
+		msgData := req.MsgData
+		if !conf.Enable || (msgData.ContentType >= constant.NotificationBegin && msgData.ContentType <= constant.NotificationEnd) {
+			return msgData, nil
+		}
+		if utils.IsContain(msgData.SendID, config.Config.IMAdmin.UserID) || utils.IsContain(mcontext.GetOpUserID(ctx), config.Config.IMAdmin.UserID) {
+			return msgData, nil
+		}
+		userLimit := conf.User
+		if limit, ok := conf.SessionType[msgData.SessionType]; ok {
+			userLimit = limit
+		}
+		type bucket struct {
+			key   string
+			limit config.RateLimit
+		}
+		buckets := make([]bucket, 0, 3)
+		if limit, ok := conf.ContentType[msgData.ContentType]; ok {
+			buckets = append(buckets, bucket{key: "MSG_CONTENT:" + strconv.Itoa(int(msgData.ContentType)) + ":" + msgData.SendID, limit: limit})
+		}
+		buckets = append(buckets,
+			bucket{key: "MSG_USER:" + strconv.Itoa(int(msgData.SessionType)) + ":" + msgData.SendID, limit: userLimit},
+			bucket{key: "MSG_CONVERSATION:" + msgprocessor.GetConversationIDByMsg(msgData), limit: conf.Conversation},
+		)
+		for i, b := range buckets {
+			allowed, err := limiter.Allow(ctx, b.key, b.limit.Capacity, b.limit.Rate)
+			if err != nil {
+				// do not block sending when redis is unavailable
+				log.ZWarn(ctx, "message rate limit failed", err, "key", b.key)
+				return msgData, nil
+			}
+			if !allowed {
+				// a rejected send uses up no quota, give back the tokens the buckets before took
+				for _, taken := range buckets[:i] {
+					if err := limiter.Refund(ctx, taken.key, taken.limit.Capacity, taken.limit.Rate); err != nil {
+						log.ZWarn(ctx, "message rate limit refund failed", err, "key", taken.key)
+					}
+				}
+				return nil, ErrMsgRateLimited.Wrap(b.key)
+			}
+		}
+		return msgData, nil
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/msg"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+)
+
+// fakeRateLimiter keeps the tokens left in each bucket, buckets start full.
+type fakeRateLimiter struct {
+	tokens map[string]int64
+}
+
+func (f *fakeRateLimiter) Allow(_ context.Context, key string, capacity int64, _ float64) (bool, error) {
+	if _, ok := f.tokens[key]; !ok {
+		f.tokens[key] = capacity
+	}
+	if f.tokens[key] == 0 {
+		return false, nil
+	}
+	f.tokens[key]--
+	return true, nil
+}
+
+func (f *fakeRateLimiter) Refund(_ context.Context, key string, capacity int64, _ float64) error {
+	if f.tokens[key] < capacity {
+		f.tokens[key]++
+	}
+	return nil
+}
+
+func TestMessageRateLimitRefundsRejectedSend(t *testing.T) {
+	conf := config.Config.MsgRateLimit
+	defer func() { config.Config.MsgRateLimit = conf }()
+	config.Config.MsgRateLimit.Enable = true
+	config.Config.MsgRateLimit.User = config.RateLimit{Capacity: 10, Rate: 1}
+	config.Config.MsgRateLimit.Conversation = config.RateLimit{Capacity: 1, Rate: 1}
+
+	limiter := &fakeRateLimiter{tokens: make(map[string]int64)}
+	interceptor := MessageRateLimit(limiter)
+	send := func(recvID string) error {
+		_, err := interceptor(context.Background(), &msg.SendMsgReq{MsgData: &sdkws.MsgData{
+			SendID:      "u1",
+			RecvID:      recvID,
+			SessionType: constant.SingleChatType,
+			ContentType: constant.Text,
+		}})
+		return err
+	}
+	userKey := "MSG_USER:1:u1"
+
+	assert.NoError(t, send("u2"))
+	assert.Equal(t, int64(9), limiter.tokens[userKey])
+	// the full conversation rejects the send, the sender keeps its quota
+	for i := 0; i < 3; i++ {
+		assert.Error(t, send("u2"))
+	}
+	assert.Equal(t, int64(9), limiter.tokens[userKey])
+	assert.NoError(t, send("u3"))
+	assert.Equal(t, int64(8), limiter.tokens[userKey])
+}
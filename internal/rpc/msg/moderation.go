@@ -0,0 +1,184 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	pbmsg "github.com/OpenIMSDK/protocol/msg"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/open-im-server/v3/pkg/moderation"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/msgext"
+)
+
+// MsgSensitive is returned when a message contains a word of a reject rule in the moderation dictionary.
+const MsgSensitive = 1406
+
+var ErrMsgSensitive = errs.NewCodeError(MsgSensitive, "MsgSensitive")
+
+// moderationTextField is the json field holding the text of the content types checked by the moderation dictionary.
+var moderationTextField = map[int32]string{
+	constant.Text:   "content",
+	constant.AtText: "text",
+	constant.Quote:  "text",
+}
+
+func newModerationFilter(rdb redis.UniversalClient) (*moderation.Filter, error) {
+	conf := config.Config.Moderation
+	if !conf.Enable {
+		return nil, nil
+	}
+	var src moderation.Source
+	switch conf.Source {
+	case "file":
+		src = moderation.NewFileSource(conf.File)
+	case "redis":
+		src = moderation.NewRedisSource(rdb, conf.RedisKey)
+	default:
+		return nil, errs.ErrArgs.Wrap("unknown moderation source " + conf.Source)
+	}
+	interval := time.Duration(conf.ReloadInterval) * time.Second
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	filter := moderation.NewFilter()
+	if err := filter.Watch(context.Background(), src, interval); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+// moderateContent checks the text in content against the moderation dictionary, it returns the content with
+// masked words replaced and the words that flag it for review.
+func (m *msgServer) moderateContent(contentType int32, content []byte) ([]byte, []string, string, error) {
+	field, ok := moderationTextField[contentType]
+	if m.moderationFilter == nil || !ok {
+		return content, nil, "", nil
+	}
+	var elem map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	if err := decoder.Decode(&elem); err != nil {
+		return content, nil, "", nil
+	}
+	text, _ := elem[field].(string)
+	if text == "" {
+		return content, nil, "", nil
+	}
+	res := m.moderationFilter.Check(text)
+	if res.Reject {
+		return nil, nil, "", ErrMsgSensitive.Wrap()
+	}
+	if res.Text != text {
+		elem[field] = res.Text
+		data, err := json.Marshal(elem)
+		if err != nil {
+			return nil, nil, "", errs.Wrap(err)
+		}
+		content = data
+	}
+	if !res.Flagged {
+		return content, nil, "", nil
+	}
+	return content, res.Words, text, nil
+}
+
+// newModerationRecord returns the record of a flagged message, or nil when no word flags it for review.
+func newModerationRecord(msgData *sdkws.MsgData, conversationID string, text string, words []string) *relation.ModerationRecordModel {
+	if len(words) == 0 {
+		return nil
+	}
+	return &relation.ModerationRecordModel{
+		ServerMsgID:    msgData.ServerMsgID,
+		ClientMsgID:    msgData.ClientMsgID,
+		ConversationID: conversationID,
+		SendID:         msgData.SendID,
+		SessionType:    msgData.SessionType,
+		ContentType:    msgData.ContentType,
+		Content:        text,
+		Words:          words,
+		SendTime:       msgData.SendTime,
+		CreateTime:     time.Now(),
+	}
+}
+
+// recordModeration writes the record once the message is stored, a nil record is ignored.
+func (m *msgServer) recordModeration(ctx context.Context, record *relation.ModerationRecordModel) {
+	if record == nil {
+		return
+	}
+	if err := m.ModerationDatabase.CreateRecord(ctx, record); err != nil {
+		log.ZWarn(ctx, "create moderation record failed", err, "serverMsgID", record.ServerMsgID)
+	}
+}
+
+// moderateMsg rejects, masks or flags the message by the moderation dictionary before it is sent,
+// the returned record of a flagged message is written by the caller after the message is queued.
+func (m *msgServer) moderateMsg(req *pbmsg.SendMsgReq) (*relation.ModerationRecordModel, error) {
+	content, words, text, err := m.moderateContent(req.MsgData.ContentType, req.MsgData.Content)
+	if err != nil {
+		return nil, err
+	}
+	req.MsgData.Content = content
+	return newModerationRecord(req.MsgData, msgprocessor.GetConversationIDByMsg(req.MsgData), text, words), nil
+}
+
+func (m *msgServer) GetModerationRecords(ctx context.Context, req *msgext.GetModerationRecordsReq) (*msgext.GetModerationRecordsResp, error) {
+	if err := authverify.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	var start, end time.Time
+	if req.StartTime > 0 {
+		start = time.UnixMilli(req.StartTime)
+	}
+	if req.EndTime > 0 {
+		end = time.UnixMilli(req.EndTime)
+	}
+	total, records, err := m.ModerationDatabase.SearchRecords(ctx, req.SendID, req.ConversationID, start, end, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msgext.GetModerationRecordsResp{
+		Total:   total,
+		Records: make([]*msgext.ModerationRecord, 0, len(records)),
+	}
+	for _, record := range records {
+		resp.Records = append(resp.Records, &msgext.ModerationRecord{
+			ServerMsgID:    record.ServerMsgID,
+			ClientMsgID:    record.ClientMsgID,
+			ConversationID: record.ConversationID,
+			SendID:         record.SendID,
+			SessionType:    record.SessionType,
+			ContentType:    record.ContentType,
+			Content:        record.Content,
+			Words:          record.Words,
+			SendTime:       record.SendTime,
+			CreateTime:     record.CreateTime.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
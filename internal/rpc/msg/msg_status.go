@@ -16,10 +16,15 @@ package msg
 
 import (
 	"context"
+	"sort"
 
 	"github.com/OpenIMSDK/protocol/constant"
 	pbmsg "github.com/OpenIMSDK/protocol/msg"
+	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/mcontext"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/msgext"
 )
 
 func (m *msgServer) SetSendMsgStatus(
@@ -48,3 +53,38 @@ func (m *msgServer) GetSendMsgStatus(
 	resp.Status = status
 	return resp, nil
 }
+
+// GetMsgDeliveryStatus returns how the msg at seq reached each recipient, to app managers and to the sender of the msg.
+func (m *msgServer) GetMsgDeliveryStatus(ctx context.Context, req *msgext.GetMsgDeliveryStatusReq) (*msgext.GetMsgDeliveryStatusResp, error) {
+	if !authverify.IsAppManagerUid(ctx) {
+		opUserID := mcontext.GetOpUserID(ctx)
+		if err := m.checkConversationMember(ctx, opUserID, req.ConversationID); err != nil {
+			return nil, err
+		}
+		_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, opUserID, req.ConversationID, []int64{req.Seq})
+		if err != nil {
+			return nil, err
+		}
+		if len(msgs) == 0 || msgs[0] == nil {
+			return nil, errs.ErrRecordNotFound.Wrap("msg not found")
+		}
+		if msgs[0].SendID != opUserID {
+			return nil, errs.ErrNoPermission.Wrap("only the sender can get the delivery status")
+		}
+	}
+	statuses, err := m.MsgDatabase.GetMsgDeliveryStatus(ctx, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msgext.GetMsgDeliveryStatusResp{Statuses: make([]*msgext.MsgDeliveryStatus, 0, len(statuses))}
+	for userID, status := range statuses {
+		resp.Statuses = append(resp.Statuses, &msgext.MsgDeliveryStatus{
+			UserID:      userID,
+			Status:      status.Status,
+			PlatformIDs: status.PlatformIDs,
+			UpdateTime:  status.UpdateTime,
+		})
+	}
+	sort.Slice(resp.Statuses, func(i, j int) bool { return resp.Statuses[i].UserID < resp.Statuses[j].UserID })
+	return resp, nil
+}
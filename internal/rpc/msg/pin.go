@@ -0,0 +1,179 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/msgext"
+)
+
+// maxPinnedMsgs is the number of msgs that can be pinned in one conversation.
+const maxPinnedMsgs = 50
+
+// checkPinAccess checks that userID may change the pins of a conversation with the given session type.
+func (m *msgServer) checkPinAccess(ctx context.Context, sessionType int32, groupID string) error {
+	switch sessionType {
+	case constant.SingleChatType:
+		// either party of a single chat can pin
+	case constant.SuperGroupChatType:
+		if err := m.Group.CheckGroupAdmin(ctx, groupID); err != nil {
+			return err
+		}
+	default:
+		return errs.ErrArgs.Wrap("msgs in this conversation can not be pinned")
+	}
+	return nil
+}
+
+// getPinMsg checks that userID may change the pins of the conversation and returns the msg at seq.
+func (m *msgServer) getPinMsg(ctx context.Context, userID, conversationID string, seq int64) (*sdkws.MsgData, error) {
+	if err := authverify.CheckAccessV3(ctx, userID); err != nil {
+		return nil, err
+	}
+	if err := m.checkConversationMember(ctx, userID, conversationID); err != nil {
+		return nil, err
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, userID, conversationID, []int64{seq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil {
+		return nil, errs.ErrRecordNotFound.Wrap("msg not found")
+	}
+	msg := msgs[0]
+	if err := m.checkPinAccess(ctx, msg.SessionType, msg.GroupID); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func (m *msgServer) sendPinNotification(ctx context.Context, userID, recvID, conversationID string, sessionType int32, seq int64, clientMsgID string, isPin bool) error {
+	tips := msgext.MsgPinnedTips{
+		ConversationID: conversationID,
+		Seq:            seq,
+		ClientMsgID:    clientMsgID,
+		SessionType:    sessionType,
+		OpUserID:       userID,
+		IsPin:          isPin,
+		PinTime:        time.Now().UnixMilli(),
+	}
+	return m.notificationSender.NotificationWithSesstionType(ctx, userID, recvID, msgprocessor.MsgPinNotification, sessionType, &tips)
+}
+
+func (m *msgServer) PinMsg(ctx context.Context, req *msgext.PinMsgReq) (*msgext.PinMsgResp, error) {
+	msg, err := m.getPinMsg(ctx, req.UserID, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	if msg.ContentType == constant.MsgRevokeNotification {
+		return nil, errs.ErrMsgAlreadyRevoke.Wrap("msg already revoke")
+	}
+	if msg.ContentType >= constant.NotificationBegin && msg.ContentType <= constant.NotificationEnd {
+		return nil, errs.ErrArgs.Wrap("notification can not be pinned")
+	}
+	pinned, err := m.ConversationPinDatabase.PinMsg(ctx, req.ConversationID, req.Seq, req.UserID, maxPinnedMsgs)
+	if err != nil {
+		return nil, err
+	}
+	if pinned {
+		if err := m.sendPinNotification(ctx, req.UserID, notificationRecvID(req.UserID, msg), req.ConversationID, msg.SessionType, msg.Seq, msg.ClientMsgID, true); err != nil {
+			return nil, err
+		}
+	}
+	return &msgext.PinMsgResp{}, nil
+}
+
+// UnpinMsg works from the pin store and the conversation alone, a pin outlives the msg being revoked, deleted or cleared.
+func (m *msgServer) UnpinMsg(ctx context.Context, req *msgext.UnpinMsgReq) (*msgext.UnpinMsgResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := m.checkConversationMember(ctx, req.UserID, req.ConversationID); err != nil {
+		return nil, err
+	}
+	conversationID := req.ConversationID
+	if parentConversationID, _, ok := msgprocessor.ParseThreadConversationID(conversationID); ok {
+		conversationID = parentConversationID
+	}
+	conversation, err := m.Conversation.GetConversation(ctx, req.UserID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.checkPinAccess(ctx, conversation.ConversationType, conversation.GroupID); err != nil {
+		return nil, err
+	}
+	removed, err := m.ConversationPinDatabase.UnpinMsg(ctx, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	if removed {
+		if err := m.sendPinNotification(ctx, req.UserID, m.conversationAndGetRecvID(conversation, req.UserID), req.ConversationID, conversation.ConversationType, req.Seq, "", false); err != nil {
+			return nil, err
+		}
+	}
+	return &msgext.UnpinMsgResp{}, nil
+}
+
+func (m *msgServer) GetPinnedMsgs(ctx context.Context, req *msgext.GetPinnedMsgsReq) (*msgext.GetPinnedMsgsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	for _, conversationID := range req.ConversationIDs {
+		if err := m.checkConversationMember(ctx, req.UserID, conversationID); err != nil {
+			return nil, err
+		}
+	}
+	pins, err := m.ConversationPinDatabase.GetPinnedMsgs(ctx, req.ConversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msgext.GetPinnedMsgsResp{PinnedMsgs: make(map[string]*msgext.PinnedMsgs, len(pins))}
+	for conversationID, conversationPins := range pins {
+		var msgMap map[int64]*sdkws.MsgData
+		if req.WithMsgData {
+			seqs := make([]int64, 0, len(conversationPins))
+			for _, pin := range conversationPins {
+				seqs = append(seqs, pin.Seq)
+			}
+			_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, conversationID, seqs)
+			if err != nil {
+				return nil, err
+			}
+			msgMap = make(map[int64]*sdkws.MsgData, len(msgs))
+			for _, msg := range msgs {
+				msgMap[msg.Seq] = msg
+			}
+		}
+		pinnedMsgs := make([]*msgext.PinnedMsg, 0, len(conversationPins))
+		for _, pin := range conversationPins {
+			pinnedMsgs = append(pinnedMsgs, &msgext.PinnedMsg{
+				Seq:       pin.Seq,
+				PinUserID: pin.PinUserID,
+				PinTime:   pin.PinTime.UnixMilli(),
+				MsgData:   msgMap[pin.Seq],
+			})
+		}
+		resp.PinnedMsgs[conversationID] = &msgext.PinnedMsgs{PinnedMsgs: pinnedMsgs}
+	}
+	return resp, nil
+}
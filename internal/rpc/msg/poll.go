@@ -0,0 +1,164 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	unrelationtb "github.com/openimsdk/open-im-server/v3/pkg/common/db/table/unrelation"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/msgext"
+)
+
+// checkPollMsg validates a poll before it is sent and resets the result, the tally is only ever written by the server.
+func checkPollMsg(msg *sdkws.MsgData) error {
+	if msg.SessionType != constant.SuperGroupChatType {
+		return errs.ErrArgs.Wrap("polls are only supported in group conversations")
+	}
+	poll, err := msgprocessor.ParsePollContent(msg.Content)
+	if err != nil {
+		return errs.ErrArgs.Wrap(err.Error())
+	}
+	if poll.IsClosed(time.Now().UnixMilli()) {
+		return errs.ErrArgs.Wrap("poll deadline has passed")
+	}
+	msg.AttachedInfo = msgprocessor.SetPollResult(msg.AttachedInfo, &msgprocessor.PollResult{Counts: make([]int64, len(poll.Options))})
+	return nil
+}
+
+// getPoll returns the poll msg at seq after checking that userID is a member of its group.
+func (m *msgServer) getPoll(ctx context.Context, userID, conversationID string, seq int64) (*sdkws.MsgData, *msgprocessor.PollContent, error) {
+	if err := authverify.CheckAccessV3(ctx, userID); err != nil {
+		return nil, nil, err
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, userID, conversationID, []int64{seq})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil {
+		return nil, nil, errs.ErrRecordNotFound.Wrap("msg not found")
+	}
+	msg := msgs[0]
+	if msg.ContentType != msgprocessor.Poll {
+		return nil, nil, errs.ErrArgs.Wrap("msg is not a poll")
+	}
+	if msg.SessionType != constant.SuperGroupChatType ||
+		conversationID != msgprocessor.GetConversationIDBySessionType(constant.SuperGroupChatType, msg.GroupID) {
+		return nil, nil, errs.ErrArgs.Wrap("poll is not in the conversation")
+	}
+	if _, err := m.Group.GetGroupMemberCache(ctx, msg.GroupID, userID); err != nil {
+		if errs.ErrRecordNotFound.Is(err) {
+			return nil, nil, errs.ErrNotInGroupYet.Wrap("not in group")
+		}
+		return nil, nil, err
+	}
+	poll, err := msgprocessor.ParsePollContent(msg.Content)
+	if err != nil {
+		return nil, nil, errs.ErrInternalServer.Wrap(err.Error())
+	}
+	return msg, poll, nil
+}
+
+func (m *msgServer) VotePoll(ctx context.Context, req *msgext.VotePollReq) (*msgext.VotePollResp, error) {
+	msg, poll, err := m.getPoll(ctx, req.UserID, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UnixMilli()
+	if poll.IsClosed(now) {
+		return nil, errs.ErrArgs.Wrap("poll is closed")
+	}
+	if err := poll.CheckVote(req.OptionIndexes); err != nil {
+		return nil, errs.ErrArgs.Wrap(err.Error())
+	}
+	vote := &unrelationtb.PollVoteModel{UserID: req.UserID, Options: req.OptionIndexes, Time: now}
+	voted, err := m.MsgDatabase.VotePoll(ctx, req.ConversationID, req.Seq, vote)
+	if err != nil {
+		return nil, err
+	}
+	if !voted {
+		return nil, errs.ErrArgs.Wrap("already voted")
+	}
+	_, result, err := m.MsgDatabase.GetPollVotes(ctx, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	counts := pollCounts(result, len(poll.Options))
+	tips := msgext.MsgPollVoteTips{
+		ConversationID: req.ConversationID,
+		Seq:            msg.Seq,
+		ClientMsgID:    msg.ClientMsgID,
+		Counts:         counts,
+		VoterCount:     result.VoterCount,
+	}
+	// the poll creator stands in as the sender of the notification when votes are anonymous
+	sendID := msg.SendID
+	if !poll.Anonymous {
+		sendID = req.UserID
+		tips.VoterUserID = req.UserID
+		tips.OptionIndexes = req.OptionIndexes
+	}
+	if err := m.notificationSender.NotificationWithSesstionType(ctx, sendID, msg.GroupID, msgprocessor.MsgPollVoteNotification, constant.SuperGroupChatType, &tips); err != nil {
+		return nil, err
+	}
+	return &msgext.VotePollResp{Counts: counts, VoterCount: result.VoterCount}, nil
+}
+
+func (m *msgServer) GetPollResult(ctx context.Context, req *msgext.GetPollResultReq) (*msgext.GetPollResultResp, error) {
+	_, poll, err := m.getPoll(ctx, req.UserID, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	votes, result, err := m.MsgDatabase.GetPollVotes(ctx, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	counts := pollCounts(result, len(poll.Options))
+	resp := &msgext.GetPollResultResp{
+		Options:    make([]*msgext.PollOptionResult, len(poll.Options)),
+		VoterCount: result.VoterCount,
+		Closed:     poll.IsClosed(time.Now().UnixMilli()),
+	}
+	for i := range resp.Options {
+		resp.Options[i] = &msgext.PollOptionResult{Index: int32(i), Count: counts[i], UserIDs: []string{}}
+	}
+	for _, vote := range votes {
+		if vote.UserID == req.UserID {
+			resp.MyOptionIndexes = vote.Options
+		}
+		if poll.Anonymous {
+			continue
+		}
+		for _, option := range vote.Options {
+			if int(option) < len(resp.Options) {
+				resp.Options[option].UserIDs = append(resp.Options[option].UserIDs, vote.UserID)
+			}
+		}
+	}
+	return resp, nil
+}
+
+// pollCounts returns the count of every option, options nobody voted for may be missing from the stored tally.
+func pollCounts(result *msgprocessor.PollResult, optionNum int) []int64 {
+	counts := make([]int64, optionNum)
+	copy(counts, result.Counts)
+	return counts
+}
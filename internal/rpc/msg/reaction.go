@@ -0,0 +1,127 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	unrelationtb "github.com/openimsdk/open-im-server/v3/pkg/common/db/table/unrelation"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/msgext"
+)
+
+// getReactionMsg returns the message userID reacts to, notifications and revoked messages can not be reacted to.
+func (m *msgServer) getReactionMsg(ctx context.Context, userID, conversationID string, seq int64) (*sdkws.MsgData, error) {
+	if err := authverify.CheckAccessV3(ctx, userID); err != nil {
+		return nil, err
+	}
+	if err := m.checkConversationMember(ctx, userID, conversationID); err != nil {
+		return nil, err
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, userID, conversationID, []int64{seq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil {
+		return nil, errs.ErrRecordNotFound.Wrap("msg not found")
+	}
+	if msgs[0].ContentType == constant.MsgRevokeNotification {
+		return nil, errs.ErrMsgAlreadyRevoke.Wrap("msg already revoke")
+	}
+	if msgs[0].ContentType >= constant.NotificationBegin && msgs[0].ContentType <= constant.NotificationEnd {
+		return nil, errs.ErrArgs.Wrap("notification can not be reacted to")
+	}
+	return msgs[0], nil
+}
+
+func (m *msgServer) sendReactionNotification(ctx context.Context, userID, conversationID, emoji string, msg *sdkws.MsgData, isAdd bool) error {
+	tips := msgext.MsgReactionTips{
+		UserID:         userID,
+		ClientMsgID:    msg.ClientMsgID,
+		Seq:            msg.Seq,
+		ConversationID: conversationID,
+		SessionType:    msg.SessionType,
+		Emoji:          emoji,
+		IsAdd:          isAdd,
+		ReactTime:      time.Now().UnixMilli(),
+	}
+	return m.notificationSender.NotificationWithSesstionType(ctx, userID, notificationRecvID(userID, msg), msgprocessor.MsgReactionNotification, msg.SessionType, &tips)
+}
+
+// notificationRecvID returns the receiver of a notification about msg sent by userID, the group or the other party of the single chat.
+func notificationRecvID(userID string, msg *sdkws.MsgData) string {
+	switch {
+	case msg.SessionType == constant.SuperGroupChatType:
+		return msg.GroupID
+	case msg.SendID == userID:
+		return msg.RecvID
+	default:
+		return msg.SendID
+	}
+}
+
+func (m *msgServer) AddMsgReaction(ctx context.Context, req *msgext.AddMsgReactionReq) (*msgext.AddMsgReactionResp, error) {
+	msg, err := m.getReactionMsg(ctx, req.UserID, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	added, err := m.MsgDatabase.AddMsgReaction(ctx, req.ConversationID, req.Seq, &unrelationtb.ReactionModel{Emoji: req.Emoji, UserID: req.UserID})
+	if err != nil {
+		return nil, err
+	}
+	if added {
+		if err := m.sendReactionNotification(ctx, req.UserID, req.ConversationID, req.Emoji, msg, true); err != nil {
+			return nil, err
+		}
+	}
+	return &msgext.AddMsgReactionResp{}, nil
+}
+
+func (m *msgServer) RemoveMsgReaction(ctx context.Context, req *msgext.RemoveMsgReactionReq) (*msgext.RemoveMsgReactionResp, error) {
+	msg, err := m.getReactionMsg(ctx, req.UserID, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	removed, err := m.MsgDatabase.RemoveMsgReaction(ctx, req.ConversationID, req.Seq, &unrelationtb.ReactionModel{Emoji: req.Emoji, UserID: req.UserID})
+	if err != nil {
+		return nil, err
+	}
+	if removed {
+		if err := m.sendReactionNotification(ctx, req.UserID, req.ConversationID, req.Emoji, msg, false); err != nil {
+			return nil, err
+		}
+	}
+	return &msgext.RemoveMsgReactionResp{}, nil
+}
+
+func (m *msgServer) GetMsgReactionUsers(ctx context.Context, req *msgext.GetMsgReactionUsersReq) (*msgext.GetMsgReactionUsersResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := m.checkConversationMember(ctx, req.UserID, req.ConversationID); err != nil {
+		return nil, err
+	}
+	total, userIDs, err := m.MsgDatabase.GetMsgReactionUsers(ctx, req.ConversationID, req.Seq, req.Emoji, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &msgext.GetMsgReactionUsersResp{Total: int32(total), UserIDs: userIDs}, nil
+}
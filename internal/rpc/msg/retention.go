@@ -0,0 +1,118 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/mcontext"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/msgext"
+)
+
+func (m *msgServer) SetRetentionPolicy(ctx context.Context, req *msgext.SetRetentionPolicyReq) (*msgext.SetRetentionPolicyResp, error) {
+	if err := authverify.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	policy := &relation.RetentionPolicyModel{
+		Scope:      req.Scope,
+		Key:        req.Key,
+		RetainDays: req.RetainDays,
+		OpUserID:   mcontext.GetOpUserID(ctx),
+		CreateTime: now,
+		UpdateTime: now,
+	}
+	if err := m.RetentionPolicyDatabase.SetPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+	return &msgext.SetRetentionPolicyResp{}, nil
+}
+
+func (m *msgServer) DeleteRetentionPolicy(ctx context.Context, req *msgext.DeleteRetentionPolicyReq) (*msgext.DeleteRetentionPolicyResp, error) {
+	if err := authverify.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := m.RetentionPolicyDatabase.DeletePolicy(ctx, req.Scope, req.Key); err != nil {
+		return nil, err
+	}
+	return &msgext.DeleteRetentionPolicyResp{}, nil
+}
+
+func (m *msgServer) GetRetentionPolicies(ctx context.Context, req *msgext.GetRetentionPoliciesReq) (*msgext.GetRetentionPoliciesResp, error) {
+	if err := authverify.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	total, policies, err := m.RetentionPolicyDatabase.PagePolicies(ctx, req.Scope, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msgext.GetRetentionPoliciesResp{
+		Total:    total,
+		Policies: make([]*msgext.RetentionPolicy, 0, len(policies)),
+	}
+	for _, policy := range policies {
+		resp.Policies = append(resp.Policies, retentionPolicyDB2Pb(policy))
+	}
+	return resp, nil
+}
+
+// RetentionDryRun reports what the retention cron task would delete from the given conversations right now.
+func (m *msgServer) RetentionDryRun(ctx context.Context, req *msgext.RetentionDryRunReq) (*msgext.RetentionDryRunResp, error) {
+	if err := authverify.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	policies, err := m.RetentionPolicyDatabase.GetPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	resp := &msgext.RetentionDryRunResp{Results: make([]*msgext.RetentionDryRunResult, 0, len(req.ConversationIDs))}
+	for _, conversationID := range req.ConversationIDs {
+		policy := policies.Match(conversationID)
+		remainTime := int64(policy.RetainDays) * 24 * 60 * 60
+		count, minSeq, err := m.MsgDatabase.CountConversationExpiredMsgs(ctx, conversationID, remainTime)
+		if err != nil {
+			return nil, err
+		}
+		resp.Results = append(resp.Results, &msgext.RetentionDryRunResult{
+			ConversationID: conversationID,
+			Policy:         retentionPolicyDB2Pb(policy),
+			ExpireTime:     now.Add(-time.Duration(remainTime) * time.Second).UnixMilli(),
+			DeleteCount:    count,
+			MinSeq:         minSeq,
+		})
+	}
+	return resp, nil
+}
+
+func retentionPolicyDB2Pb(policy *relation.RetentionPolicyModel) *msgext.RetentionPolicy {
+	pb := &msgext.RetentionPolicy{
+		Scope:      policy.Scope,
+		Key:        policy.Key,
+		RetainDays: policy.RetainDays,
+		OpUserID:   policy.OpUserID,
+	}
+	if !policy.CreateTime.IsZero() {
+		pb.CreateTime = policy.CreateTime.UnixMilli()
+	}
+	if !policy.UpdateTime.IsZero() {
+		pb.UpdateTime = policy.UpdateTime.UnixMilli()
+	}
+	return pb
+}
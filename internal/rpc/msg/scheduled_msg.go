@@ -0,0 +1,148 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/tools/errs"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/msgext"
+)
+
+func (m *msgServer) checkSendAt(sendAt int64) error {
+	t := time.UnixMilli(sendAt)
+	if !t.After(time.Now()) {
+		return errs.ErrArgs.Wrap("sendAt must be in the future")
+	}
+	if maxDelay := config.Config.ScheduledMsg.MaxDelay; maxDelay > 0 && t.After(time.Now().Add(time.Duration(maxDelay)*time.Second)) {
+		return errs.ErrArgs.Wrap("sendAt is too far in the future")
+	}
+	return nil
+}
+
+// getPendingScheduledMsg returns the scheduled msg if it belongs to userID and has not been dispatched yet.
+func (m *msgServer) getPendingScheduledMsg(ctx context.Context, userID, scheduledMsgID string) (*relation.ScheduledMsgModel, error) {
+	if err := authverify.CheckAccessV3(ctx, userID); err != nil {
+		return nil, err
+	}
+	scheduledMsg, _, err := m.ScheduledMsgDatabase.GetScheduledMsg(ctx, scheduledMsgID)
+	if err != nil {
+		return nil, err
+	}
+	if scheduledMsg.UserID != userID {
+		return nil, errs.ErrNoPermission.Wrap("not the owner of the scheduled msg")
+	}
+	if scheduledMsg.Status != relation.ScheduledMsgStatusPending {
+		return nil, errs.ErrArgs.Wrap("scheduled msg is no longer pending")
+	}
+	return scheduledMsg, nil
+}
+
+func (m *msgServer) ScheduleMsg(ctx context.Context, req *msgext.ScheduleMsgReq) (*msgext.ScheduleMsgResp, error) {
+	data := req.SendMsgReq.MsgData
+	if err := authverify.CheckAccessV3(ctx, data.SendID); err != nil {
+		return nil, err
+	}
+	switch data.SessionType {
+	case constant.SingleChatType, constant.SuperGroupChatType:
+	case constant.NotificationChatType:
+		if !authverify.IsAppManagerUid(ctx) {
+			return nil, errs.ErrNoPermission.Wrap("only app manager can schedule notifications")
+		}
+	default:
+		return nil, errs.ErrArgs.Wrap("unknown sessionType")
+	}
+	if err := m.checkSendAt(req.SendAt); err != nil {
+		return nil, err
+	}
+	// reject early what would certainly fail, the full check runs again when the msg is sent
+	if err := m.messageVerification(ctx, req.SendMsgReq); err != nil {
+		return nil, err
+	}
+	scheduledMsgID := GetMsgID(data.SendID)
+	if err := m.ScheduledMsgDatabase.CreateScheduledMsg(ctx, scheduledMsgID, time.UnixMilli(req.SendAt), data); err != nil {
+		return nil, err
+	}
+	return &msgext.ScheduleMsgResp{ScheduledMsgID: scheduledMsgID}, nil
+}
+
+func (m *msgServer) CancelScheduledMsg(ctx context.Context, req *msgext.CancelScheduledMsgReq) (*msgext.CancelScheduledMsgResp, error) {
+	if _, err := m.getPendingScheduledMsg(ctx, req.UserID, req.ScheduledMsgID); err != nil {
+		return nil, err
+	}
+	if err := m.ScheduledMsgDatabase.CancelScheduledMsg(ctx, req.ScheduledMsgID); err != nil {
+		if IsNotFound(err) {
+			return nil, errs.ErrArgs.Wrap("scheduled msg is no longer pending")
+		}
+		return nil, err
+	}
+	return &msgext.CancelScheduledMsgResp{}, nil
+}
+
+func (m *msgServer) RescheduleMsg(ctx context.Context, req *msgext.RescheduleMsgReq) (*msgext.RescheduleMsgResp, error) {
+	if _, err := m.getPendingScheduledMsg(ctx, req.UserID, req.ScheduledMsgID); err != nil {
+		return nil, err
+	}
+	if err := m.checkSendAt(req.SendAt); err != nil {
+		return nil, err
+	}
+	if err := m.ScheduledMsgDatabase.RescheduleMsg(ctx, req.ScheduledMsgID, time.UnixMilli(req.SendAt)); err != nil {
+		if IsNotFound(err) {
+			return nil, errs.ErrArgs.Wrap("scheduled msg is no longer pending")
+		}
+		return nil, err
+	}
+	return &msgext.RescheduleMsgResp{}, nil
+}
+
+func (m *msgServer) GetScheduledMsgs(ctx context.Context, req *msgext.GetScheduledMsgsReq) (*msgext.GetScheduledMsgsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	status := req.Status
+	if len(status) == 0 {
+		status = []int32{relation.ScheduledMsgStatusPending}
+	}
+	total, scheduledMsgs, err := m.ScheduledMsgDatabase.PageUserScheduledMsgs(ctx, req.UserID, status, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msgext.GetScheduledMsgsResp{
+		Total:         total,
+		ScheduledMsgs: make([]*msgext.ScheduledMsg, 0, len(scheduledMsgs)),
+	}
+	for _, scheduledMsg := range scheduledMsgs {
+		msgData, err := m.ScheduledMsgDatabase.UnmarshalMsg(scheduledMsg)
+		if err != nil {
+			return nil, err
+		}
+		resp.ScheduledMsgs = append(resp.ScheduledMsgs, &msgext.ScheduledMsg{
+			ScheduledMsgID: scheduledMsg.ScheduledMsgID,
+			SendAt:         scheduledMsg.SendAt.UnixMilli(),
+			Status:         scheduledMsg.Status,
+			MsgData:        msgData,
+			ServerMsgID:    scheduledMsg.ServerMsgID,
+			ErrMsg:         scheduledMsg.ErrMsg,
+			CreateTime:     scheduledMsg.CreateTime.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
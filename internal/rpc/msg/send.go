@@ -38,6 +38,14 @@ func (m *msgServer) SendMsg(ctx context.Context, req *pbmsg.SendMsgReq) (resp *p
 		if !flag {
 			return nil, errs.ErrMessageHasReadDisable.Wrap()
 		}
+		if err := m.execInterceptorHandler(ctx, req); err != nil {
+			return nil, err
+		}
+		if req.MsgData.ContentType == msgprocessor.Poll {
+			if err := checkPollMsg(req.MsgData); err != nil {
+				return nil, err
+			}
+		}
 		m.encapsulateMsgData(req.MsgData)
 		switch req.MsgData.SessionType {
 		case constant.SingleChatType:
@@ -69,10 +77,15 @@ func (m *msgServer) sendMsgSuperGroupChat(
 	if err := callbackMsgModify(ctx, req); err != nil {
 		return nil, err
 	}
+	record, err := m.moderateMsg(req)
+	if err != nil {
+		return nil, err
+	}
 	err = m.MsgDatabase.MsgToMQ(ctx, utils.GenConversationUniqueKeyForGroup(req.MsgData.GroupID), req.MsgData)
 	if err != nil {
 		return nil, err
 	}
+	m.recordModeration(ctx, record)
 	if req.MsgData.ContentType == constant.AtText {
 		go m.setConversationAtInfo(ctx, req.MsgData)
 	}
@@ -172,10 +185,15 @@ func (m *msgServer) sendMsgSingleChat(ctx context.Context, req *pbmsg.SendMsgReq
 		if err := callbackMsgModify(ctx, req); err != nil {
 			return nil, err
 		}
+		record, err := m.moderateMsg(req)
+		if err != nil {
+			return nil, err
+		}
 		if err := m.MsgDatabase.MsgToMQ(ctx, utils.GenConversationUniqueKeyForSingle(req.MsgData.SendID, req.MsgData.RecvID), req.MsgData); err != nil {
 			prommetrics.SingleChatMsgProcessFailedCounter.Inc()
 			return nil, err
 		}
+		m.recordModeration(ctx, record)
 		err = callbackAfterSendSingleMsg(ctx, req)
 		if err != nil {
 			log.ZWarn(ctx, "CallbackAfterSendSingleMsg", err, "req", req)
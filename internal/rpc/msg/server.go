@@ -27,23 +27,34 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/localcache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/mgo"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/unrelation"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/startrpc"
+	"github.com/openimsdk/open-im-server/v3/pkg/moderation"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/msgext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 )
 
 type (
 	MessageInterceptorChain []MessageInterceptorFunc
 	msgServer               struct {
-		RegisterCenter         discoveryregistry.SvcDiscoveryRegistry
-		MsgDatabase            controller.CommonMsgDatabase
-		Group                  *rpcclient.GroupRpcClient
-		User                   *rpcclient.UserRpcClient
-		Conversation           *rpcclient.ConversationRpcClient
-		friend                 *rpcclient.FriendRpcClient
-		GroupLocalCache        *localcache.GroupLocalCache
-		ConversationLocalCache *localcache.ConversationLocalCache
-		Handlers               MessageInterceptorChain
-		notificationSender     *rpcclient.NotificationSender
+		RegisterCenter          discoveryregistry.SvcDiscoveryRegistry
+		MsgDatabase             controller.CommonMsgDatabase
+		MsgSearchDatabase       controller.MsgSearchDatabase
+		ScheduledMsgDatabase    controller.ScheduledMsgDatabase
+		ModerationDatabase      controller.ModerationDatabase
+		ConversationPinDatabase controller.ConversationPinDatabase
+		RetentionPolicyDatabase controller.RetentionPolicyDatabase
+		LegalHoldDatabase       controller.LegalHoldDatabase
+		Group                   *rpcclient.GroupRpcClient
+		User                    *rpcclient.UserRpcClient
+		Conversation            *rpcclient.ConversationRpcClient
+		friend                  *rpcclient.FriendRpcClient
+		GroupLocalCache         *localcache.GroupLocalCache
+		ConversationLocalCache  *localcache.ConversationLocalCache
+		Handlers                MessageInterceptorChain
+		notificationSender      *rpcclient.NotificationSender
+		moderationFilter        *moderation.Filter
 	}
 )
 
@@ -80,23 +91,66 @@ func Start(client discoveryregistry.SvcDiscoveryRegistry, server *grpc.Server) e
 	userRpcClient := rpcclient.NewUserRpcClient(client)
 	groupRpcClient := rpcclient.NewGroupRpcClient(client)
 	friendRpcClient := rpcclient.NewFriendRpcClient(client)
-	msgDatabase, err := controller.NewCommonMsgDatabase(msgDocModel, cacheModel)
+	msgArchive, err := controller.InitMsgArchive(rdb, mongo.GetDatabase())
+	if err != nil {
+		return err
+	}
+	legalHoldDatabase, err := controller.InitLegalHoldDatabase(mongo.GetDatabase())
+	if err != nil {
+		return err
+	}
+	msgDatabase, err := controller.NewCommonMsgDatabase(msgDocModel, cacheModel, msgArchive, legalHoldDatabase)
+	if err != nil {
+		return err
+	}
+	searchIndex, err := controller.NewMsgSearchIndex(rdb)
+	if err != nil {
+		return err
+	}
+	// closed after the server drained, searches and reindexing may still be running until then
+	startrpc.AddShutdown(searchIndex.Close)
+	scheduledMsgDB, err := mgo.NewScheduledMsgMongo(mongo.GetDatabase())
+	if err != nil {
+		return err
+	}
+	conversationPinDB, err := mgo.NewConversationPinMongo(mongo.GetDatabase())
+	if err != nil {
+		return err
+	}
+	moderationRecordDB, err := mgo.NewModerationRecordMongo(mongo.GetDatabase())
+	if err != nil {
+		return err
+	}
+	retentionPolicyDB, err := mgo.NewRetentionPolicyMongo(mongo.GetDatabase())
+	if err != nil {
+		return err
+	}
+	moderationFilter, err := newModerationFilter(rdb)
 	if err != nil {
 		return err
 	}
 	s := &msgServer{
-		Conversation:           &conversationClient,
-		User:                   &userRpcClient,
-		Group:                  &groupRpcClient,
-		MsgDatabase:            msgDatabase,
-		RegisterCenter:         client,
-		GroupLocalCache:        localcache.NewGroupLocalCache(&groupRpcClient),
-		ConversationLocalCache: localcache.NewConversationLocalCache(&conversationClient),
-		friend:                 &friendRpcClient,
+		Conversation:            &conversationClient,
+		User:                    &userRpcClient,
+		Group:                   &groupRpcClient,
+		MsgDatabase:             msgDatabase,
+		MsgSearchDatabase:       controller.NewMsgSearchDatabase(searchIndex, msgDatabase),
+		ScheduledMsgDatabase:    controller.NewScheduledMsgDatabase(scheduledMsgDB),
+		ModerationDatabase:      controller.NewModerationDatabase(moderationRecordDB),
+		ConversationPinDatabase: controller.NewConversationPinDatabase(conversationPinDB),
+		RetentionPolicyDatabase: controller.NewRetentionPolicyDatabase(retentionPolicyDB),
+		LegalHoldDatabase:       legalHoldDatabase,
+		RegisterCenter:          client,
+		GroupLocalCache:         localcache.NewGroupLocalCache(&groupRpcClient),
+		ConversationLocalCache:  localcache.NewConversationLocalCache(&conversationClient),
+		friend:                  &friendRpcClient,
+		moderationFilter:        moderationFilter,
 	}
 	s.notificationSender = rpcclient.NewNotificationSender(rpcclient.WithLocalSendMsg(s.SendMsg))
-	s.addInterceptorHandler(MessageHasReadEnabled)
+	// SendMsg checks has-read receipts itself, the chain only rate limits
+	s.addInterceptorHandler(MessageRateLimit(cache.NewRateLimiter(rdb)))
 	msg.RegisterMsgServer(server, s)
+	msgext.RegisterMsgExtServer(server, s)
 	return nil
 }
 
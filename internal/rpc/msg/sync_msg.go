@@ -16,15 +16,20 @@ package msg
 
 import (
 	"context"
+	"time"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/search"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/msgext"
 
 	"github.com/OpenIMSDK/protocol/constant"
 	"github.com/OpenIMSDK/protocol/msg"
 
 	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mcontext"
 	"github.com/OpenIMSDK/tools/utils"
 )
 
@@ -112,14 +117,84 @@ func (m *msgServer) GetMaxSeq(ctx context.Context, req *sdkws.GetMaxSeqReq) (*sd
 	return resp, nil
 }
 
-func (m *msgServer) SearchMessage(ctx context.Context, req *msg.SearchMessageReq) (resp *msg.SearchMessageResp, err error) {
-	var chatLogs []*sdkws.MsgData
-	var total int32
-	resp = &msg.SearchMessageResp{}
-	if total, chatLogs, err = m.MsgDatabase.SearchMessage(ctx, req); err != nil {
+func (m *msgServer) SearchMessage(ctx context.Context, req *msg.SearchMessageReq) (*msg.SearchMessageResp, error) {
+	resp, err := m.SearchMsg(ctx, &msgext.SearchMsgReq{
+		SendID:      req.SendID,
+		RecvID:      req.RecvID,
+		MsgType:     req.MsgType,
+		SendTime:    req.SendTime,
+		SessionType: req.SessionType,
+		Pagination:  req.Pagination,
+	})
+	if err != nil {
 		return nil, err
 	}
+	return &msg.SearchMessageResp{ChatLogs: resp.ChatLogs, ChatLogsNum: resp.ChatLogsNum}, nil
+}
 
+func (m *msgServer) SearchMsg(ctx context.Context, req *msgext.SearchMsgReq) (*msgext.SearchMsgResp, error) {
+	query := &search.Query{
+		Keyword:         req.Keyword,
+		SendID:          req.SendID,
+		RecvID:          req.RecvID,
+		ConversationIDs: req.ConversationIDs,
+		SessionType:     req.SessionType,
+		StartTime:       req.StartTime,
+		EndTime:         req.EndTime,
+		Cursor:          req.Cursor,
+		Limit:           int(req.Count),
+	}
+	if req.MsgType != 0 {
+		query.ContentTypes = []int32{req.MsgType}
+	}
+	if req.SendTime != "" {
+		day, err := time.Parse("2006-01-02", req.SendTime)
+		if err != nil {
+			return nil, errs.ErrArgs.Wrap("sendTime format must be yyyy-mm-dd")
+		}
+		if start := day.UnixMilli(); start > query.StartTime {
+			query.StartTime = start
+		}
+		if end := day.AddDate(0, 0, 1).UnixMilli(); query.EndTime == 0 || end < query.EndTime {
+			query.EndTime = end
+		}
+	}
+	if req.Pagination != nil {
+		if query.Limit == 0 {
+			query.Limit = int(req.Pagination.ShowNumber)
+		}
+		if query.Cursor == "" {
+			query.Offset = int((req.Pagination.PageNumber - 1) * req.Pagination.ShowNumber)
+		}
+	}
+	// admins search all conversations, users only the ones they are in
+	var userID string
+	if !authverify.IsAppManagerUid(ctx) {
+		userID = mcontext.GetOpUserID(ctx)
+		conversationIDs, err := m.ConversationLocalCache.GetConversationIDs(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if len(query.ConversationIDs) > 0 {
+			conversationIDs = utils.IntersectString(query.ConversationIDs, conversationIDs)
+		}
+		if len(conversationIDs) == 0 {
+			return &msgext.SearchMsgResp{}, nil
+		}
+		query.ConversationIDs = conversationIDs
+	}
+	total, nextCursor, chatLogs, err := m.MsgSearchDatabase.SearchMessage(ctx, userID, query)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msgext.SearchMsgResp{ChatLogsNum: int32(total), NextCursor: nextCursor}
+	if resp.ChatLogs, err = m.toChatLogs(ctx, chatLogs); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (m *msgServer) toChatLogs(ctx context.Context, chatLogs []*sdkws.MsgData) ([]*msg.ChatLog, error) {
 	var (
 		sendIDs  []string
 		recvIDs  []string
@@ -166,6 +241,7 @@ func (m *msgServer) SearchMessage(ctx context.Context, req *msg.SearchMessageReq
 			groupMap[groupInfo.GroupID] = groupInfo
 		}
 	}
+	pbChatLogs := make([]*msg.ChatLog, 0, len(chatLogs))
 	for _, chatLog := range chatLogs {
 		pbchatLog := &msg.ChatLog{}
 		utils.CopyStructFields(pbchatLog, chatLog)
@@ -186,10 +262,9 @@ func (m *msgServer) SearchMessage(ctx context.Context, req *msg.SearchMessageReq
 			pbchatLog.GroupOwner = groupMap[chatLog.GroupID].OwnerUserID
 			pbchatLog.GroupType = groupMap[chatLog.GroupID].GroupType
 		}
-		resp.ChatLogs = append(resp.ChatLogs, pbchatLog)
+		pbChatLogs = append(pbChatLogs, pbchatLog)
 	}
-	resp.ChatLogsNum = total
-	return resp, nil
+	return pbChatLogs, nil
 }
 
 func (m *msgServer) GetServerTime(ctx context.Context, _ *msg.GetServerTimeReq) (*msg.GetServerTimeResp, error) {
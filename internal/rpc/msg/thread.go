@@ -0,0 +1,173 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	pbmsg "github.com/OpenIMSDK/protocol/msg"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/msgext"
+)
+
+// maxThreadPullNum limits the replies returned by one PullThreadMsgs call.
+const maxThreadPullNum = 100
+
+func (m *msgServer) getThreadRootMsg(ctx context.Context, userID, conversationID string, rootSeq int64) (*sdkws.MsgData, error) {
+	if err := m.checkConversationMember(ctx, userID, conversationID); err != nil {
+		return nil, err
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, userID, conversationID, []int64{rootSeq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil {
+		return nil, errs.ErrRecordNotFound.Wrap("root msg not found")
+	}
+	if msgs[0].ContentType == constant.MsgRevokeNotification {
+		return nil, errs.ErrMsgAlreadyRevoke.Wrap("root msg already revoke")
+	}
+	if msgs[0].ContentType >= constant.NotificationBegin && msgs[0].ContentType <= constant.NotificationEnd {
+		return nil, errs.ErrArgs.Wrap("can not reply to a notification in a thread")
+	}
+	return msgs[0], nil
+}
+
+func (m *msgServer) SendThreadMsg(ctx context.Context, req *msgext.SendThreadMsgReq) (*msgext.SendThreadMsgResp, error) {
+	data := req.MsgData
+	if data.SessionType != constant.SuperGroupChatType {
+		return nil, errs.ErrArgs.Wrap("threads are only supported in group conversations")
+	}
+	if req.ConversationID != msgprocessor.GetConversationIDBySessionType(constant.SuperGroupChatType, data.GroupID) {
+		return nil, errs.ErrArgs.Wrap("conversationID does not match the group")
+	}
+	if err := authverify.CheckAccessV3(ctx, data.SendID); err != nil {
+		return nil, err
+	}
+	root, err := m.getThreadRootMsg(ctx, data.SendID, req.ConversationID, req.RootSeq)
+	if err != nil {
+		return nil, err
+	}
+	sendReq := &pbmsg.SendMsgReq{MsgData: data}
+	m.encapsulateMsgData(data)
+	if err := m.messageVerification(ctx, sendReq); err != nil {
+		return nil, err
+	}
+	if err := callbackBeforeSendGroupMsg(ctx, sendReq); err != nil {
+		return nil, err
+	}
+	if err := callbackMsgModify(ctx, sendReq); err != nil {
+		return nil, err
+	}
+	record, err := m.moderateMsg(sendReq)
+	if err != nil {
+		return nil, err
+	}
+	// msgtransfer routes the marked reply to the thread conversation, assigns its seq there,
+	// then updates the root msg and notifies the group
+	threadConversationID := msgprocessor.GetThreadConversationID(req.ConversationID, req.RootSeq)
+	data.AttachedInfo = msgprocessor.SetThreadReply(data.AttachedInfo, &msgprocessor.ThreadReply{
+		ThreadConversationID: threadConversationID,
+		RootSendID:           root.SendID,
+	})
+	if err := m.MsgDatabase.MsgToMQ(ctx, threadConversationID, data); err != nil {
+		return nil, err
+	}
+	m.recordModeration(ctx, record)
+	return &msgext.SendThreadMsgResp{
+		ThreadConversationID: threadConversationID,
+		ServerMsgID:          data.ServerMsgID,
+		ClientMsgID:          data.ClientMsgID,
+		SendTime:             data.SendTime,
+	}, nil
+}
+
+func (m *msgServer) PullThreadMsgs(ctx context.Context, req *msgext.PullThreadMsgsReq) (*msgext.PullThreadMsgsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := m.checkConversationMember(ctx, req.UserID, req.ConversationID); err != nil {
+		return nil, err
+	}
+	threadConversationID := msgprocessor.GetThreadConversationID(req.ConversationID, req.RootSeq)
+	resp := &msgext.PullThreadMsgsResp{ThreadConversationID: threadConversationID}
+	maxSeq, err := m.MsgDatabase.GetMaxSeq(ctx, threadConversationID)
+	if err != nil && errs.Unwrap(err) != redis.Nil {
+		return nil, err
+	}
+	if maxSeq == 0 {
+		return resp, nil
+	}
+	hasReadSeq, err := m.MsgDatabase.GetHasReadSeq(ctx, req.UserID, threadConversationID)
+	if err != nil && errs.Unwrap(err) != redis.Nil {
+		return nil, err
+	}
+	begin, end, num := req.Begin, req.End, req.Num
+	if begin < 1 {
+		begin = 1
+	}
+	if end == 0 || end > maxSeq {
+		end = maxSeq
+	}
+	if num > maxThreadPullNum {
+		num = maxThreadPullNum
+	}
+	if end < begin {
+		return nil, errs.ErrArgs.Wrap("seq end < begin")
+	}
+	minSeq, maxSeq, msgs, err := m.MsgDatabase.GetMsgBySeqsRange(ctx, req.UserID, threadConversationID, begin, end, num, maxSeq)
+	if err != nil {
+		return nil, err
+	}
+	resp.Msgs = msgs
+	resp.MinSeq = minSeq
+	resp.MaxSeq = maxSeq
+	resp.HasReadSeq = hasReadSeq
+	return resp, nil
+}
+
+// markThreadAsRead updates the read position of a thread, threads have no conversation record of their own.
+func (m *msgServer) markThreadAsRead(ctx context.Context, req *pbmsg.MarkConversationAsReadReq) (*pbmsg.MarkConversationAsReadResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := m.checkConversationMember(ctx, req.UserID, req.ConversationID); err != nil {
+		return nil, err
+	}
+	maxSeq, err := m.MsgDatabase.GetMaxSeq(ctx, req.ConversationID)
+	if err != nil && errs.Unwrap(err) != redis.Nil {
+		return nil, err
+	}
+	if req.HasReadSeq > maxSeq {
+		return nil, errs.ErrArgs.Wrap("hasReadSeq must not be bigger than maxSeq")
+	}
+	hasReadSeq, err := m.MsgDatabase.GetHasReadSeq(ctx, req.UserID, req.ConversationID)
+	if err != nil && errs.Unwrap(err) != redis.Nil {
+		return nil, err
+	}
+	if req.HasReadSeq > hasReadSeq {
+		if err := m.MsgDatabase.SetHasReadSeq(ctx, req.UserID, req.ConversationID, req.HasReadSeq); err != nil {
+			return nil, err
+		}
+	}
+	return &pbmsg.MarkConversationAsReadResp{}, nil
+}
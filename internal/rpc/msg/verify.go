@@ -27,6 +27,7 @@ import (
 	"github.com/OpenIMSDK/tools/utils"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 )
 
 var ExcludeContentType = []int{constant.HasReadReceipt}
@@ -134,6 +135,8 @@ func (m *msgServer) messageVerification(ctx context.Context, data *msg.SendMsgRe
 
 func (m *msgServer) encapsulateMsgData(msg *sdkws.MsgData) {
 	msg.ServerMsgID = GetMsgID(msg.SendID)
+	// only SendThreadMsg marks a reply after the checks of the thread
+	msg.AttachedInfo = msgprocessor.SetThreadReply(msg.AttachedInfo, nil)
 	if msg.SendTime == 0 {
 		msg.SendTime = utils.GetCurrentTimestampByMill()
 	}
@@ -158,6 +161,8 @@ func (m *msgServer) encapsulateMsgData(msg *sdkws.MsgData) {
 		fallthrough
 	case constant.Custom:
 		fallthrough
+	case msgprocessor.Poll:
+		fallthrough
 	case constant.Quote:
 		utils.SetSwitchFromOptions(msg.Options, constant.IsConversationUpdate, true)
 		utils.SetSwitchFromOptions(msg.Options, constant.IsUnreadCount, true)
@@ -23,19 +23,16 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/mgo"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/unrelation"
 
-	"github.com/openimsdk/open-im-server/v3/pkg/common/db/s3"
-	"github.com/openimsdk/open-im-server/v3/pkg/common/db/s3/cos"
-	"github.com/openimsdk/open-im-server/v3/pkg/common/db/s3/minio"
-	"github.com/openimsdk/open-im-server/v3/pkg/common/db/s3/oss"
-
 	"google.golang.org/grpc"
 
 	"github.com/OpenIMSDK/protocol/third"
 	"github.com/OpenIMSDK/tools/discoveryregistry"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/thirdext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 )
 
@@ -68,28 +65,19 @@ func Start(client discoveryregistry.SvcDiscoveryRegistry, server *grpc.Server) e
 		return err
 	}
 	// 根据配置文件策略选择 oss 方式
-	enable := config.Config.Object.Enable
-	var o s3.Interface
-	switch config.Config.Object.Enable {
-	case "minio":
-		o, err = minio.NewMinio(cache.NewMinioCache(rdb))
-	case "cos":
-		o, err = cos.NewCos()
-	case "oss":
-		o, err = oss.NewOSS()
-	default:
-		err = fmt.Errorf("invalid object enable: %s", enable)
-	}
+	o, err := controller.NewS3(rdb)
 	if err != nil {
 		return err
 	}
-	third.RegisterThirdServer(server, &thirdServer{
+	s := &thirdServer{
 		apiURL:        apiURL,
 		thirdDatabase: controller.NewThirdDatabase(cache.NewMsgCacheModel(rdb), logdb),
 		userRpcClient: rpcclient.NewUserRpcClient(client),
 		s3dataBase:    controller.NewS3Database(rdb, o, s3db),
 		defaultExpire: time.Hour * 24 * 7,
-	})
+	}
+	third.RegisterThirdServer(server, s)
+	thirdext.RegisterThirdExtServer(server, s)
 	return nil
 }
 
@@ -109,6 +97,16 @@ func (t *thirdServer) FcmUpdateToken(ctx context.Context, req *third.FcmUpdateTo
 	return &third.FcmUpdateTokenResp{}, nil
 }
 
+func (t *thirdServer) ApnsUpdateToken(ctx context.Context, req *thirdext.ApnsUpdateTokenReq) (*thirdext.ApnsUpdateTokenResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.Account); err != nil {
+		return nil, err
+	}
+	if err := t.thirdDatabase.ApnsUpdateToken(ctx, req.Account, int(req.PlatformID), req.ApnsToken, req.ExpireTime); err != nil {
+		return nil, err
+	}
+	return &thirdext.ApnsUpdateTokenResp{}, nil
+}
+
 func (t *thirdServer) SetAppBadge(ctx context.Context, req *third.SetAppBadgeReq) (resp *third.SetAppBadgeResp, err error) {
 	err = t.thirdDatabase.SetAppBadge(ctx, req.UserID, int(req.AppUnreadCount))
 	if err != nil {
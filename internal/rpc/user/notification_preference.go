@@ -0,0 +1,142 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/utils"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/userext"
+)
+
+// aliasPushVendors push to every device of a user alias and can not skip single platforms.
+var aliasPushVendors = []string{"getui", "jpush"}
+
+// checkPushPlatforms rejects turning offline pushes off on only some platforms when the push vendor can not honour it,
+// turning them off on every platform is still honoured since those users are never pushed.
+func checkPushPlatforms(preference *userext.NotificationPreference) error {
+	if len(preference.GetDisabledPushPlatformIDs()) == 0 || preference.AllPushDisabled() {
+		return nil
+	}
+	if utils.IsContain(config.Config.Push.Enable, aliasPushVendors) {
+		return errs.ErrArgs.Wrap("push vendor " + config.Config.Push.Enable + " can not disable pushes per platform")
+	}
+	return nil
+}
+
+func notificationPreferenceDB2Pb(preference *relation.NotificationPreferenceModel) *userext.NotificationPreference {
+	return &userext.NotificationPreference{
+		Timezone: preference.Timezone,
+		QuietHours: &userext.QuietHours{
+			Enable: preference.QuietHoursEnable,
+			Start:  preference.QuietHoursStart,
+			End:    preference.QuietHoursEnd,
+		},
+		DisabledPushPlatformIDs: preference.DisabledPushPlatformIDs,
+		GroupMentionOnly:        preference.GroupMentionOnly,
+	}
+}
+
+func (s *userServer) SetNotificationPreference(ctx context.Context, req *userext.SetNotificationPreferenceReq) (*userext.SetNotificationPreferenceResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := checkPushPlatforms(req.Preference); err != nil {
+		return nil, err
+	}
+	if _, err := s.FindWithError(ctx, []string{req.UserID}); err != nil {
+		return nil, err
+	}
+	preference := &relation.NotificationPreferenceModel{
+		UserID:                  req.UserID,
+		Timezone:                req.Preference.Timezone,
+		DisabledPushPlatformIDs: req.Preference.DisabledPushPlatformIDs,
+		GroupMentionOnly:        req.Preference.GroupMentionOnly,
+		UpdateTime:              time.Now(),
+	}
+	if req.Preference.QuietHours != nil {
+		preference.QuietHoursEnable = req.Preference.QuietHours.Enable
+		preference.QuietHoursStart = req.Preference.QuietHours.Start
+		preference.QuietHoursEnd = req.Preference.QuietHours.End
+	}
+	if preference.DisabledPushPlatformIDs == nil {
+		preference.DisabledPushPlatformIDs = []int32{}
+	}
+	if err := s.notificationPreferenceDatabase.SetPreference(ctx, preference); err != nil {
+		return nil, err
+	}
+	if err := s.userNotificationSender.NotificationPreferenceSetNotification(ctx, req.UserID, notificationPreferenceDB2Pb(preference)); err != nil {
+		log.ZWarn(ctx, "NotificationPreferenceSetNotification failed", err, "userID", req.UserID)
+	}
+	return &userext.SetNotificationPreferenceResp{}, nil
+}
+
+func (s *userServer) GetNotificationPreference(ctx context.Context, req *userext.GetNotificationPreferenceReq) (*userext.GetNotificationPreferenceResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	preferences, err := s.notificationPreferenceDatabase.GetPreferences(ctx, []string{req.UserID})
+	if err != nil {
+		return nil, err
+	}
+	return &userext.GetNotificationPreferenceResp{Preference: notificationPreferenceDB2Pb(preferences[0])}, nil
+}
+
+func (s *userServer) GetNotificationPreferences(ctx context.Context, req *userext.GetNotificationPreferencesReq) (*userext.GetNotificationPreferencesResp, error) {
+	preferences, err := s.notificationPreferenceDatabase.GetPreferences(ctx, req.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+	resp := &userext.GetNotificationPreferencesResp{Preferences: make([]*userext.UserNotificationPreference, 0, len(preferences))}
+	for _, preference := range preferences {
+		resp.Preferences = append(resp.Preferences, &userext.UserNotificationPreference{
+			UserID:     preference.UserID,
+			Preference: notificationPreferenceDB2Pb(preference),
+		})
+	}
+	return resp, nil
+}
+
+func (s *userServer) SetUserLocale(ctx context.Context, req *userext.SetUserLocaleReq) (*userext.SetUserLocaleResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if _, err := s.FindWithError(ctx, []string{req.UserID}); err != nil {
+		return nil, err
+	}
+	if err := s.UpdateByMap(ctx, req.UserID, map[string]any{"locale": req.Locale}); err != nil {
+		return nil, err
+	}
+	return &userext.SetUserLocaleResp{}, nil
+}
+
+func (s *userServer) GetUsersLocale(ctx context.Context, req *userext.GetUsersLocaleReq) (*userext.GetUsersLocaleResp, error) {
+	users, err := s.Find(ctx, req.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+	resp := &userext.GetUsersLocaleResp{Locales: make([]*userext.UserLocale, 0, len(users))}
+	for _, user := range users {
+		resp.Locales = append(resp.Locales, &userext.UserLocale{UserID: user.UserID, Locale: user.Locale})
+	}
+	return resp, nil
+}
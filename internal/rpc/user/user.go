@@ -24,6 +24,7 @@ import (
 	"github.com/OpenIMSDK/tools/pagination"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/userext"
 
 	"github.com/OpenIMSDK/tools/tx"
 
@@ -54,11 +55,12 @@ import (
 
 type userServer struct {
 	controller.UserDatabase
-	friendNotificationSender *notification.FriendNotificationSender
-	userNotificationSender   *notification.UserNotificationSender
-	friendRpcClient          *rpcclient.FriendRpcClient
-	groupRpcClient           *rpcclient.GroupRpcClient
-	RegisterCenter           registry.SvcDiscoveryRegistry
+	notificationPreferenceDatabase controller.NotificationPreferenceDatabase
+	friendNotificationSender       *notification.FriendNotificationSender
+	userNotificationSender         *notification.UserNotificationSender
+	friendRpcClient                *rpcclient.FriendRpcClient
+	groupRpcClient                 *rpcclient.GroupRpcClient
+	RegisterCenter                 registry.SvcDiscoveryRegistry
 }
 
 func (s *userServer) GetGroupOnlineUser(ctx context.Context, req *pbuser.GetGroupOnlineUserReq) (*pbuser.GetGroupOnlineUserResp, error) {
@@ -86,6 +88,11 @@ func Start(client registry.SvcDiscoveryRegistry, server *grpc.Server) error {
 	if err != nil {
 		return err
 	}
+	preferenceDB, err := mgo.NewNotificationPreferenceMongo(mongo.GetDatabase())
+	if err != nil {
+		return err
+	}
+	preferenceDatabase := controller.NewNotificationPreferenceDatabase(preferenceDB, cache.NewNotificationPreferenceCacheRedis(rdb, preferenceDB, cache.GetDefaultOpt()))
 	cache := cache.NewUserCacheRedis(rdb, userDB, cache.GetDefaultOpt())
 	userMongoDB := unrelation.NewUserMongoDriver(mongo.GetDatabase())
 	database := controller.NewUserDatabase(userDB, cache, tx.NewMongo(mongo.GetClient()), userMongoDB)
@@ -93,14 +100,16 @@ func Start(client registry.SvcDiscoveryRegistry, server *grpc.Server) error {
 	groupRpcClient := rpcclient.NewGroupRpcClient(client)
 	msgRpcClient := rpcclient.NewMessageRpcClient(client)
 	u := &userServer{
-		UserDatabase:             database,
-		RegisterCenter:           client,
-		friendRpcClient:          &friendRpcClient,
-		groupRpcClient:           &groupRpcClient,
-		friendNotificationSender: notification.NewFriendNotificationSender(&msgRpcClient, notification.WithDBFunc(database.FindWithError)),
-		userNotificationSender:   notification.NewUserNotificationSender(&msgRpcClient, notification.WithUserFunc(database.FindWithError)),
+		UserDatabase:                   database,
+		notificationPreferenceDatabase: preferenceDatabase,
+		RegisterCenter:                 client,
+		friendRpcClient:                &friendRpcClient,
+		groupRpcClient:                 &groupRpcClient,
+		friendNotificationSender:       notification.NewFriendNotificationSender(&msgRpcClient, notification.WithDBFunc(database.FindWithError)),
+		userNotificationSender:         notification.NewUserNotificationSender(&msgRpcClient, notification.WithUserFunc(database.FindWithError)),
 	}
 	pbuser.RegisterUserServer(server, u)
+	userext.RegisterUserExtServer(server, u)
 	return u.UserDatabase.InitOnce(context.Background(), users)
 }
 
@@ -60,6 +60,20 @@ func StartTask() error {
 		return errs.Wrap(err)
 	}
 
+	fmt.Println("start scheduledMsg cron task", "cron config", config.Config.ScheduledMsg.DispatchTime)
+	_, err = crontab.AddFunc(config.Config.ScheduledMsg.DispatchTime, cronWrapFunc(rdb, "cron_dispatch_scheduled_msgs", msgTool.DispatchScheduledMsgs))
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	if config.Config.MsgArchive.Enable {
+		fmt.Println("start msgArchive cron task", "cron config", config.Config.MsgArchive.CronTime)
+		_, err = crontab.AddFunc(config.Config.MsgArchive.CronTime, cronWrapFunc(rdb, "cron_archive_msgs", msgTool.AllConversationArchiveMsgs))
+		if err != nil {
+			return errs.Wrap(err)
+		}
+	}
+
 	// start crontab
 	crontab.Start()
 
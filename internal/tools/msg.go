@@ -52,10 +52,16 @@ type MsgTool struct {
 	userDatabase          controller.UserDatabase
 	groupDatabase         controller.GroupDatabase
 	msgNotificationSender *notification.MsgNotificationSender
+	scheduledMsgDatabase  controller.ScheduledMsgDatabase
+	retentionDatabase     controller.RetentionPolicyDatabase
+	msgRpcClient          *rpcclient.MessageRpcClient
+	rdb                   redis.UniversalClient
 }
 
 func NewMsgTool(msgDatabase controller.CommonMsgDatabase, userDatabase controller.UserDatabase,
 	groupDatabase controller.GroupDatabase, conversationDatabase controller.ConversationDatabase, msgNotificationSender *notification.MsgNotificationSender,
+	scheduledMsgDatabase controller.ScheduledMsgDatabase, retentionDatabase controller.RetentionPolicyDatabase,
+	msgRpcClient *rpcclient.MessageRpcClient, rdb redis.UniversalClient,
 ) *MsgTool {
 	return &MsgTool{
 		msgDatabase:           msgDatabase,
@@ -63,6 +69,10 @@ func NewMsgTool(msgDatabase controller.CommonMsgDatabase, userDatabase controlle
 		groupDatabase:         groupDatabase,
 		conversationDatabase:  conversationDatabase,
 		msgNotificationSender: msgNotificationSender,
+		scheduledMsgDatabase:  scheduledMsgDatabase,
+		retentionDatabase:     retentionDatabase,
+		msgRpcClient:          msgRpcClient,
+		rdb:                   rdb,
 	}
 }
 
@@ -118,9 +128,18 @@ func InitMsgTool() (*MsgTool, error) {
 		cache.NewConversationRedis(rdb, cache.GetDefaultOpt(), conversationDB),
 		ctxTx,
 	)
+	scheduledMsgDB, err := mgo.NewScheduledMsgMongo(mongo.GetDatabase())
+	if err != nil {
+		return nil, err
+	}
+	retentionPolicyDB, err := mgo.NewRetentionPolicyMongo(mongo.GetDatabase())
+	if err != nil {
+		return nil, err
+	}
 	msgRpcClient := rpcclient.NewMessageRpcClient(discov)
 	msgNotificationSender := notification.NewMsgNotificationSender(rpcclient.WithRpcClient(&msgRpcClient))
-	msgTool := NewMsgTool(msgDatabase, userDatabase, groupDatabase, conversationDatabase, msgNotificationSender)
+	msgTool := NewMsgTool(msgDatabase, userDatabase, groupDatabase, conversationDatabase, msgNotificationSender,
+		controller.NewScheduledMsgDatabase(scheduledMsgDB), controller.NewRetentionPolicyDatabase(retentionPolicyDB), &msgRpcClient, rdb)
 	return msgTool, nil
 }
 
@@ -180,10 +199,17 @@ func (c *MsgTool) AllConversationClearMsgAndFixSeq() {
 	log.ZInfo(ctx, "============================ start del cron finished ============================")
 }
 
+// ClearConversationsMsg deletes the msgs older than the retention policy of each conversation.
 func (c *MsgTool) ClearConversationsMsg(ctx context.Context, conversationIDs []string) {
+	policies, err := c.retentionDatabase.GetPolicies(ctx)
+	if err != nil {
+		log.ZError(ctx, "GetPolicies failed", err)
+		return
+	}
 	for _, conversationID := range conversationIDs {
-		if err := c.msgDatabase.DeleteConversationMsgsAndSetMinSeq(ctx, conversationID, int64(config.Config.RetainChatRecords*24*60*60)); err != nil {
-			log.ZError(ctx, "DeleteUserSuperGroupMsgsAndSetMinSeq failed", err, "conversationID", conversationID, "DBRetainChatRecords", config.Config.RetainChatRecords)
+		policy := policies.Match(conversationID)
+		if err := c.msgDatabase.DeleteConversationMsgsAndSetMinSeq(ctx, conversationID, int64(policy.RetainDays)*24*60*60); err != nil {
+			log.ZError(ctx, "DeleteUserSuperGroupMsgsAndSetMinSeq failed", err, "conversationID", conversationID, "scope", policy.Scope, "key", policy.Key, "retainDays", policy.RetainDays)
 		}
 		if err := c.checkMaxSeq(ctx, conversationID); err != nil {
 			log.ZError(ctx, "fixSeq failed", err, "conversationID", conversationID)
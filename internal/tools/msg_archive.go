@@ -0,0 +1,47 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tools
+
+import (
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mcontext"
+	"github.com/OpenIMSDK/tools/utils"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+)
+
+// AllConversationArchiveMsgs moves the cold msg docs of every conversation to object storage.
+func (c *MsgTool) AllConversationArchiveMsgs() {
+	ctx := mcontext.NewCtx(utils.GetSelfFuncName())
+	log.ZInfo(ctx, "============================ start archive cron task ============================")
+	conversationIDs, err := c.conversationDatabase.GetAllConversationIDs(ctx)
+	if err != nil {
+		log.ZError(ctx, "GetAllConversationIDs failed", err)
+		return
+	}
+	for _, conversationID := range conversationIDs {
+		conversationIDs = append(conversationIDs, utils.GetNotificationConversationIDByConversationID(conversationID))
+	}
+	archiveTime := int64(config.Config.MsgArchive.ArchiveTime) * 24 * 60 * 60
+	var total int
+	for _, conversationID := range conversationIDs {
+		count, err := c.msgDatabase.ArchiveConversationMsgs(ctx, conversationID, archiveTime)
+		if err != nil {
+			log.ZError(ctx, "ArchiveConversationMsgs failed", err, "conversationID", conversationID)
+		}
+		total += count
+	}
+	log.ZInfo(ctx, "============================ archive cron task finished ============================", "docs", total)
+}
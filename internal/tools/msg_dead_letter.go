@@ -0,0 +1,51 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tools
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+	pbmsg "github.com/OpenIMSDK/protocol/msg"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/kafka"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/mq"
+)
+
+// RedriveMongoDeadLetter puts the batches msgtransfer failed to write to mongo back onto the mongo topic,
+// it returns the number of batches re-driven.
+func (c *MsgTool) RedriveMongoDeadLetter(ctx context.Context) (int, error) {
+	if mq.Type() != mq.TypeKafka {
+		return 0, errs.ErrArgs.Wrap("redrive only supports the kafka mq")
+	}
+	return kafka.DrainTopic(ctx, config.Config.Kafka.Addr, config.Config.Kafka.MsgToMongoDeadLetter.Topic,
+		config.Config.Kafka.ConsumerGroupID.MsgToMongoDeadLetter, func(msg *sarama.ConsumerMessage) error {
+			var msgFromMQ pbmsg.MsgDataToMongoByMQ
+			if err := proto.Unmarshal(msg.Value, &msgFromMQ); err != nil {
+				log.ZError(ctx, "unmarshal mongo dead letter failed, skip", err, "key", string(msg.Key), "offset", msg.Offset)
+				return nil
+			}
+			msgCtx := kafka.GetContextWithMQHeader(msg.Headers)
+			if err := c.msgDatabase.MsgToMongoMQ(msgCtx, string(msg.Key), msgFromMQ.ConversationID, msgFromMQ.MsgData, msgFromMQ.LastSeq); err != nil {
+				return err
+			}
+			log.ZInfo(msgCtx, "redrive mongo dead letter", "conversationID", msgFromMQ.ConversationID, "lastSeq", msgFromMQ.LastSeq, "len", len(msgFromMQ.MsgData))
+			return nil
+		})
+}
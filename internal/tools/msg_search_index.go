@@ -0,0 +1,47 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tools
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
+)
+
+// IndexAllConversationMsgs writes the msgs stored in mongo to the msg search index, msgtransfer only indexes
+// the msgs it consumes after it first joined its consumer group. It returns the number of msgs indexed.
+func (c *MsgTool) IndexAllConversationMsgs(ctx context.Context) (int, error) {
+	index, err := controller.NewMsgSearchIndex(c.rdb)
+	if err != nil {
+		return 0, err
+	}
+	defer index.Close()
+	searchDatabase := controller.NewMsgSearchDatabase(index, c.msgDatabase)
+	conversationIDs, err := c.conversationDatabase.GetAllConversationIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var total int
+	for _, conversationID := range conversationIDs {
+		count, err := searchDatabase.IndexConversationMsgs(ctx, conversationID)
+		if err != nil {
+			log.ZError(ctx, "IndexConversationMsgs failed", err, "conversationID", conversationID)
+		}
+		total += count
+	}
+	return total, nil
+}
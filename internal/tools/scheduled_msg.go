@@ -0,0 +1,85 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tools
+
+import (
+	"time"
+
+	"github.com/OpenIMSDK/protocol/msg"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mcontext"
+	"github.com/OpenIMSDK/tools/utils"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+// scheduledMsgSendTimeout is how long a scheduled msg may stay in sending before it is considered interrupted.
+const scheduledMsgSendTimeout = 10 * time.Minute
+
+func (c *MsgTool) DispatchScheduledMsgs() {
+	ctx := mcontext.NewCtx(utils.GetSelfFuncName())
+	stuck, err := c.scheduledMsgDatabase.FailStuckScheduledMsgs(ctx, time.Now().Add(-scheduledMsgSendTimeout))
+	if err != nil {
+		log.ZError(ctx, "FailStuckScheduledMsgs failed", err)
+	} else if len(stuck) > 0 {
+		log.ZWarn(ctx, "scheduled msgs interrupted while sending", nil, "num", len(stuck))
+	}
+	batchSize := config.Config.ScheduledMsg.BatchSize
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	scheduledMsgs, err := c.scheduledMsgDatabase.GetDueScheduledMsgs(ctx, time.Now(), batchSize)
+	if err != nil {
+		log.ZError(ctx, "GetDueScheduledMsgs failed", err)
+		return
+	}
+	log.ZDebug(ctx, "GetDueScheduledMsgs", "num", len(scheduledMsgs))
+	for _, scheduledMsg := range scheduledMsgs {
+		c.sendScheduledMsg(scheduledMsg)
+	}
+}
+
+func (c *MsgTool) sendScheduledMsg(scheduledMsg *relation.ScheduledMsgModel) {
+	ctx := mcontext.NewCtx(utils.GetSelfFuncName() + "-" + utils.OperationIDGenerator() + "-" + scheduledMsg.ScheduledMsgID)
+	// the msg may have been cancelled or taken by another run after it was listed
+	if err := c.scheduledMsgDatabase.ClaimScheduledMsg(ctx, scheduledMsg.ScheduledMsgID); err != nil {
+		if !relation.IsNotFound(err) {
+			log.ZError(ctx, "ClaimScheduledMsg failed", err, "scheduledMsgID", scheduledMsg.ScheduledMsgID)
+		}
+		return
+	}
+	msgData, err := c.scheduledMsgDatabase.UnmarshalMsg(scheduledMsg)
+	if err != nil {
+		log.ZError(ctx, "UnmarshalMsg failed", err, "scheduledMsgID", scheduledMsg.ScheduledMsgID)
+		if err := c.scheduledMsgDatabase.FinishScheduledMsg(ctx, scheduledMsg.ScheduledMsgID, "", err); err != nil {
+			log.ZError(ctx, "FinishScheduledMsg failed", err, "scheduledMsgID", scheduledMsg.ScheduledMsgID)
+		}
+		return
+	}
+	// the send time is the time the msg is actually delivered
+	msgData.SendTime = 0
+	ctx = mcontext.SetOpUserID(ctx, msgData.SendID)
+	var serverMsgID string
+	resp, sendErr := c.msgRpcClient.SendMsg(ctx, &msg.SendMsgReq{MsgData: msgData})
+	if sendErr != nil {
+		log.ZWarn(ctx, "send scheduled msg failed", sendErr, "scheduledMsgID", scheduledMsg.ScheduledMsgID, "sendID", msgData.SendID)
+	} else {
+		serverMsgID = resp.ServerMsgID
+	}
+	if err := c.scheduledMsgDatabase.FinishScheduledMsg(ctx, scheduledMsg.ScheduledMsgID, serverMsgID, sendErr); err != nil {
+		log.ZError(ctx, "FinishScheduledMsg failed", err, "scheduledMsgID", scheduledMsg.ScheduledMsgID)
+	}
+}
@@ -73,6 +73,18 @@ type CustomElem struct {
 	Extension   string `mapstructure:"extension"`
 }
 
+type PollOptionElem struct {
+	Text string `mapstructure:"text" json:"text" validate:"required"`
+}
+
+type PollElem struct {
+	Question  string           `mapstructure:"question"  json:"question"  validate:"required"`
+	Options   []PollOptionElem `mapstructure:"options"   json:"options"   validate:"required,min=2"`
+	Multiple  bool             `mapstructure:"multiple"  json:"multiple"`
+	Anonymous bool             `mapstructure:"anonymous" json:"anonymous"`
+	Deadline  int64            `mapstructure:"deadline"  json:"deadline"`
+}
+
 type TextElem struct {
 	Content string `json:"content" validate:"required"`
 }
@@ -20,6 +20,7 @@ const CallbackAfterSetGroupInfoCommand = "callbackAfterSetGroupInfoCommand"
 const CallbackBeforeSetGroupInfoCommand = "callbackBeforeSetGroupInfoCommand"
 
 const CallbackAfterRevokeMsgCommand = "callbackBeforeAfterMsgCommand"
+const CallbackBeforeEditMsgCommand = "callbackBeforeEditMsgCommand"
 const CallbackBeforeAddBlackCommand = "callbackBeforeAddBlackCommand"
 const CallbackAfterAddFriendCommand = "callbackAfterAddFriendCommand"
 const CallbackBeforeAddFriendAgreeCommand = "callbackBeforeAddFriendAgreeCommand"
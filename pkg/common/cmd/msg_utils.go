@@ -15,9 +15,14 @@
 package cmd
 
 import (
+	"fmt"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/tools/mcontext"
 	"github.com/spf13/cobra"
 
 	"github.com/openimsdk/open-im-server/v3/internal/tools"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 )
 
 type MsgUtilsCmd struct {
@@ -79,6 +84,15 @@ func (m *MsgUtilsCmd) getLimitFlag(cmdLines *cobra.Command) int64 {
 	return limit
 }
 
+func (m *MsgUtilsCmd) AddConfFlag() {
+	m.Command.PersistentFlags().StringP(constant.FlagConf, "c", "", "path to config file folder")
+}
+
+func (m *MsgUtilsCmd) getConfFlag(cmdLines *cobra.Command) string {
+	configFolderPath, _ := cmdLines.Flags().GetString(constant.FlagConf)
+	return configFolderPath
+}
+
 func (m *MsgUtilsCmd) Execute() error {
 	return m.Command.Execute()
 }
@@ -123,6 +137,26 @@ func NewClearCmd() *ClearCmd {
 	}
 }
 
+type RedriveCmd struct {
+	*MsgUtilsCmd
+}
+
+func NewRedriveCmd() *RedriveCmd {
+	return &RedriveCmd{
+		NewMsgUtilsCmd("redrive [resource]", "redrive action", cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs)),
+	}
+}
+
+type IndexCmd struct {
+	*MsgUtilsCmd
+}
+
+func NewIndexCmd() *IndexCmd {
+	return &IndexCmd{
+		NewMsgUtilsCmd("index [resource]", "index action", cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs)),
+	}
+}
+
 type SeqCmd struct {
 	*MsgUtilsCmd
 }
@@ -183,3 +217,39 @@ func (m *MsgCmd) GetMsgCmd() *cobra.Command {
 func (m *MsgCmd) ClearMsgCmd() *cobra.Command {
 	return &m.Command
 }
+
+// RedriveMsgCmd puts the msgs in the mongo dead letter topic back to the mongo topic.
+func (m *MsgCmd) RedriveMsgCmd() *cobra.Command {
+	m.Command.RunE = func(cmdLines *cobra.Command, args []string) error {
+		if err := config.InitConfig(m.getConfFlag(cmdLines)); err != nil {
+			return err
+		}
+		msgTool, err := tools.InitMsgTool()
+		if err != nil {
+			return err
+		}
+		ctx := mcontext.NewCtx("redrive_mongo_dead_letter")
+		n, err := msgTool.RedriveMongoDeadLetter(ctx)
+		fmt.Printf("redrive %d msg batches from %s\n", n, config.Config.Kafka.MsgToMongoDeadLetter.Topic)
+		return err
+	}
+	return &m.Command
+}
+
+// IndexMsgCmd writes the msgs stored before msgtransfer started indexing to the msg search index.
+func (m *MsgCmd) IndexMsgCmd() *cobra.Command {
+	m.Command.RunE = func(cmdLines *cobra.Command, args []string) error {
+		if err := config.InitConfig(m.getConfFlag(cmdLines)); err != nil {
+			return err
+		}
+		msgTool, err := tools.InitMsgTool()
+		if err != nil {
+			return err
+		}
+		ctx := mcontext.NewCtx("index_msgs")
+		n, err := msgTool.IndexAllConversationMsgs(ctx)
+		fmt.Printf("index %d msgs to the msg search index\n", n)
+		return err
+	}
+	return &m.Command
+}
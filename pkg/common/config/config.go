@@ -45,6 +45,11 @@ type POfflinePush struct {
 	Ext    string `yaml:"ext"`
 }
 
+type RateLimit struct {
+	Capacity int64   `yaml:"capacity"` // burst size, 0 means no limit
+	Rate     float64 `yaml:"rate"`     // tokens refilled per second
+}
+
 type MYSQL struct {
 	Address       []string `yaml:"address"`
 	Username      string   `yaml:"username"`
@@ -88,6 +93,7 @@ type configStruct struct {
 	} `yaml:"redis"`
 
 	Kafka struct {
+		Type         string   `yaml:"type"`
 		Username     string   `yaml:"username"`
 		Password     string   `yaml:"password"`
 		ProducerAck  string   `yaml:"producerAck"`
@@ -100,20 +106,32 @@ type configStruct struct {
 			ClientKeyPwd       string `yaml:"clientKeyPwd"`
 			InsecureSkipVerify bool   `yaml:"insecureSkipVerify"`
 		} `yaml:"tls"`
+		RedisStream struct {
+			MaxLen    int64 `yaml:"maxLen"`    // entries above which acknowledged entries are trimmed, 0 keeps every entry
+			ClaimIdle int64 `yaml:"claimIdle"` // seconds an unacknowledged message waits before another consumer takes it
+		} `yaml:"redisStream"`
 		LatestMsgToRedis struct {
 			Topic string `yaml:"topic"`
 		} `yaml:"latestMsgToRedis"`
 		MsgToMongo struct {
-			Topic string `yaml:"topic"`
+			Topic      string `yaml:"topic"`
+			MaxRetry   int    `yaml:"maxRetry"`
+			Backoff    int    `yaml:"backoff"`
+			MaxBackoff int    `yaml:"maxBackoff"`
 		} `yaml:"offlineMsgToMongo"`
+		MsgToMongoDeadLetter struct {
+			Topic string `yaml:"topic"`
+		} `yaml:"msgToMongoDeadLetter"`
 		MsgToPush struct {
 			Topic string `yaml:"topic"`
 		} `yaml:"msgToPush"`
 		ConsumerGroupID struct {
-			MsgToRedis string `yaml:"msgToRedis"`
-			MsgToMongo string `yaml:"msgToMongo"`
-			MsgToMySql string `yaml:"msgToMySql"`
-			MsgToPush  string `yaml:"msgToPush"`
+			MsgToRedis           string `yaml:"msgToRedis"`
+			MsgToMongo           string `yaml:"msgToMongo"`
+			MsgToMySql           string `yaml:"msgToMySql"`
+			MsgToPush            string `yaml:"msgToPush"`
+			MsgToSearch          string `yaml:"msgToSearch"`
+			MsgToMongoDeadLetter string `yaml:"msgToMongoDeadLetter"`
 		} `yaml:"consumerGroupID"`
 	} `yaml:"kafka"`
 
@@ -208,6 +226,20 @@ type configStruct struct {
 		WebsocketMaxMsgLen       int   `yaml:"websocketMaxMsgLen"`
 		WebsocketTimeout         int   `yaml:"websocketTimeout"`
 		WebsocketWriteBufferSize int   `yaml:"websocketWriteBufferSize"`
+		GatewayRoute             struct {
+			Enable            bool `yaml:"enable"`
+			HeartbeatInterval int  `yaml:"heartbeatInterval"`
+		} `yaml:"gatewayRoute"`
+		Heartbeat struct {
+			PingInterval        int            `yaml:"pingInterval"`
+			IdleTimeout         int            `yaml:"idleTimeout"`
+			PlatformIdleTimeout map[string]int `yaml:"platformIdleTimeout"`
+		} `yaml:"heartbeat"`
+		SessionResume struct {
+			Enable     bool `yaml:"enable"`
+			Window     int  `yaml:"window"`
+			BufferSize int  `yaml:"bufferSize"`
+		} `yaml:"sessionResume"`
 	} `yaml:"longConnSvr"`
 
 	Push struct {
@@ -224,12 +256,39 @@ type configStruct struct {
 		Fcm struct {
 			ServiceAccount string `yaml:"serviceAccount"`
 		} `yaml:"fcm"`
+		Apns struct {
+			KeyFile  string `yaml:"keyFile"`
+			KeyID    string `yaml:"keyID"`
+			TeamID   string `yaml:"teamID"`
+			BundleID string `yaml:"bundleID"`
+		} `yaml:"apns"`
 		Jpns struct {
 			AppKey       string `yaml:"appKey"`
 			MasterSecret string `yaml:"masterSecret"`
 			PushUrl      string `yaml:"pushUrl"`
 			PushIntent   string `yaml:"pushIntent"`
 		} `yaml:"jpns"`
+		Webhook struct {
+			Url       string `yaml:"url"`
+			Secret    string `yaml:"secret"`
+			BatchSize int    `yaml:"batchSize"`
+			Timeout   int    `yaml:"timeout"`
+			MaxRetry  int    `yaml:"maxRetry"`
+		} `yaml:"webhook"`
+		Retry struct {
+			Enable      bool `yaml:"enable"`
+			MaxAttempts int  `yaml:"maxAttempts"`
+			Backoff     int  `yaml:"backoff"`
+			MaxBackoff  int  `yaml:"maxBackoff"`
+		} `yaml:"retry"`
+		Template struct {
+			File string `yaml:"file"`
+		} `yaml:"template"`
+		DeliveryStatus struct {
+			Enable        bool `yaml:"enable"`
+			MaxRecipients int  `yaml:"maxRecipients"`
+			Expire        int  `yaml:"expire"`
+		} `yaml:"deliveryStatus"`
 	}
 	Manager struct {
 		UserID   []string `yaml:"userID"`
@@ -258,6 +317,51 @@ type configStruct struct {
 		FriendVerify *bool `yaml:"friendVerify"`
 	} `yaml:"messageVerify"`
 
+	MsgSearch struct {
+		Engine string `yaml:"engine"`
+		Local  struct {
+			Dir         string `yaml:"dir"`
+			MergeFactor int    `yaml:"mergeFactor"`
+		} `yaml:"local"`
+	} `yaml:"msgSearch"`
+
+	MsgEdit struct {
+		TimeWindow int64 `yaml:"timeWindow"`
+	} `yaml:"msgEdit"`
+
+	MsgReaction struct {
+		MaxEmojis int `yaml:"maxEmojis"`
+	} `yaml:"msgReaction"`
+
+	ScheduledMsg struct {
+		DispatchTime string `yaml:"dispatchTime"`
+		MaxDelay     int64  `yaml:"maxDelay"`
+		BatchSize    int64  `yaml:"batchSize"`
+	} `yaml:"scheduledMsg"`
+
+	MsgArchive struct {
+		Enable      bool   `yaml:"enable"`
+		ArchiveTime int    `yaml:"archiveTime"`
+		CronTime    string `yaml:"cronTime"`
+		Bucket      string `yaml:"bucket"`
+	} `yaml:"msgArchive"`
+
+	MsgRateLimit struct {
+		Enable       bool                `yaml:"enable"`
+		User         RateLimit           `yaml:"user"`
+		Conversation RateLimit           `yaml:"conversation"`
+		SessionType  map[int32]RateLimit `yaml:"sessionType"`
+		ContentType  map[int32]RateLimit `yaml:"contentType"`
+	} `yaml:"msgRateLimit"`
+
+	Moderation struct {
+		Enable         bool   `yaml:"enable"`
+		Source         string `yaml:"source"`
+		File           string `yaml:"file"`
+		RedisKey       string `yaml:"redisKey"`
+		ReloadInterval int    `yaml:"reloadInterval"`
+	} `yaml:"moderation"`
+
 	IOSPush struct {
 		PushSound  string `yaml:"pushSound"`
 		BadgeCount bool   `yaml:"badgeCount"`
@@ -302,6 +406,7 @@ type configStruct struct {
 		CallbackAfterSetGroupInfo          CallBackConfig `yaml:"setGroupInfoAfter"`
 		CallbackBeforeSetGroupInfo         CallBackConfig `yaml:"setGroupInfoBefore"`
 		CallbackAfterRevokeMsg             CallBackConfig `yaml:"revokeMsgAfter"`
+		CallbackBeforeEditMsg              CallBackConfig `yaml:"editMsgBefore"`
 		CallbackBeforeAddBlack             CallBackConfig `yaml:"addBlackBefore"`
 		CallbackAfterAddFriend             CallBackConfig `yaml:"addFriendAfter"`
 		CallbackBeforeAddFriendAgree       CallBackConfig `yaml:"addFriendAgreeBefore"`
@@ -0,0 +1,116 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	gatewayRouteKey = "GATEWAY_ROUTE:"
+	gatewayNodeKey  = "GATEWAY_NODE:"
+
+	// gateways publish all their users again well within the expiry, so routes of crashed nodes disappear
+	gatewayRouteExpire = time.Hour * 24
+)
+
+// GatewayRouteCache records which msggateway nodes hold the connections of a user, so pushes only go to those nodes.
+// The routes of a node can be trusted only while its heartbeat key exists.
+type GatewayRouteCache interface {
+	AddUserRoutes(ctx context.Context, node string, userIDs []string) error
+	DelUserRoute(ctx context.Context, node string, userID string) error
+	SetNodeHeartbeat(ctx context.Context, node string, expire time.Duration) error
+	// GetAliveNodes returns the nodes whose heartbeat has not expired.
+	GetAliveNodes(ctx context.Context, nodes []string) (map[string]bool, error)
+	// GetUserRoutes returns the nodes of each user, users without connections are omitted.
+	GetUserRoutes(ctx context.Context, userIDs []string) (map[string][]string, error)
+}
+
+func NewGatewayRouteCache(rdb redis.UniversalClient) GatewayRouteCache {
+	return &gatewayRouteRedis{rdb: rdb}
+}
+
+type gatewayRouteRedis struct {
+	rdb redis.UniversalClient
+}
+
+func (g *gatewayRouteRedis) getRouteKey(userID string) string {
+	return gatewayRouteKey + userID
+}
+
+func (g *gatewayRouteRedis) getNodeKey(node string) string {
+	return gatewayNodeKey + node
+}
+
+func (g *gatewayRouteRedis) AddUserRoutes(ctx context.Context, node string, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	pipe := g.rdb.Pipeline()
+	now := time.Now().UnixMilli()
+	for _, userID := range userIDs {
+		key := g.getRouteKey(userID)
+		pipe.HSet(ctx, key, node, now)
+		pipe.Expire(ctx, key, gatewayRouteExpire)
+	}
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (g *gatewayRouteRedis) DelUserRoute(ctx context.Context, node string, userID string) error {
+	return errs.Wrap(g.rdb.HDel(ctx, g.getRouteKey(userID), node).Err())
+}
+
+func (g *gatewayRouteRedis) SetNodeHeartbeat(ctx context.Context, node string, expire time.Duration) error {
+	return errs.Wrap(g.rdb.Set(ctx, g.getNodeKey(node), time.Now().UnixMilli(), expire).Err())
+}
+
+func (g *gatewayRouteRedis) GetAliveNodes(ctx context.Context, nodes []string) (map[string]bool, error) {
+	pipe := g.rdb.Pipeline()
+	cmds := make([]*redis.IntCmd, len(nodes))
+	for i, node := range nodes {
+		cmds[i] = pipe.Exists(ctx, g.getNodeKey(node))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	alive := make(map[string]bool, len(nodes))
+	for i, node := range nodes {
+		alive[node] = cmds[i].Val() > 0
+	}
+	return alive, nil
+}
+
+func (g *gatewayRouteRedis) GetUserRoutes(ctx context.Context, userIDs []string) (map[string][]string, error) {
+	pipe := g.rdb.Pipeline()
+	cmds := make([]*redis.StringSliceCmd, len(userIDs))
+	for i, userID := range userIDs {
+		cmds[i] = pipe.HKeys(ctx, g.getRouteKey(userID))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	routes := make(map[string][]string, len(userIDs))
+	for i, userID := range userIDs {
+		if nodes := cmds[i].Val(); len(nodes) > 0 {
+			routes[userID] = nodes
+		}
+	}
+	return routes, nil
+}
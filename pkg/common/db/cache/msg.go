@@ -50,6 +50,7 @@ const (
 	signalCache      = "SIGNAL_CACHE:"
 	signalListCache  = "SIGNAL_LIST_CACHE:"
 	FCM_TOKEN        = "FCM_TOKEN:"
+	apnsToken        = "APNS_TOKEN:"
 
 	messageCache            = "MESSAGE_CACHE:"
 	messageDelUserList      = "MESSAGE_DEL_USER_LIST:"
@@ -64,6 +65,8 @@ var concurrentLimit = 3
 
 type SeqCache interface {
 	SetMaxSeq(ctx context.Context, conversationID string, maxSeq int64) error
+	// IncrMaxSeq atomically allocates incr seqs and returns the new max seq
+	IncrMaxSeq(ctx context.Context, conversationID string, incr int64) (int64, error)
 	GetMaxSeqs(ctx context.Context, conversationIDs []string) (map[string]int64, error)
 	GetMaxSeq(ctx context.Context, conversationID string) (int64, error)
 	SetMinSeq(ctx context.Context, conversationID string, minSeq int64) error
@@ -91,6 +94,9 @@ type thirdCache interface {
 	SetFcmToken(ctx context.Context, account string, platformID int, fcmToken string, expireTime int64) (err error)
 	GetFcmToken(ctx context.Context, account string, platformID int) (string, error)
 	DelFcmToken(ctx context.Context, account string, platformID int) error
+	SetApnsToken(ctx context.Context, account string, platformID int, token string, expireTime int64) error
+	GetApnsToken(ctx context.Context, account string, platformID int) (string, error)
+	DelApnsToken(ctx context.Context, account string, platformID int) error
 	IncrUserBadgeUnreadCountSum(ctx context.Context, userID string) (int, error)
 	SetUserBadgeUnreadCountSum(ctx context.Context, userID string, value int) error
 	GetUserBadgeUnreadCountSum(ctx context.Context, userID string) (int, error)
@@ -117,6 +123,12 @@ type MsgModel interface {
 	DelMsgFromCache(ctx context.Context, userID string, seqList []int64) error
 	SetSendMsgStatus(ctx context.Context, id string, status int32) error
 	GetSendMsgStatus(ctx context.Context, id string) (int32, error)
+	// SetMsgDeliveryStatus records how the msg at seq reached each recipient and expires the record after expire.
+	SetMsgDeliveryStatus(ctx context.Context, conversationID string, seq int64, statuses map[string]*MsgDeliveryStatus, expire time.Duration) error
+	// UpdateMsgDeliveryStatus changes the record of the msg at seq, a msg without a record is left alone.
+	UpdateMsgDeliveryStatus(ctx context.Context, conversationID string, seq int64, statuses map[string]*MsgDeliveryStatus) error
+	// GetMsgDeliveryStatus returns the record of the msg at seq by recipient, empty when there is none.
+	GetMsgDeliveryStatus(ctx context.Context, conversationID string, seq int64) (map[string]*MsgDeliveryStatus, error)
 	JudgeMessageReactionExist(ctx context.Context, clientMsgID string, sessionType int32) (bool, error)
 	GetOneMessageAllReactionList(ctx context.Context, clientMsgID string, sessionType int32) (map[string]string, error)
 	DeleteOneMessageKey(ctx context.Context, clientMsgID string, sessionType int32, subKey string) error
@@ -176,6 +188,10 @@ func (c *msgCache) SetMaxSeq(ctx context.Context, conversationID string, maxSeq
 	return c.setSeq(ctx, conversationID, maxSeq, c.getMaxSeqKey)
 }
 
+func (c *msgCache) IncrMaxSeq(ctx context.Context, conversationID string, incr int64) (int64, error) {
+	return utils.Wrap2(c.rdb.IncrBy(ctx, c.getMaxSeqKey(conversationID), incr).Result())
+}
+
 func (c *msgCache) GetMaxSeqs(ctx context.Context, conversationIDs []string) (m map[string]int64, err error) {
 	return c.getSeqs(ctx, conversationIDs, c.getMaxSeqKey)
 }
@@ -727,6 +743,18 @@ func (c *msgCache) DelFcmToken(ctx context.Context, account string, platformID i
 	return errs.Wrap(c.rdb.Del(ctx, FCM_TOKEN+account+":"+strconv.Itoa(platformID)).Err())
 }
 
+func (c *msgCache) SetApnsToken(ctx context.Context, account string, platformID int, token string, expireTime int64) error {
+	return errs.Wrap(c.rdb.Set(ctx, apnsToken+account+":"+strconv.Itoa(platformID), token, time.Duration(expireTime)*time.Second).Err())
+}
+
+func (c *msgCache) GetApnsToken(ctx context.Context, account string, platformID int) (string, error) {
+	return utils.Wrap2(c.rdb.Get(ctx, apnsToken+account+":"+strconv.Itoa(platformID)).Result())
+}
+
+func (c *msgCache) DelApnsToken(ctx context.Context, account string, platformID int) error {
+	return errs.Wrap(c.rdb.Del(ctx, apnsToken+account+":"+strconv.Itoa(platformID)).Err())
+}
+
 func (c *msgCache) IncrUserBadgeUnreadCountSum(ctx context.Context, userID string) (int, error) {
 	seq, err := c.rdb.Incr(ctx, userBadgeUnreadCountSum+userID).Result()
 
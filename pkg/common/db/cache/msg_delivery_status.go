@@ -0,0 +1,104 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const msgDeliveryStatus = "MSG_DELIVERY_STATUS:"
+
+// updateMsgDeliveryStatusScript sets the fields ARGV[1], ARGV[2], ... of KEYS[1] to ARGV[2], ARGV[4], ... only when the key exists,
+// so a late offline push result neither records a msg that was not recorded nor revives an expired one.
+var updateMsgDeliveryStatusScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('HSET', KEYS[1], unpack(ARGV))
+return 1
+`)
+
+// MsgDeliveryStatus is how a msg reached one recipient.
+type MsgDeliveryStatus struct {
+	Status int32 `json:"status"`
+	// PlatformIDs are the platforms the msg was pushed to online.
+	PlatformIDs []int32 `json:"platformIDs,omitempty"`
+	UpdateTime  int64   `json:"updateTime"`
+}
+
+func getMsgDeliveryStatusKey(conversationID string, seq int64) string {
+	return msgDeliveryStatus + conversationID + ":" + strconv.FormatInt(seq, 10)
+}
+
+func msgDeliveryStatusArgs(statuses map[string]*MsgDeliveryStatus) ([]any, error) {
+	args := make([]any, 0, len(statuses)*2)
+	for userID, status := range statuses {
+		data, err := json.Marshal(status)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		args = append(args, userID, string(data))
+	}
+	return args, nil
+}
+
+func (c *msgCache) SetMsgDeliveryStatus(ctx context.Context, conversationID string, seq int64, statuses map[string]*MsgDeliveryStatus, expire time.Duration) error {
+	if len(statuses) == 0 {
+		return nil
+	}
+	args, err := msgDeliveryStatusArgs(statuses)
+	if err != nil {
+		return err
+	}
+	key := getMsgDeliveryStatusKey(conversationID, seq)
+	pipe := c.rdb.TxPipeline()
+	pipe.HSet(ctx, key, args...)
+	pipe.Expire(ctx, key, expire)
+	_, err = pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *msgCache) UpdateMsgDeliveryStatus(ctx context.Context, conversationID string, seq int64, statuses map[string]*MsgDeliveryStatus) error {
+	if len(statuses) == 0 {
+		return nil
+	}
+	args, err := msgDeliveryStatusArgs(statuses)
+	if err != nil {
+		return err
+	}
+	return errs.Wrap(updateMsgDeliveryStatusScript.Run(ctx, c.rdb, []string{getMsgDeliveryStatusKey(conversationID, seq)}, args...).Err())
+}
+
+func (c *msgCache) GetMsgDeliveryStatus(ctx context.Context, conversationID string, seq int64) (map[string]*MsgDeliveryStatus, error) {
+	values, err := c.rdb.HGetAll(ctx, getMsgDeliveryStatusKey(conversationID, seq)).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	statuses := make(map[string]*MsgDeliveryStatus, len(values))
+	for userID, value := range values {
+		var status MsgDeliveryStatus
+		if err := json.Unmarshal([]byte(value), &status); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		statuses[userID] = &status
+	}
+	return statuses, nil
+}
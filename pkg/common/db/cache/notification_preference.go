@@ -0,0 +1,92 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/dtm-labs/rockscache"
+	"github.com/redis/go-redis/v9"
+
+	relationtb "github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+const (
+	notificationPreferenceKey        = "NOTIFICATION_PREFERENCE:"
+	notificationPreferenceExpireTime = time.Second * 60 * 60 * 12
+)
+
+type NotificationPreferenceCache interface {
+	metaCache
+	NewCache() NotificationPreferenceCache
+	// GetPreferences returns the preference of every user, users who never set one get the default preference.
+	GetPreferences(ctx context.Context, userIDs []string) ([]*relationtb.NotificationPreferenceModel, error)
+	DelPreferences(userIDs ...string) NotificationPreferenceCache
+}
+
+type NotificationPreferenceCacheRedis struct {
+	metaCache
+	preferenceDB relationtb.NotificationPreferenceInterface
+	expireTime   time.Duration
+	rcClient     *rockscache.Client
+}
+
+func NewNotificationPreferenceCacheRedis(
+	rdb redis.UniversalClient,
+	preferenceDB relationtb.NotificationPreferenceInterface,
+	options rockscache.Options,
+) NotificationPreferenceCache {
+	rcClient := rockscache.NewClient(rdb, options)
+	return &NotificationPreferenceCacheRedis{
+		metaCache:    NewMetaCacheRedis(rcClient),
+		preferenceDB: preferenceDB,
+		expireTime:   notificationPreferenceExpireTime,
+		rcClient:     rcClient,
+	}
+}
+
+func (n *NotificationPreferenceCacheRedis) NewCache() NotificationPreferenceCache {
+	return &NotificationPreferenceCacheRedis{
+		metaCache:    NewMetaCacheRedis(n.rcClient, n.metaCache.GetPreDelKeys()...),
+		preferenceDB: n.preferenceDB,
+		expireTime:   n.expireTime,
+		rcClient:     n.rcClient,
+	}
+}
+
+func (n *NotificationPreferenceCacheRedis) getPreferenceKey(userID string) string {
+	return notificationPreferenceKey + userID
+}
+
+func (n *NotificationPreferenceCacheRedis) GetPreferences(ctx context.Context, userIDs []string) ([]*relationtb.NotificationPreferenceModel, error) {
+	return batchGetCache2(ctx, n.rcClient, n.expireTime, userIDs, n.getPreferenceKey, func(ctx context.Context, userID string) (*relationtb.NotificationPreferenceModel, error) {
+		preference, err := n.preferenceDB.Take(ctx, userID)
+		if relationtb.IsNotFound(err) {
+			return &relationtb.NotificationPreferenceModel{UserID: userID}, nil
+		}
+		return preference, err
+	})
+}
+
+func (n *NotificationPreferenceCacheRedis) DelPreferences(userIDs ...string) NotificationPreferenceCache {
+	keys := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		keys = append(keys, n.getPreferenceKey(userID))
+	}
+	cache := n.NewCache()
+	cache.AddKeys(keys...)
+	return cache
+}
@@ -0,0 +1,87 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const offlinePushRetryKey = "OFFLINE_PUSH_RETRY"
+
+// claimScript takes up to ARGV[3] members of KEYS[1] due at ARGV[1] and pushes their score to ARGV[2],
+// so a member claimed by a crashed instance becomes due again once the lease runs out.
+var claimScript = redis.NewScript(`
+local members = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, tonumber(ARGV[3]))
+for _, member in ipairs(members) do
+	redis.call('ZADD', KEYS[1], ARGV[2], member)
+end
+return members
+`)
+
+// OfflinePushRetryCache is the queue of offline pushes waiting to be retried, ordered by the time they are due.
+type OfflinePushRetryCache interface {
+	// Add queues task to be due at dueAt.
+	Add(ctx context.Context, task string, dueAt time.Time) error
+	// Claim returns at most limit due tasks and hides them from other claims for lease.
+	Claim(ctx context.Context, lease time.Duration, limit int64) ([]string, error)
+	// Replace removes a claimed task and queues next in its place.
+	Replace(ctx context.Context, task string, next string, dueAt time.Time) error
+	// Remove drops claimed tasks from the queue.
+	Remove(ctx context.Context, tasks ...string) error
+}
+
+func NewOfflinePushRetryCache(rdb redis.UniversalClient) OfflinePushRetryCache {
+	return &offlinePushRetryRedis{rdb: rdb}
+}
+
+type offlinePushRetryRedis struct {
+	rdb redis.UniversalClient
+}
+
+func (o *offlinePushRetryRedis) Add(ctx context.Context, task string, dueAt time.Time) error {
+	return errs.Wrap(o.rdb.ZAdd(ctx, offlinePushRetryKey, redis.Z{Score: float64(dueAt.UnixMilli()), Member: task}).Err())
+}
+
+func (o *offlinePushRetryRedis) Claim(ctx context.Context, lease time.Duration, limit int64) ([]string, error) {
+	now := time.Now()
+	tasks, err := claimScript.Run(ctx, o.rdb, []string{offlinePushRetryKey}, now.UnixMilli(), now.Add(lease).UnixMilli(), limit).StringSlice()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return tasks, nil
+}
+
+func (o *offlinePushRetryRedis) Replace(ctx context.Context, task string, next string, dueAt time.Time) error {
+	pipe := o.rdb.TxPipeline()
+	pipe.ZRem(ctx, offlinePushRetryKey, task)
+	pipe.ZAdd(ctx, offlinePushRetryKey, redis.Z{Score: float64(dueAt.UnixMilli()), Member: next})
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (o *offlinePushRetryRedis) Remove(ctx context.Context, tasks ...string) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+	members := make([]any, 0, len(tasks))
+	for _, task := range tasks {
+		members = append(members, task)
+	}
+	return errs.Wrap(o.rdb.ZRem(ctx, offlinePushRetryKey, members...).Err())
+}
@@ -0,0 +1,63 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOfflinePushRetryClaim(t *testing.T) {
+	rdb := redis.NewClient(&redis.Options{})
+	defer rdb.Close()
+	retry := NewOfflinePushRetryCache(rdb)
+	ctx := context.Background()
+	due := fmt.Sprintf("test-due-%v", rand.Int63())
+	later := fmt.Sprintf("test-later-%v", rand.Int63())
+	next := due + "-next"
+	defer rdb.ZRem(ctx, offlinePushRetryKey, due, later, next)
+
+	assert.Nil(t, retry.Add(ctx, due, time.Now()))
+	assert.Nil(t, retry.Add(ctx, later, time.Now().Add(time.Hour)))
+	claimed, err := retry.Claim(ctx, 200*time.Millisecond, 1000)
+	assert.Nil(t, err)
+	assert.Contains(t, claimed, due)
+	assert.NotContains(t, claimed, later)
+
+	// a claimed task is hidden for the lease, then due again as if its instance crashed
+	claimed, err = retry.Claim(ctx, 200*time.Millisecond, 1000)
+	assert.Nil(t, err)
+	assert.NotContains(t, claimed, due)
+	time.Sleep(300 * time.Millisecond)
+	claimed, err = retry.Claim(ctx, time.Minute, 1000)
+	assert.Nil(t, err)
+	assert.Contains(t, claimed, due)
+
+	// requeued in place of the claimed task
+	assert.Nil(t, retry.Replace(ctx, due, next, time.Now()))
+	claimed, err = retry.Claim(ctx, time.Minute, 1000)
+	assert.Nil(t, err)
+	assert.Contains(t, claimed, next)
+	assert.NotContains(t, claimed, due)
+	assert.Nil(t, retry.Remove(ctx, next))
+	score, err := rdb.ZScore(ctx, offlinePushRetryKey, next).Result()
+	assert.Equal(t, redis.Nil, err, score)
+}
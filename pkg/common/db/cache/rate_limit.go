@@ -0,0 +1,97 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const rateLimitKey = "RATE_LIMIT:"
+
+// tokenBucketScript refills the bucket in KEYS[1] by the time elapsed since its last use and takes one token.
+// ARGV: capacity, refill rate per second, now in milliseconds. Returns 1 if a token was taken, 0 otherwise.
+var tokenBucketScript = redis.NewScript(`
+local capacity = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(bucket[1])
+local ts = tonumber(bucket[2])
+if tokens == nil or ts == nil then
+	tokens = capacity
+	ts = now
+end
+if now > ts then
+	tokens = math.min(capacity, tokens + (now - ts) * rate / 1000)
+	ts = now
+end
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(ts))
+redis.call('PEXPIRE', KEYS[1], math.ceil(capacity / rate * 1000) + 1000)
+return allowed
+`)
+
+// refundTokenScript gives back a token taken from the bucket in KEYS[1], a bucket that expired meanwhile is full already.
+// ARGV: capacity.
+var refundTokenScript = redis.NewScript(`
+local tokens = tonumber(redis.call('HGET', KEYS[1], 'tokens'))
+if tokens == nil then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'tokens', tostring(math.min(tonumber(ARGV[1]), tokens + 1)))
+return 1
+`)
+
+// RateLimiter is a token bucket limiter shared by all instances through redis.
+type RateLimiter interface {
+	// Allow takes a token from the bucket named key, which holds at most capacity tokens and refills rate tokens per second.
+	Allow(ctx context.Context, key string, capacity int64, rate float64) (bool, error)
+	// Refund gives back a token Allow took from the bucket named key.
+	Refund(ctx context.Context, key string, capacity int64, rate float64) error
+}
+
+func NewRateLimiter(rdb redis.UniversalClient) RateLimiter {
+	return &rateLimiterRedis{rdb: rdb}
+}
+
+type rateLimiterRedis struct {
+	rdb redis.UniversalClient
+}
+
+func (r *rateLimiterRedis) Allow(ctx context.Context, key string, capacity int64, rate float64) (bool, error) {
+	if capacity <= 0 || rate <= 0 {
+		return true, nil
+	}
+	res, err := tokenBucketScript.Run(ctx, r.rdb, []string{rateLimitKey + key}, capacity, rate, time.Now().UnixMilli()).Int()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return res == 1, nil
+}
+
+func (r *rateLimiterRedis) Refund(ctx context.Context, key string, capacity int64, rate float64) error {
+	if capacity <= 0 || rate <= 0 {
+		return nil
+	}
+	return errs.Wrap(refundTokenScript.Run(ctx, r.rdb, []string{rateLimitKey + key}, capacity).Err())
+}
@@ -0,0 +1,101 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiterAllow(t *testing.T) {
+	rdb := redis.NewClient(&redis.Options{})
+	defer rdb.Close()
+	limiter := NewRateLimiter(rdb)
+	ctx := context.Background()
+	key := fmt.Sprintf("test-%v", rand.Int63())
+	defer rdb.Del(ctx, rateLimitKey+key)
+
+	// a new bucket is full, capacity sends pass at once and the next one waits for a refill
+	for i := 0; i < 3; i++ {
+		allowed, err := limiter.Allow(ctx, key, 3, 10)
+		assert.Nil(t, err)
+		assert.True(t, allowed)
+	}
+	allowed, err := limiter.Allow(ctx, key, 3, 10)
+	assert.Nil(t, err)
+	assert.False(t, allowed)
+
+	// 10 tokens per second refill one token in 100ms
+	time.Sleep(150 * time.Millisecond)
+	allowed, err = limiter.Allow(ctx, key, 3, 10)
+	assert.Nil(t, err)
+	assert.True(t, allowed)
+	allowed, err = limiter.Allow(ctx, key, 3, 10)
+	assert.Nil(t, err)
+	assert.False(t, allowed)
+
+	// the bucket expires once it would be full again
+	ttl, err := rdb.PTTL(ctx, rateLimitKey+key).Result()
+	assert.Nil(t, err)
+	assert.True(t, ttl > 0 && ttl <= 1300*time.Millisecond, ttl)
+}
+
+func TestRateLimiterDisabled(t *testing.T) {
+	// without a capacity or a rate nothing is limited and redis is not used
+	limiter := NewRateLimiter(nil)
+	for _, limit := range []struct {
+		capacity int64
+		rate     float64
+	}{{0, 10}, {10, 0}} {
+		allowed, err := limiter.Allow(context.Background(), "disabled", limit.capacity, limit.rate)
+		assert.Nil(t, err)
+		assert.True(t, allowed)
+	}
+}
+
+func TestRateLimiterRefund(t *testing.T) {
+	rdb := redis.NewClient(&redis.Options{})
+	defer rdb.Close()
+	limiter := NewRateLimiter(rdb)
+	ctx := context.Background()
+	key := fmt.Sprintf("test-%v", rand.Int63())
+	defer rdb.Del(ctx, rateLimitKey+key)
+
+	// a refunded token can be taken again, refunds never overfill the bucket
+	allowed, err := limiter.Allow(ctx, key, 1, 0.001)
+	assert.Nil(t, err)
+	assert.True(t, allowed)
+	assert.Nil(t, limiter.Refund(ctx, key, 1, 0.001))
+	assert.Nil(t, limiter.Refund(ctx, key, 1, 0.001))
+	allowed, err = limiter.Allow(ctx, key, 1, 0.001)
+	assert.Nil(t, err)
+	assert.True(t, allowed)
+	allowed, err = limiter.Allow(ctx, key, 1, 0.001)
+	assert.Nil(t, err)
+	assert.False(t, allowed)
+
+	// a bucket that expired is not created by a refund
+	missing := key + "-missing"
+	assert.Nil(t, limiter.Refund(ctx, missing, 1, 0.001))
+	n, err := rdb.Exists(ctx, rateLimitKey+missing).Result()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), n)
+}
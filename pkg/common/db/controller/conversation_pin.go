@@ -0,0 +1,79 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+type ConversationPinDatabase interface {
+	// 置顶消息, 已置顶时返回false
+	PinMsg(ctx context.Context, conversationID string, seq int64, userID string, maxNum int) (bool, error)
+	// 取消置顶消息, 未置顶时返回false
+	UnpinMsg(ctx context.Context, conversationID string, seq int64) (bool, error)
+	// 获取会话的置顶消息, 按置顶时间排序
+	GetPinnedMsgs(ctx context.Context, conversationIDs []string) (map[string][]*relation.PinnedMsgModel, error)
+}
+
+func NewConversationPinDatabase(pinDB relation.ConversationPinInterface) ConversationPinDatabase {
+	return &conversationPinDatabase{pinDB: pinDB}
+}
+
+type conversationPinDatabase struct {
+	pinDB relation.ConversationPinInterface
+}
+
+func (c *conversationPinDatabase) PinMsg(ctx context.Context, conversationID string, seq int64, userID string, maxNum int) (bool, error) {
+	pinned, err := c.pinDB.AddPin(ctx, conversationID, &relation.PinnedMsgModel{Seq: seq, PinUserID: userID, PinTime: time.Now()}, maxNum)
+	if err != nil || pinned {
+		return pinned, err
+	}
+	pins, err := c.GetPinnedMsgs(ctx, []string{conversationID})
+	if err != nil {
+		return false, err
+	}
+	for _, pin := range pins[conversationID] {
+		if pin.Seq == seq {
+			return false, nil
+		}
+	}
+	return false, errs.ErrArgs.Wrap("too many pinned msgs in the conversation")
+}
+
+func (c *conversationPinDatabase) UnpinMsg(ctx context.Context, conversationID string, seq int64) (bool, error) {
+	return c.pinDB.RemovePin(ctx, conversationID, seq)
+}
+
+func (c *conversationPinDatabase) GetPinnedMsgs(ctx context.Context, conversationIDs []string) (map[string][]*relation.PinnedMsgModel, error) {
+	if len(conversationIDs) == 0 {
+		return map[string][]*relation.PinnedMsgModel{}, nil
+	}
+	conversationPins, err := c.pinDB.Find(ctx, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string][]*relation.PinnedMsgModel, len(conversationPins))
+	for _, conversationPin := range conversationPins {
+		if len(conversationPin.Pins) > 0 {
+			res[conversationPin.ConversationID] = conversationPin.Pins
+		}
+	}
+	return res, nil
+}
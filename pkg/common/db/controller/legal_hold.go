@@ -0,0 +1,150 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/pagination"
+	"github.com/OpenIMSDK/tools/utils"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+type LegalHoldDatabase interface {
+	// 设置法律保留并记录审计日志
+	PlaceHold(ctx context.Context, hold *relation.LegalHoldModel) error
+	// 解除法律保留并记录审计日志
+	ReleaseHold(ctx context.Context, targetType int32, targetID string, opUserID string, reason string) error
+	// 分页获取法律保留, targetType为0时获取全部
+	PageHolds(ctx context.Context, targetType int32, pagination pagination.Pagination) (int64, []*relation.LegalHoldModel, error)
+	// 查询法律保留审计日志
+	SearchLogs(ctx context.Context, targetType int32, targetID string, pagination pagination.Pagination) (int64, []*relation.LegalHoldLogModel, error)
+	// 会话本身或会话中的用户被法律保留时返回true
+	IsConversationHeld(ctx context.Context, conversationID string) (bool, error)
+}
+
+func NewLegalHoldDatabase(holdDB relation.LegalHoldInterface, logDB relation.LegalHoldLogInterface, conversationDB relation.ConversationModelInterface) LegalHoldDatabase {
+	return &legalHoldDatabase{holdDB: holdDB, logDB: logDB, conversationDB: conversationDB}
+}
+
+func InitLegalHoldDatabase(database *mongo.Database) (LegalHoldDatabase, error) {
+	holdDB, err := mgo.NewLegalHoldMongo(database)
+	if err != nil {
+		return nil, err
+	}
+	logDB, err := mgo.NewLegalHoldLogMongo(database)
+	if err != nil {
+		return nil, err
+	}
+	conversationDB, err := mgo.NewConversationMongo(database)
+	if err != nil {
+		return nil, err
+	}
+	return NewLegalHoldDatabase(holdDB, logDB, conversationDB), nil
+}
+
+type legalHoldDatabase struct {
+	holdDB         relation.LegalHoldInterface
+	logDB          relation.LegalHoldLogInterface
+	conversationDB relation.ConversationModelInterface
+}
+
+func (l *legalHoldDatabase) PlaceHold(ctx context.Context, hold *relation.LegalHoldModel) error {
+	if err := l.holdDB.Create(ctx, hold); err != nil {
+		if mongo.IsDuplicateKeyError(errs.Unwrap(err)) {
+			return errs.ErrArgs.Wrap("target is already on legal hold")
+		}
+		return err
+	}
+	return l.logDB.Create(ctx, []*relation.LegalHoldLogModel{{
+		TargetType: hold.TargetType,
+		TargetID:   hold.TargetID,
+		Action:     relation.LegalHoldActionPlace,
+		Reason:     hold.Reason,
+		OpUserID:   hold.OpUserID,
+		CreateTime: hold.CreateTime,
+	}})
+}
+
+func (l *legalHoldDatabase) ReleaseHold(ctx context.Context, targetType int32, targetID string, opUserID string, reason string) error {
+	if _, err := l.holdDB.Take(ctx, targetType, targetID); err != nil {
+		if errs.Unwrap(err) == mongo.ErrNoDocuments {
+			return errs.ErrRecordNotFound.Wrap("target is not on legal hold")
+		}
+		return err
+	}
+	if err := l.holdDB.Delete(ctx, targetType, targetID); err != nil {
+		return err
+	}
+	return l.logDB.Create(ctx, []*relation.LegalHoldLogModel{{
+		TargetType: targetType,
+		TargetID:   targetID,
+		Action:     relation.LegalHoldActionRelease,
+		Reason:     reason,
+		OpUserID:   opUserID,
+		CreateTime: time.Now(),
+	}})
+}
+
+func (l *legalHoldDatabase) PageHolds(ctx context.Context, targetType int32, pagination pagination.Pagination) (int64, []*relation.LegalHoldModel, error) {
+	return l.holdDB.Page(ctx, targetType, pagination)
+}
+
+func (l *legalHoldDatabase) SearchLogs(ctx context.Context, targetType int32, targetID string, pagination pagination.Pagination) (int64, []*relation.LegalHoldLogModel, error) {
+	return l.logDB.Search(ctx, targetType, targetID, pagination)
+}
+
+// IsConversationHeld also treats the notification conversation of a held conversation as held.
+func (l *legalHoldDatabase) IsConversationHeld(ctx context.Context, conversationID string) (bool, error) {
+	holds, err := l.holdDB.FindAll(ctx)
+	if err != nil {
+		return false, err
+	}
+	var userIDs []string
+	for _, hold := range holds {
+		switch hold.TargetType {
+		case relation.LegalHoldTargetConversation:
+			if hold.TargetID == conversationID || utils.GetNotificationConversationIDByConversationID(hold.TargetID) == conversationID {
+				return true, nil
+			}
+		case relation.LegalHoldTargetUser:
+			userIDs = append(userIDs, hold.TargetID)
+		}
+	}
+	if len(userIDs) == 0 {
+		return false, nil
+	}
+	candidates := []string{conversationID}
+	if strings.HasPrefix(conversationID, "n_") {
+		rest := strings.TrimPrefix(conversationID, "n_")
+		candidates = append(candidates, "si_"+rest, "sg_"+rest)
+	}
+	for _, userID := range userIDs {
+		conversationIDs, err := l.conversationDB.FindConversationID(ctx, userID, candidates)
+		if err != nil {
+			return false, err
+		}
+		if len(conversationIDs) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
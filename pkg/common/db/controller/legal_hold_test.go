@@ -0,0 +1,135 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OpenIMSDK/tools/pagination"
+	"github.com/OpenIMSDK/tools/utils"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+type fakeLegalHoldDB struct {
+	holds []*relation.LegalHoldModel
+}
+
+func (f *fakeLegalHoldDB) Create(ctx context.Context, hold *relation.LegalHoldModel) error {
+	f.holds = append(f.holds, hold)
+	return nil
+}
+
+func (f *fakeLegalHoldDB) Delete(ctx context.Context, targetType int32, targetID string) error {
+	for i, hold := range f.holds {
+		if hold.TargetType == targetType && hold.TargetID == targetID {
+			f.holds = append(f.holds[:i], f.holds[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+func (f *fakeLegalHoldDB) Take(ctx context.Context, targetType int32, targetID string) (*relation.LegalHoldModel, error) {
+	for _, hold := range f.holds {
+		if hold.TargetType == targetType && hold.TargetID == targetID {
+			return hold, nil
+		}
+	}
+	return nil, mongo.ErrNoDocuments
+}
+
+func (f *fakeLegalHoldDB) FindAll(ctx context.Context) ([]*relation.LegalHoldModel, error) {
+	return f.holds, nil
+}
+
+func (f *fakeLegalHoldDB) Page(ctx context.Context, targetType int32, pagination pagination.Pagination) (int64, []*relation.LegalHoldModel, error) {
+	return int64(len(f.holds)), f.holds, nil
+}
+
+type fakeLegalHoldLogDB struct {
+	logs []*relation.LegalHoldLogModel
+}
+
+func (f *fakeLegalHoldLogDB) Create(ctx context.Context, logs []*relation.LegalHoldLogModel) error {
+	f.logs = append(f.logs, logs...)
+	return nil
+}
+
+func (f *fakeLegalHoldLogDB) Search(ctx context.Context, targetType int32, targetID string, pagination pagination.Pagination) (int64, []*relation.LegalHoldLogModel, error) {
+	return int64(len(f.logs)), f.logs, nil
+}
+
+// fakeConversationDB only implements FindConversationID, userID -> conversationIDs.
+type fakeConversationDB struct {
+	relation.ConversationModelInterface
+	conversations map[string][]string
+}
+
+func (f *fakeConversationDB) FindConversationID(ctx context.Context, userID string, conversationIDs []string) ([]string, error) {
+	var exist []string
+	for _, conversationID := range conversationIDs {
+		if utils.Contain(conversationID, f.conversations[userID]...) {
+			exist = append(exist, conversationID)
+		}
+	}
+	return exist, nil
+}
+
+func TestLegalHoldIsConversationHeld(t *testing.T) {
+	ctx := context.Background()
+	logDB := &fakeLegalHoldLogDB{}
+	db := NewLegalHoldDatabase(&fakeLegalHoldDB{}, logDB, &fakeConversationDB{
+		conversations: map[string][]string{"u1": {"si_u1_u2", "sg_g1"}},
+	})
+	if err := db.PlaceHold(ctx, &relation.LegalHoldModel{TargetType: relation.LegalHoldTargetConversation, TargetID: "sg_g2"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.PlaceHold(ctx, &relation.LegalHoldModel{TargetType: relation.LegalHoldTargetUser, TargetID: "u1"}); err != nil {
+		t.Fatal(err)
+	}
+	cases := map[string]bool{
+		"sg_g2":    true,
+		"n_g2":     true,
+		"si_u1_u2": true,
+		"n_u1_u2":  true,
+		"sg_g1":    true,
+		"sg_g3":    false,
+		"si_u2_u3": false,
+	}
+	for conversationID, want := range cases {
+		held, err := db.IsConversationHeld(ctx, conversationID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if held != want {
+			t.Errorf("%s: held %v, want %v", conversationID, held, want)
+		}
+	}
+	if err := db.ReleaseHold(ctx, relation.LegalHoldTargetUser, "u1", "admin", "case closed"); err != nil {
+		t.Fatal(err)
+	}
+	if held, _ := db.IsConversationHeld(ctx, "si_u1_u2"); held {
+		t.Error("si_u1_u2 still held after the user hold is released")
+	}
+	if err := db.ReleaseHold(ctx, relation.LegalHoldTargetUser, "u1", "admin", "again"); err == nil {
+		t.Error("releasing a missing hold should fail")
+	}
+	if len(logDB.logs) != 3 || logDB.logs[2].Action != relation.LegalHoldActionRelease || logDB.logs[2].Reason != "case closed" {
+		t.Errorf("unexpected audit logs %+v", logDB.logs)
+	}
+}
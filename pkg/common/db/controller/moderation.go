@@ -0,0 +1,47 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/pagination"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+type ModerationDatabase interface {
+	// 记录待审核的消息
+	CreateRecord(ctx context.Context, record *relation.ModerationRecordModel) error
+	// 查询待审核的消息, 按记录时间倒序
+	SearchRecords(ctx context.Context, sendID string, conversationID string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*relation.ModerationRecordModel, error)
+}
+
+func NewModerationDatabase(recordDB relation.ModerationRecordInterface) ModerationDatabase {
+	return &moderationDatabase{recordDB: recordDB}
+}
+
+type moderationDatabase struct {
+	recordDB relation.ModerationRecordInterface
+}
+
+func (m *moderationDatabase) CreateRecord(ctx context.Context, record *relation.ModerationRecordModel) error {
+	return m.recordDB.Create(ctx, []*relation.ModerationRecordModel{record})
+}
+
+func (m *moderationDatabase) SearchRecords(ctx context.Context, sendID string, conversationID string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*relation.ModerationRecordModel, error) {
+	return m.recordDB.Search(ctx, sendID, conversationID, start, end, pagination)
+}
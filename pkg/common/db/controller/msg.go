@@ -18,6 +18,8 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"reflect"
+	"strconv"
 	"time"
 
 	"github.com/OpenIMSDK/protocol/constant"
@@ -28,15 +30,18 @@ import (
 
 	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/pagination"
 
 	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
 	unrelationtb "github.com/openimsdk/open-im-server/v3/pkg/common/db/table/unrelation"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/unrelation"
-	"github.com/openimsdk/open-im-server/v3/pkg/common/kafka"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/mq"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 
 	pbmsg "github.com/OpenIMSDK/protocol/msg"
 	"github.com/OpenIMSDK/protocol/sdkws"
@@ -46,6 +51,16 @@ import (
 const (
 	updateKeyMsg = iota
 	updateKeyRevoke
+	updateKeyEdit
+	updateKeyReaction
+	updateKeyThread
+)
+
+const (
+	// reactionSummaryUsers is the number of users kept per emoji in the reaction summary of a message.
+	reactionSummaryUsers = 10
+	// threadSummaryUsers is the number of participants kept in the thread summary of a root message.
+	threadSummaryUsers = 10
 )
 
 type CommonMsgDatabase interface {
@@ -53,6 +68,22 @@ type CommonMsgDatabase interface {
 	BatchInsertChat2DB(ctx context.Context, conversationID string, msgs []*sdkws.MsgData, currentMaxSeq int64) error
 	// 撤回消息
 	RevokeMsg(ctx context.Context, conversationID string, seq int64, revoke *unrelationtb.RevokeModel) error
+	// 编辑消息, 追加到编辑历史并更新缓存中的消息
+	EditMsg(ctx context.Context, conversationID string, seq int64, edit *unrelationtb.EditModel) error
+	// 获取消息的编辑历史, 按编辑时间升序, 返回的消息为编辑前的原始内容
+	GetMsgEditHistory(ctx context.Context, userID string, conversationID string, seq int64) (msg *sdkws.MsgData, edits []*unrelationtb.EditModel, err error)
+	// 添加表情回应, 已回应过返回false
+	AddMsgReaction(ctx context.Context, conversationID string, seq int64, reaction *unrelationtb.ReactionModel) (added bool, err error)
+	// 取消表情回应, 未回应过返回false
+	RemoveMsgReaction(ctx context.Context, conversationID string, seq int64, reaction *unrelationtb.ReactionModel) (removed bool, err error)
+	// 分页获取回应某个表情的用户, 按回应时间升序
+	GetMsgReactionUsers(ctx context.Context, conversationID string, seq int64, emoji string, pagination pagination.Pagination) (total int64, userIDs []string, err error)
+	// 话题回复写入缓存分配seq后, 更新根消息的回复数、最后回复和参与者
+	UpdateThreadRoot(ctx context.Context, threadConversationID string, replies []*sdkws.MsgData) error
+	// 投票, 计票和投票记录在同一次写入中更新, 已投过票返回false
+	VotePoll(ctx context.Context, conversationID string, seq int64, vote *unrelationtb.PollVoteModel) (voted bool, err error)
+	// 获取投票记录和计票结果
+	GetPollVotes(ctx context.Context, conversationID string, seq int64) (votes []*unrelationtb.PollVoteModel, result *msgprocessor.PollResult, err error)
 	// mark as read
 	MarkSingleChatMsgsAsRead(ctx context.Context, userID string, conversationID string, seqs []int64) error
 	// 刪除redis中消息缓存
@@ -66,13 +97,20 @@ type CommonMsgDatabase interface {
 	// 通过seqList获取大群在 mongo里面的消息
 	GetMsgBySeqs(ctx context.Context, userID string, conversationID string, seqs []int64) (minSeq int64, maxSeq int64, seqMsg []*sdkws.MsgData, err error)
 	// 删除会话消息重置最小seq， remainTime为消息保留的时间单位秒,超时消息删除， 传0删除所有消息(此方法不删除redis cache)
+	// 会话被法律保留时只重置最小seq，不删除消息
 	DeleteConversationMsgsAndSetMinSeq(ctx context.Context, conversationID string, remainTime int64) error
+	// 统计DeleteConversationMsgsAndSetMinSeq将会删除的消息数和删除后的最小seq，不做删除
+	CountConversationExpiredMsgs(ctx context.Context, conversationID string, remainTime int64) (count int64, minSeq int64, err error)
+	// 归档会话中已写满且超过archiveTime(秒)的消息文档到对象存储，返回归档的文档数
+	ArchiveConversationMsgs(ctx context.Context, conversationID string, archiveTime int64) (int, error)
+	// 按文档遍历会话在mongo中的消息(不含已归档、已撤回和对所有用户删除的消息)
+	RangeConversationMsgs(ctx context.Context, conversationID string, fn func(msgs []*sdkws.MsgData) error) error
 	// 用户标记删除过期消息返回标记删除的seq列表
 	UserMsgsDestruct(ctx context.Context, userID string, conversationID string, destructTime int64, lastMsgDestructTime time.Time) (seqs []int64, err error)
 
 	// 用户根据seq删除消息
 	DeleteUserMsgsBySeqs(ctx context.Context, userID string, conversationID string, seqs []int64) error
-	// 物理删除消息置空
+	// 物理删除消息置空, 会话被法律保留时只对所有用户隐藏
 	DeleteMsgsPhysicalBySeqs(ctx context.Context, conversationID string, seqs []int64) error
 
 	SetMaxSeq(ctx context.Context, conversationID string, maxSeq int64) error
@@ -97,7 +135,7 @@ type CommonMsgDatabase interface {
 	GetConversationMinMaxSeqInMongoAndCache(ctx context.Context, conversationID string) (minSeqMongo, maxSeqMongo, minSeqCache, maxSeqCache int64, err error)
 	SetSendMsgStatus(ctx context.Context, id string, status int32) error
 	GetSendMsgStatus(ctx context.Context, id string) (int32, error)
-	SearchMessage(ctx context.Context, req *pbmsg.SearchMessageReq) (total int32, msgData []*sdkws.MsgData, err error)
+	GetMsgDeliveryStatus(ctx context.Context, conversationID string, seq int64) (map[string]*cache.MsgDeliveryStatus, error)
 	FindOneByDocIDs(ctx context.Context, docIDs []string, seqs map[string]int64) (map[string]*sdkws.MsgData, error)
 
 	// to mq
@@ -126,22 +164,26 @@ type CommonMsgDatabase interface {
 	ConvertMsgsDocLen(ctx context.Context, conversationIDs []string)
 }
 
-func NewCommonMsgDatabase(msgDocModel unrelationtb.MsgDocModelInterface, cacheModel cache.MsgModel) (CommonMsgDatabase, error) {
-	producerToRedis, err := kafka.NewKafkaProducer(config.Config.Kafka.Addr, config.Config.Kafka.LatestMsgToRedis.Topic)
+// NewCommonMsgDatabase creates the msg database, archive may be nil when msg archiving is disabled
+// and legalHold may be nil in services that never delete msgs.
+func NewCommonMsgDatabase(msgDocModel unrelationtb.MsgDocModelInterface, cacheModel cache.MsgModel, archive MsgArchive, legalHold LegalHoldDatabase) (CommonMsgDatabase, error) {
+	producerToRedis, err := mq.NewMQProducer(config.Config.Kafka.LatestMsgToRedis.Topic)
 	if err != nil {
 		return nil, err
 	}
-	producerToMongo, err := kafka.NewKafkaProducer(config.Config.Kafka.Addr, config.Config.Kafka.MsgToMongo.Topic)
+	producerToMongo, err := mq.NewMQProducer(config.Config.Kafka.MsgToMongo.Topic)
 	if err != nil {
 		return nil, err
 	}
-	producerToPush, err := kafka.NewKafkaProducer(config.Config.Kafka.Addr, config.Config.Kafka.MsgToPush.Topic)
+	producerToPush, err := mq.NewMQProducer(config.Config.Kafka.MsgToPush.Topic)
 	if err != nil {
 		return nil, err
 	}
 	return &commonMsgDatabase{
 		msgDocDatabase:  msgDocModel,
 		cache:           cacheModel,
+		archive:         archive,
+		legalHold:       legalHold,
 		producer:        producerToRedis,
 		producerToMongo: producerToMongo,
 		producerToPush:  producerToPush,
@@ -151,17 +193,27 @@ func NewCommonMsgDatabase(msgDocModel unrelationtb.MsgDocModelInterface, cacheMo
 func InitCommonMsgDatabase(rdb redis.UniversalClient, database *mongo.Database) (CommonMsgDatabase, error) {
 	cacheModel := cache.NewMsgCacheModel(rdb)
 	msgDocModel := unrelation.NewMsgMongoDriver(database)
-	return NewCommonMsgDatabase(msgDocModel, cacheModel)
+	archive, err := InitMsgArchive(rdb, database)
+	if err != nil {
+		return nil, err
+	}
+	legalHold, err := InitLegalHoldDatabase(database)
+	if err != nil {
+		return nil, err
+	}
+	return NewCommonMsgDatabase(msgDocModel, cacheModel, archive, legalHold)
 }
 
 type commonMsgDatabase struct {
 	msgDocDatabase   unrelationtb.MsgDocModelInterface
 	msg              unrelationtb.MsgDocModel
 	cache            cache.MsgModel
-	producer         *kafka.Producer
-	producerToMongo  *kafka.Producer
-	producerToModify *kafka.Producer
-	producerToPush   *kafka.Producer
+	archive          MsgArchive
+	legalHold        LegalHoldDatabase
+	producer         mq.MQProducer
+	producerToMongo  mq.MQProducer
+	producerToModify mq.MQProducer
+	producerToPush   mq.MQProducer
 }
 
 func (db *commonMsgDatabase) MsgToMQ(ctx context.Context, key string, msg2mq *sdkws.MsgData) error {
@@ -198,6 +250,15 @@ func (db *commonMsgDatabase) BatchInsertBlock(ctx context.Context, conversationI
 	if len(fields) == 0 {
 		return nil
 	}
+	if key != updateKeyMsg {
+		seqs := make([]int64, 0, len(fields))
+		for i := range fields {
+			seqs = append(seqs, firstSeq+int64(i))
+		}
+		if err := db.rehydrateSeqs(ctx, conversationID, seqs); err != nil {
+			return err
+		}
+	}
 	num := db.msg.GetSingleGocMsgNum()
 	// num = 100
 	for i, field := range fields { // 检查类型
@@ -211,6 +272,12 @@ func (db *commonMsgDatabase) BatchInsertBlock(ctx context.Context, conversationI
 			}
 		case updateKeyRevoke:
 			_, ok = field.(*unrelationtb.RevokeModel)
+		case updateKeyEdit:
+			_, ok = field.(*unrelationtb.EditModel)
+		case updateKeyReaction:
+			_, ok = field.(*unrelationtb.ReactionModel)
+		case updateKeyThread:
+			_, ok = field.(*unrelationtb.ThreadModel)
 		default:
 			return errs.ErrInternalServer.Wrap("key is invalid")
 		}
@@ -232,6 +299,13 @@ func (db *commonMsgDatabase) BatchInsertBlock(ctx context.Context, conversationI
 			res, err = db.msgDocDatabase.UpdateMsg(ctx, docID, index, "msg", field)
 		case updateKeyRevoke:
 			res, err = db.msgDocDatabase.UpdateMsg(ctx, docID, index, "revoke", field)
+		case updateKeyEdit:
+			res, err = db.msgDocDatabase.PushUnique(ctx, docID, index, "edits", []any{field})
+		case updateKeyReaction:
+			reaction := field.(*unrelationtb.ReactionModel)
+			res, err = db.msgDocDatabase.PushUnique(ctx, docID, index, "reactions."+reaction.Emoji, []string{reaction.UserID})
+		case updateKeyThread:
+			res, err = db.msgDocDatabase.UpdateMsgMax(ctx, docID, index, "thread", field)
 		}
 		if err != nil {
 			return false, err
@@ -270,6 +344,19 @@ func (db *commonMsgDatabase) BatchInsertBlock(ctx context.Context, conversationI
 				doc.Msg[db.msg.GetMsgIndex(seq)] = &unrelationtb.MsgInfoModel{
 					Revoke: fields[j].(*unrelationtb.RevokeModel),
 				}
+			case updateKeyEdit:
+				doc.Msg[db.msg.GetMsgIndex(seq)] = &unrelationtb.MsgInfoModel{
+					Edits: []*unrelationtb.EditModel{fields[j].(*unrelationtb.EditModel)},
+				}
+			case updateKeyReaction:
+				reaction := fields[j].(*unrelationtb.ReactionModel)
+				doc.Msg[db.msg.GetMsgIndex(seq)] = &unrelationtb.MsgInfoModel{
+					Reactions: map[string][]string{reaction.Emoji: {reaction.UserID}},
+				}
+			case updateKeyThread:
+				doc.Msg[db.msg.GetMsgIndex(seq)] = &unrelationtb.MsgInfoModel{
+					Thread: fields[j].(*unrelationtb.ThreadModel),
+				}
 			}
 		}
 		for i, model := range doc.Msg {
@@ -345,7 +432,305 @@ func (db *commonMsgDatabase) RevokeMsg(ctx context.Context, conversationID strin
 	return db.BatchInsertBlock(ctx, conversationID, []any{revoke}, updateKeyRevoke, seq)
 }
 
+func (db *commonMsgDatabase) EditMsg(ctx context.Context, conversationID string, seq int64, edit *unrelationtb.EditModel) error {
+	if err := db.BatchInsertBlock(ctx, conversationID, []any{edit}, updateKeyEdit, seq); err != nil {
+		return err
+	}
+	return db.updateCachedMsg(ctx, conversationID, seq, func(msg *sdkws.MsgData) {
+		msg.Content = []byte(edit.Content)
+		if msg.Options == nil {
+			msg.Options = make(map[string]bool)
+		}
+		msg.Options[msgprocessor.IsEdited] = true
+	})
+}
+
+// updateCachedMsg applies fn to the cached copy of a message.
+// The message may not be in mongo yet, so the cached copy is updated instead of being deleted.
+func (db *commonMsgDatabase) updateCachedMsg(ctx context.Context, conversationID string, seq int64, fn func(msg *sdkws.MsgData)) error {
+	msgs, _, err := db.cache.GetMessagesBySeq(ctx, conversationID, []int64{seq})
+	if err != nil && errs.Unwrap(err) != redis.Nil {
+		log.ZWarn(ctx, "get msg from cache failed", err, "conversationID", conversationID, "seq", seq)
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	fn(msgs[0])
+	if _, err := db.cache.SetMessageToCache(ctx, conversationID, msgs[:1]); err != nil {
+		log.ZError(ctx, "set msg to cache failed", err, "conversationID", conversationID, "seq", seq)
+		return db.cache.DeleteMessages(ctx, conversationID, []int64{seq})
+	}
+	return nil
+}
+
+// GetMsgEditHistory reads the msg through the cache like GetMsgBySeqs, a msg that is not in mongo yet has its edits
+// in a doc of its own until it is stored, so the edits are read from mongo separately.
+// Both copies of the msg carry the latest content, the original one is taken from the first edit.
+func (db *commonMsgDatabase) GetMsgEditHistory(ctx context.Context, userID string, conversationID string, seq int64) (*sdkws.MsgData, []*unrelationtb.EditModel, error) {
+	_, _, msgs, err := db.GetMsgBySeqs(ctx, userID, conversationID, []int64{seq})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil {
+		return nil, nil, errs.ErrRecordNotFound.Wrap("msg not found")
+	}
+	if err := db.rehydrateSeqs(ctx, conversationID, []int64{seq}); err != nil {
+		return nil, nil, err
+	}
+	msgInfo, err := db.msgDocDatabase.GetMsgEdits(ctx, db.msg.GetDocID(conversationID, seq), db.msg.GetMsgIndex(seq))
+	if err != nil {
+		return nil, nil, err
+	}
+	msg := msgs[0]
+	if len(msgInfo.Edits) == 0 {
+		return msg, nil, nil
+	}
+	if msgInfo.Edits[0].PrevContent != "" {
+		msg.Content = []byte(msgInfo.Edits[0].PrevContent)
+	} else if msgInfo.Msg != nil {
+		// edits made before the replaced content was recorded, the stored msg still has the original content
+		msg.Content = []byte(msgInfo.Msg.Content)
+	}
+	return msg, msgInfo.Edits, nil
+}
+
+func (db *commonMsgDatabase) AddMsgReaction(ctx context.Context, conversationID string, seq int64, reaction *unrelationtb.ReactionModel) (bool, error) {
+	if err := db.rehydrateSeqs(ctx, conversationID, []int64{seq}); err != nil {
+		return false, err
+	}
+	docID := db.msg.GetDocID(conversationID, seq)
+	res, err := db.msgDocDatabase.PushReaction(ctx, docID, db.msg.GetMsgIndex(seq), reaction.Emoji, reaction.UserID, config.Config.MsgReaction.MaxEmojis)
+	if err != nil {
+		return false, err
+	}
+	if res.MatchedCount == 0 {
+		exist, err := db.msgDocDatabase.IsExistDocID(ctx, docID)
+		if err != nil {
+			return false, err
+		}
+		if exist {
+			return false, errs.ErrArgs.Wrap("msg can be reacted with at most " + strconv.Itoa(config.Config.MsgReaction.MaxEmojis) + " emojis")
+		}
+		// the doc does not exist yet, whoever writes first creates it
+		if err := db.BatchInsertBlock(ctx, conversationID, []any{reaction}, updateKeyReaction, seq); err != nil {
+			return false, err
+		}
+	} else if res.ModifiedCount == 0 {
+		return false, nil
+	}
+	return true, db.updateCachedMsg(ctx, conversationID, seq, func(msg *sdkws.MsgData) {
+		reactions := msgprocessor.GetReactions(msg.AttachedInfo)
+		index := reactionIndex(reactions, reaction.Emoji)
+		if index < 0 {
+			reactions = append(reactions, &msgprocessor.ReactionSummary{Emoji: reaction.Emoji})
+			index = len(reactions) - 1
+		}
+		reactions[index].Count++
+		if len(reactions[index].UserIDs) < reactionSummaryUsers {
+			reactions[index].UserIDs = append(reactions[index].UserIDs, reaction.UserID)
+		}
+		msg.AttachedInfo = msgprocessor.SetReactions(msg.AttachedInfo, reactions)
+	})
+}
+
+func (db *commonMsgDatabase) RemoveMsgReaction(ctx context.Context, conversationID string, seq int64, reaction *unrelationtb.ReactionModel) (bool, error) {
+	if err := db.rehydrateSeqs(ctx, conversationID, []int64{seq}); err != nil {
+		return false, err
+	}
+	res, err := db.msgDocDatabase.PullReaction(ctx, db.msg.GetDocID(conversationID, seq), db.msg.GetMsgIndex(seq), reaction.Emoji, reaction.UserID)
+	if err != nil {
+		return false, err
+	}
+	if res.ModifiedCount == 0 {
+		return false, nil
+	}
+	// the cached summary only keeps the first users, a user missing from it is just counted out
+	return true, db.updateCachedMsg(ctx, conversationID, seq, func(msg *sdkws.MsgData) {
+		reactions := msgprocessor.GetReactions(msg.AttachedInfo)
+		index := reactionIndex(reactions, reaction.Emoji)
+		if index < 0 {
+			return
+		}
+		reactions[index].Count--
+		if i := utils.IndexOf(reaction.UserID, reactions[index].UserIDs...); i >= 0 {
+			reactions[index].UserIDs = utils.Delete(reactions[index].UserIDs, i)
+		}
+		msg.AttachedInfo = msgprocessor.SetReactions(msg.AttachedInfo, reactions)
+	})
+}
+
+func reactionIndex(reactions []*msgprocessor.ReactionSummary, emoji string) int {
+	for i, reaction := range reactions {
+		if reaction.Emoji == emoji {
+			return i
+		}
+	}
+	return -1
+}
+
+func (db *commonMsgDatabase) GetMsgReactionUsers(ctx context.Context, conversationID string, seq int64, emoji string, pagination pagination.Pagination) (int64, []string, error) {
+	return db.msgDocDatabase.GetMsgReactionUsers(ctx, db.msg.GetDocID(conversationID, seq), db.msg.GetMsgIndex(seq), emoji, pagination)
+}
+
+func (db *commonMsgDatabase) UpdateThreadRoot(ctx context.Context, threadConversationID string, replies []*sdkws.MsgData) error {
+	conversationID, rootSeq, ok := msgprocessor.ParseThreadConversationID(threadConversationID)
+	if !ok || len(replies) == 0 {
+		return errs.ErrArgs.Wrap("invalid thread conversationID " + threadConversationID)
+	}
+	last := replies[len(replies)-1]
+	participants := make([]string, 0, len(replies)+1)
+	if reply := msgprocessor.GetThreadReply(last.AttachedInfo); reply != nil && reply.RootSendID != "" {
+		participants = append(participants, reply.RootSendID)
+	}
+	for _, msg := range replies {
+		participants = append(participants, msg.SendID)
+	}
+	participants = utils.Distinct(participants)
+	thread := &unrelationtb.ThreadModel{ReplyCount: last.Seq, LastReplySendID: last.SendID, LastReplyTime: last.SendTime}
+	if err := db.BatchInsertBlock(ctx, conversationID, []any{thread}, updateKeyThread, rootSeq); err != nil {
+		return err
+	}
+	if _, err := db.msgDocDatabase.PushUnique(ctx, db.msg.GetDocID(conversationID, rootSeq), db.msg.GetMsgIndex(rootSeq), "thread_users", participants); err != nil {
+		return err
+	}
+	return db.updateCachedMsg(ctx, conversationID, rootSeq, func(rootMsg *sdkws.MsgData) {
+		summary := msgprocessor.GetThread(rootMsg.AttachedInfo)
+		if summary == nil {
+			summary = &msgprocessor.ThreadSummary{ThreadConversationID: threadConversationID}
+		}
+		if last.Seq > summary.ReplyCount {
+			summary.ReplyCount = last.Seq
+			summary.LastReplySendID = last.SendID
+			summary.LastReplyTime = last.SendTime
+		}
+		for _, userID := range participants {
+			if len(summary.ParticipantUserIDs) < threadSummaryUsers && !utils.Contain(userID, summary.ParticipantUserIDs...) {
+				summary.ParticipantUserIDs = append(summary.ParticipantUserIDs, userID)
+			}
+		}
+		rootMsg.AttachedInfo = msgprocessor.SetThread(rootMsg.AttachedInfo, summary)
+	})
+}
+
+// createMsgDoc makes sure the doc holding seq exists, so conditional updates on it are not mistaken for a missing doc.
+func (db *commonMsgDatabase) createMsgDoc(ctx context.Context, conversationID string, seq int64) error {
+	docID := db.msg.GetDocID(conversationID, seq)
+	exist, err := db.msgDocDatabase.IsExistDocID(ctx, docID)
+	if err != nil || exist {
+		return err
+	}
+	doc := unrelationtb.MsgDocModel{
+		DocID: docID,
+		Msg:   make([]*unrelationtb.MsgInfoModel, db.msg.GetSingleGocMsgNum()),
+	}
+	for i := range doc.Msg {
+		doc.Msg[i] = &unrelationtb.MsgInfoModel{DelList: []string{}}
+	}
+	if err := db.msgDocDatabase.Create(ctx, &doc); err != nil && !mongo.IsDuplicateKeyError(err) {
+		return err
+	}
+	return nil
+}
+
+func (db *commonMsgDatabase) VotePoll(ctx context.Context, conversationID string, seq int64, vote *unrelationtb.PollVoteModel) (bool, error) {
+	if err := db.rehydrateSeqs(ctx, conversationID, []int64{seq}); err != nil {
+		return false, err
+	}
+	if err := db.createMsgDoc(ctx, conversationID, seq); err != nil {
+		return false, err
+	}
+	res, err := db.msgDocDatabase.AddPollVote(ctx, db.msg.GetDocID(conversationID, seq), db.msg.GetMsgIndex(seq), vote)
+	if err != nil {
+		return false, err
+	}
+	if res.ModifiedCount == 0 {
+		return false, nil
+	}
+	return true, db.updateCachedMsg(ctx, conversationID, seq, func(msg *sdkws.MsgData) {
+		result := msgprocessor.GetPollResult(msg.AttachedInfo)
+		if result == nil {
+			result = &msgprocessor.PollResult{}
+		}
+		for _, option := range vote.Options {
+			for int(option) >= len(result.Counts) {
+				result.Counts = append(result.Counts, 0)
+			}
+			result.Counts[option]++
+		}
+		result.VoterCount++
+		msg.AttachedInfo = msgprocessor.SetPollResult(msg.AttachedInfo, result)
+	})
+}
+
+func (db *commonMsgDatabase) GetPollVotes(ctx context.Context, conversationID string, seq int64) ([]*unrelationtb.PollVoteModel, *msgprocessor.PollResult, error) {
+	msg, err := db.msgDocDatabase.GetMsgPoll(ctx, db.msg.GetDocID(conversationID, seq), db.msg.GetMsgIndex(seq))
+	if err != nil {
+		return nil, nil, err
+	}
+	return msg.PollVotes, pollResult(msg), nil
+}
+
+// pollResult converts the tally kept by option index in the msg doc.
+func pollResult(msg *unrelationtb.MsgInfoModel) *msgprocessor.PollResult {
+	result := &msgprocessor.PollResult{Counts: []int64{}, VoterCount: int64(len(msg.PollVotes))}
+	for key, count := range msg.PollTally {
+		option, err := strconv.Atoi(key)
+		if err != nil || option < 0 {
+			continue
+		}
+		for option >= len(result.Counts) {
+			result.Counts = append(result.Counts, 0)
+		}
+		result.Counts[option] = count
+	}
+	return result
+}
+
+// applyMsgInfo merges the edits, reactions, thread and poll state kept next to a stored message into the message itself.
+func applyMsgInfo(conversationID string, msg *unrelationtb.MsgInfoModel) {
+	if msg.Msg.ContentType == constant.MsgRevokeNotification {
+		return
+	}
+	if len(msg.Edits) > 0 {
+		msg.Msg.Content = msg.Edits[len(msg.Edits)-1].Content
+		if msg.Msg.Options == nil {
+			msg.Msg.Options = make(map[string]bool)
+		}
+		msg.Msg.Options[msgprocessor.IsEdited] = true
+	}
+	if len(msg.Reactions) > 0 {
+		reactions := make([]*msgprocessor.ReactionSummary, 0, len(msg.Reactions))
+		for emoji, userIDs := range msg.Reactions {
+			reaction := &msgprocessor.ReactionSummary{Emoji: emoji, Count: int64(len(userIDs)), UserIDs: userIDs}
+			if len(userIDs) > reactionSummaryUsers {
+				reaction.UserIDs = userIDs[:reactionSummaryUsers]
+			}
+			reactions = append(reactions, reaction)
+		}
+		msg.Msg.AttachedInfo = msgprocessor.SetReactions(msg.Msg.AttachedInfo, reactions)
+	}
+	if msg.Thread != nil {
+		summary := &msgprocessor.ThreadSummary{
+			ThreadConversationID: msgprocessor.GetThreadConversationID(conversationID, msg.Msg.Seq),
+			ReplyCount:           msg.Thread.ReplyCount,
+			LastReplySendID:      msg.Thread.LastReplySendID,
+			LastReplyTime:        msg.Thread.LastReplyTime,
+			ParticipantUserIDs:   msg.ThreadUsers,
+		}
+		if len(summary.ParticipantUserIDs) > threadSummaryUsers {
+			summary.ParticipantUserIDs = summary.ParticipantUserIDs[:threadSummaryUsers]
+		}
+		msg.Msg.AttachedInfo = msgprocessor.SetThread(msg.Msg.AttachedInfo, summary)
+	}
+	if len(msg.PollVotes) > 0 {
+		msg.Msg.AttachedInfo = msgprocessor.SetPollResult(msg.Msg.AttachedInfo, pollResult(msg))
+	}
+}
+
 func (db *commonMsgDatabase) MarkSingleChatMsgsAsRead(ctx context.Context, userID string, conversationID string, totalSeqs []int64) error {
+	if err := db.rehydrateSeqs(ctx, conversationID, totalSeqs); err != nil {
+		return err
+	}
 	for docID, seqs := range db.msg.GetDocIDSeqsMap(conversationID, totalSeqs) {
 		var indexes []int64
 		for _, seq := range seqs {
@@ -412,7 +797,11 @@ func (db *commonMsgDatabase) BatchInsertChat2Cache(ctx context.Context, conversa
 }
 
 func (db *commonMsgDatabase) getMsgBySeqs(ctx context.Context, userID, conversationID string, seqs []int64) (totalMsgs []*sdkws.MsgData, err error) {
-	for docID, seqs := range db.msg.GetDocIDSeqsMap(conversationID, seqs) {
+	docIDSeqs := db.msg.GetDocIDSeqsMap(conversationID, seqs)
+	if err := db.rehydrateArchivedDocs(ctx, docIDSeqs); err != nil {
+		return nil, err
+	}
+	for docID, seqs := range docIDSeqs {
 		// log.ZDebug(ctx, "getMsgBySeqs", "docID", docID, "seqs", seqs)
 		msgs, err := db.findMsgInfoBySeq(ctx, userID, docID, conversationID, seqs)
 		if err != nil {
@@ -429,6 +818,8 @@ func (db *commonMsgDatabase) handlerDBMsg(ctx context.Context, cache map[int64][
 	if msg.IsRead {
 		msg.Msg.IsRead = true
 	}
+	// applied last, the quote fix-up below writes msg.Msg back to mongo and must keep the stored message as is
+	defer applyMsgInfo(conversationID, msg)
 	if msg.Msg.ContentType != constant.Quote {
 		return
 	}
@@ -476,6 +867,15 @@ func (db *commonMsgDatabase) handlerDBMsg(ctx context.Context, cache map[int64][
 		return
 	}
 	msg.Msg.Content = string(data)
+	// the stored msg keeps the quoted content while the conversation is on legal hold
+	held, err := db.isConversationHeld(ctx, conversationID)
+	if err != nil {
+		log.ZError(ctx, "isConversationHeld", err, "conversationID", conversationID)
+		return
+	}
+	if held {
+		return
+	}
 	if _, err := db.msgDocDatabase.UpdateMsg(ctx, db.msg.GetDocID(conversationID, msg.Msg.Seq), db.msg.GetMsgIndex(msg.Msg.Seq), "msg", msg.Msg); err != nil {
 		log.ZError(ctx, "UpdateMsgContent", err)
 	}
@@ -495,7 +895,11 @@ func (db *commonMsgDatabase) findMsgInfoBySeq(ctx context.Context, userID, docID
 
 func (db *commonMsgDatabase) getMsgBySeqsRange(ctx context.Context, userID string, conversationID string, allSeqs []int64, begin, end int64) (seqMsgs []*sdkws.MsgData, err error) {
 	log.ZDebug(ctx, "getMsgBySeqsRange", "conversationID", conversationID, "allSeqs", allSeqs, "begin", begin, "end", end)
-	for docID, seqs := range db.msg.GetDocIDSeqsMap(conversationID, allSeqs) {
+	docIDSeqs := db.msg.GetDocIDSeqsMap(conversationID, allSeqs)
+	if err := db.rehydrateArchivedDocs(ctx, docIDSeqs); err != nil {
+		return nil, err
+	}
+	for docID, seqs := range docIDSeqs {
 		log.ZDebug(ctx, "getMsgBySeqsRange", "docID", docID, "seqs", seqs)
 		msgs, err := db.findMsgInfoBySeq(ctx, userID, docID, conversationID, seqs)
 		if err != nil {
@@ -722,12 +1126,26 @@ func (db *commonMsgDatabase) GetMsgBySeqs(ctx context.Context, userID string, co
 }
 
 func (db *commonMsgDatabase) DeleteConversationMsgsAndSetMinSeq(ctx context.Context, conversationID string, remainTime int64) error {
+	held, err := db.isConversationHeld(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	if held {
+		return db.holdConversationMsgsAndSetMinSeq(ctx, conversationID, remainTime)
+	}
+	archivedMinSeq, err := db.deleteExpiredArchives(ctx, conversationID, remainTime)
+	if err != nil {
+		return err
+	}
 	var delStruct delMsgRecursionStruct
 	var skip int64
 	minSeq, err := db.deleteMsgRecursion(ctx, conversationID, skip, &delStruct, remainTime)
 	if err != nil {
 		return err
 	}
+	if archivedMinSeq > minSeq {
+		minSeq = archivedMinSeq
+	}
 	log.ZInfo(ctx, "DeleteConversationMsgsAndSetMinSeq", "conversationID", conversationID, "minSeq", minSeq)
 	if minSeq == 0 {
 		return nil
@@ -741,6 +1159,249 @@ func (db *commonMsgDatabase) DeleteConversationMsgsAndSetMinSeq(ctx context.Cont
 	return db.cache.SetMinSeq(ctx, conversationID, minSeq)
 }
 
+// expiredArchives returns the archived docs of the conversation whose newest msg is older than remainTime seconds,
+// archived docs are full so they expire as a whole, like the full docs in deleteMsgRecursion.
+func (db *commonMsgDatabase) expiredArchives(ctx context.Context, conversationID string, remainTime int64) ([]*relation.MsgArchiveModel, error) {
+	if db.archive == nil {
+		return nil, nil
+	}
+	archives, err := db.archive.FindConversation(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	now := utils.GetCurrentTimestampByMill()
+	return utils.Filter(archives, func(archive *relation.MsgArchiveModel) (*relation.MsgArchiveModel, bool) {
+		return archive, archive.EndTime+(remainTime*1000) < now
+	}), nil
+}
+
+// deleteExpiredArchives removes the expired archived docs from object storage and returns the min seq past them, 0 when none expired.
+func (db *commonMsgDatabase) deleteExpiredArchives(ctx context.Context, conversationID string, remainTime int64) (int64, error) {
+	archives, err := db.expiredArchives(ctx, conversationID, remainTime)
+	if err != nil {
+		return 0, err
+	}
+	var minSeq int64
+	for _, archive := range archives {
+		if err := db.archive.Destroy(ctx, archive); err != nil {
+			return 0, err
+		}
+		log.ZDebug(ctx, "archived msg doc expired", "conversationID", conversationID, "docID", archive.DocID)
+		if archive.EndSeq+1 > minSeq {
+			minSeq = archive.EndSeq + 1
+		}
+	}
+	return minSeq, nil
+}
+
+func (db *commonMsgDatabase) isConversationHeld(ctx context.Context, conversationID string) (bool, error) {
+	if db.legalHold == nil {
+		return false, nil
+	}
+	return db.legalHold.IsConversationHeld(ctx, conversationID)
+}
+
+// holdConversationMsgsAndSetMinSeq only raises the min seq past the expired msgs, so users stop seeing them while they stay in mongo.
+func (db *commonMsgDatabase) holdConversationMsgsAndSetMinSeq(ctx context.Context, conversationID string, remainTime int64) error {
+	_, minSeq, err := db.CountConversationExpiredMsgs(ctx, conversationID, remainTime)
+	if err != nil {
+		return err
+	}
+	log.ZInfo(ctx, "conversation on legal hold, msgs kept", "conversationID", conversationID, "minSeq", minSeq)
+	if minSeq == 0 {
+		return nil
+	}
+	currentMinSeq, err := db.cache.GetMinSeq(ctx, conversationID)
+	if err != nil && errs.Unwrap(err) != redis.Nil {
+		return err
+	}
+	if currentMinSeq >= minSeq {
+		return nil
+	}
+	return db.cache.SetMinSeq(ctx, conversationID, minSeq)
+}
+
+// CountConversationExpiredMsgs walks the archived docs and the docs the same way as DeleteConversationMsgsAndSetMinSeq
+// without deleting anything, minSeq is 0 when nothing would be deleted.
+func (db *commonMsgDatabase) CountConversationExpiredMsgs(ctx context.Context, conversationID string, remainTime int64) (int64, int64, error) {
+	var (
+		count  int64
+		minSeq int64
+	)
+	archives, err := db.expiredArchives(ctx, conversationID, remainTime)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, archive := range archives {
+		count += archive.MsgCount
+		if archive.EndSeq > minSeq {
+			minSeq = archive.EndSeq
+		}
+	}
+	now := utils.GetCurrentTimestampByMill()
+	for index := int64(0); ; index++ {
+		msgDocModel, err := db.msgDocDatabase.GetMsgDocModelByIndex(ctx, conversationID, index, 1)
+		if err != nil {
+			if err == unrelation.ErrMsgListNotExist {
+				break
+			}
+			return 0, 0, err
+		}
+		full := msgDocModel.IsFull() && msgDocModel.Msg[len(msgDocModel.Msg)-1].Msg.SendTime+(remainTime*1000) < now
+		for _, msg := range msgDocModel.Msg {
+			if msg == nil || msg.Msg == nil {
+				continue
+			}
+			if full || now > msg.Msg.SendTime+(remainTime*1000) {
+				count++
+				minSeq = msg.Msg.Seq
+			}
+		}
+	}
+	if minSeq == 0 {
+		return count, 0, nil
+	}
+	return count, minSeq + 1, nil
+}
+
+// ArchiveConversationMsgs moves the full docs of a conversation whose newest msg is older than archiveTime seconds
+// from mongo to object storage, oldest first, and stops at the first doc that is still hot.
+func (db *commonMsgDatabase) ArchiveConversationMsgs(ctx context.Context, conversationID string, archiveTime int64) (int, error) {
+	if db.archive == nil {
+		return 0, errs.ErrInternalServer.Wrap("msg archive is not enabled")
+	}
+	var count int
+	for {
+		msgDocModel, err := db.msgDocDatabase.GetMsgDocModelByIndex(ctx, conversationID, 0, 1)
+		if err != nil {
+			if err == unrelation.ErrMsgListNotExist {
+				return count, nil
+			}
+			return count, err
+		}
+		if !msgDocModel.IsFull() || msgDocModel.Msg[len(msgDocModel.Msg)-1].Msg.SendTime+(archiveTime*1000) >= utils.GetCurrentTimestampByMill() {
+			return count, nil
+		}
+		if err := db.archive.Archive(ctx, conversationID, msgDocModel); err != nil {
+			return count, err
+		}
+		if err := db.deleteArchivedDoc(ctx, conversationID, msgDocModel); err != nil {
+			return count, err
+		}
+		log.ZDebug(ctx, "msg doc archived", "conversationID", conversationID, "docID", msgDocModel.DocID)
+		count++
+	}
+}
+
+// deleteArchivedDoc deletes the archived doc from mongo, a write landing between the archive and the delete
+// leaves the deleted doc different from the archived one, then the deleted doc is archived again.
+func (db *commonMsgDatabase) deleteArchivedDoc(ctx context.Context, conversationID string, archived *unrelationtb.MsgDocModel) error {
+	doc, err := db.msgDocDatabase.FindOneAndDeleteByDocID(ctx, archived.DocID)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil
+		}
+		return errs.Wrap(err)
+	}
+	if reflect.DeepEqual(doc, archived) {
+		return nil
+	}
+	log.ZDebug(ctx, "msg doc written while archiving", "conversationID", conversationID, "docID", doc.DocID)
+	if err := db.archive.Archive(ctx, conversationID, doc); err != nil {
+		if restoreErr := db.restoreDoc(ctx, doc); restoreErr != nil {
+			log.ZError(ctx, "restore msg doc failed", restoreErr, "conversationID", conversationID, "docID", doc.DocID)
+		}
+		return err
+	}
+	return nil
+}
+
+// restoreDoc puts doc back to mongo. When the doc id exists, a write to an archived doc missed the rehydrate
+// and created a new doc, the msgs of doc are then merged into it without overwriting that write.
+func (db *commonMsgDatabase) restoreDoc(ctx context.Context, doc *unrelationtb.MsgDocModel) error {
+	err := db.msgDocDatabase.Create(ctx, doc)
+	if err == nil {
+		return nil
+	}
+	if !mongo.IsDuplicateKeyError(err) {
+		return errs.Wrap(err)
+	}
+	for index, info := range doc.Msg {
+		if info == nil {
+			continue
+		}
+		if err := db.msgDocDatabase.MergeMsg(ctx, doc.DocID, int64(index), info); err != nil {
+			return err
+		}
+		for _, vote := range info.PollVotes {
+			if _, err := db.msgDocDatabase.AddPollVote(ctx, doc.DocID, int64(index), vote); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// RangeConversationMsgs calls fn with the msgs of each doc of the conversation still in mongo, edits are applied.
+// Archived docs are skipped, reading them through GetMsgBySeqs would put every one of them back to mongo.
+func (db *commonMsgDatabase) RangeConversationMsgs(ctx context.Context, conversationID string, fn func(msgs []*sdkws.MsgData) error) error {
+	for index := int64(0); ; index++ {
+		msgDocModel, err := db.msgDocDatabase.GetMsgDocModelByIndex(ctx, conversationID, index, 1)
+		if err != nil {
+			if err == unrelation.ErrMsgListNotExist {
+				return nil
+			}
+			return err
+		}
+		msgs := make([]*sdkws.MsgData, 0, len(msgDocModel.Msg))
+		for _, msg := range msgDocModel.Msg {
+			if msg == nil || msg.Msg == nil || msg.Revoke != nil || utils.IsContain(unrelationtb.DelListAllUsers, msg.DelList) {
+				continue
+			}
+			applyMsgInfo(conversationID, msg)
+			msgs = append(msgs, convert.MsgDB2Pb(msg.Msg))
+		}
+		if len(msgs) == 0 {
+			continue
+		}
+		if err := fn(msgs); err != nil {
+			return err
+		}
+	}
+}
+
+// rehydrateSeqs puts the archived docs holding seqs back to mongo before they are written to,
+// otherwise the write misses the doc and is lost once the archived doc is read back.
+func (db *commonMsgDatabase) rehydrateSeqs(ctx context.Context, conversationID string, seqs []int64) error {
+	return db.rehydrateArchivedDocs(ctx, db.msg.GetDocIDSeqsMap(conversationID, seqs))
+}
+
+// rehydrateArchivedDocs puts the archived docs among docIDSeqs back to mongo, so they are read like any other doc.
+func (db *commonMsgDatabase) rehydrateArchivedDocs(ctx context.Context, docIDSeqs map[string][]int64) error {
+	if db.archive == nil || len(docIDSeqs) == 0 {
+		return nil
+	}
+	archives, err := db.archive.Find(ctx, utils.Keys(docIDSeqs))
+	if err != nil {
+		return err
+	}
+	for _, archive := range archives {
+		doc, err := db.archive.Load(ctx, archive)
+		if err != nil {
+			return err
+		}
+		// the index is only dropped once the msgs are back in mongo, otherwise the archived doc is unreachable
+		if err := db.restoreDoc(ctx, doc); err != nil {
+			return err
+		}
+		if err := db.archive.Delete(ctx, []string{archive.DocID}); err != nil {
+			return err
+		}
+		log.ZDebug(ctx, "msg doc rehydrated", "conversationID", archive.ConversationID, "docID", archive.DocID)
+	}
+	return nil
+}
+
 func (db *commonMsgDatabase) UserMsgsDestruct(ctx context.Context, userID string, conversationID string, destructTime int64, lastMsgDestructTime time.Time) (seqs []int64, err error) {
 	var index int64
 	for {
@@ -857,10 +1518,25 @@ func (db *commonMsgDatabase) deleteMsgRecursion(ctx context.Context, conversatio
 }
 
 func (db *commonMsgDatabase) DeleteMsgsPhysicalBySeqs(ctx context.Context, conversationID string, allSeqs []int64) error {
+	held, err := db.isConversationHeld(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	if err := db.rehydrateSeqs(ctx, conversationID, allSeqs); err != nil {
+		return err
+	}
 	if err := db.cache.DeleteMessages(ctx, conversationID, allSeqs); err != nil {
 		return err
 	}
 	for docID, seqs := range db.msg.GetDocIDSeqsMap(conversationID, allSeqs) {
+		if held {
+			for _, seq := range seqs {
+				if _, err := db.msgDocDatabase.PushUnique(ctx, docID, db.msg.GetMsgIndex(seq), "del_list", []string{unrelationtb.DelListAllUsers}); err != nil {
+					return err
+				}
+			}
+			continue
+		}
 		var indexes []int
 		for _, seq := range seqs {
 			indexes = append(indexes, int(db.msg.GetMsgIndex(seq)))
@@ -873,6 +1549,9 @@ func (db *commonMsgDatabase) DeleteMsgsPhysicalBySeqs(ctx context.Context, conve
 }
 
 func (db *commonMsgDatabase) DeleteUserMsgsBySeqs(ctx context.Context, userID string, conversationID string, seqs []int64) error {
+	if err := db.rehydrateSeqs(ctx, conversationID, seqs); err != nil {
+		return err
+	}
 	cachedMsgs, _, err := db.cache.GetMessagesBySeq(ctx, conversationID, seqs)
 	if err != nil && errs.Unwrap(err) != redis.Nil {
 		log.ZWarn(ctx, "DeleteUserMsgsBySeqs", err, "conversationID", conversationID, "seqs", seqs)
@@ -991,6 +1670,10 @@ func (db *commonMsgDatabase) GetSendMsgStatus(ctx context.Context, id string) (i
 	return db.cache.GetSendMsgStatus(ctx, id)
 }
 
+func (db *commonMsgDatabase) GetMsgDeliveryStatus(ctx context.Context, conversationID string, seq int64) (map[string]*cache.MsgDeliveryStatus, error) {
+	return db.cache.GetMsgDeliveryStatus(ctx, conversationID, seq)
+}
+
 func (db *commonMsgDatabase) GetConversationMinMaxSeqInMongoAndCache(ctx context.Context, conversationID string) (minSeqMongo, maxSeqMongo, minSeqCache, maxSeqCache int64, err error) {
 	minSeqMongo, maxSeqMongo, err = db.GetMinMaxSeqMongo(ctx, conversationID)
 	if err != nil {
@@ -1048,21 +1731,6 @@ func (db *commonMsgDatabase) RangeGroupSendCount(
 	return db.msgDocDatabase.RangeGroupSendCount(ctx, start, end, ase, pageNumber, showNumber)
 }
 
-func (db *commonMsgDatabase) SearchMessage(ctx context.Context, req *pbmsg.SearchMessageReq) (total int32, msgData []*sdkws.MsgData, err error) {
-	var totalMsgs []*sdkws.MsgData
-	total, msgs, err := db.msgDocDatabase.SearchMessage(ctx, req)
-	if err != nil {
-		return 0, nil, err
-	}
-	for _, msg := range msgs {
-		if msg.IsRead {
-			msg.Msg.IsRead = true
-		}
-		totalMsgs = append(totalMsgs, convert.MsgDB2Pb(msg.Msg))
-	}
-	return total, totalMsgs, nil
-}
-
 func (db *commonMsgDatabase) FindOneByDocIDs(ctx context.Context, conversationIDs []string, seqs map[string]int64) (map[string]*sdkws.MsgData, error) {
 	totalMsgs := make(map[string]*sdkws.MsgData)
 	for _, conversationID := range conversationIDs {
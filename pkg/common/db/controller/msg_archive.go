@@ -0,0 +1,219 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/redis/go-redis/v9"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/s3"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	unrelationtb "github.com/openimsdk/open-im-server/v3/pkg/common/db/table/unrelation"
+)
+
+const msgArchivePath = "openim/msg_archive/"
+
+// MsgArchive keeps full msg docs as gzip compressed bson in object storage, indexed by doc id in mongo.
+type MsgArchive interface {
+	// 归档消息文档到对象存储并记录索引
+	Archive(ctx context.Context, conversationID string, doc *unrelationtb.MsgDocModel) error
+	// 根据docID查询归档索引
+	Find(ctx context.Context, docIDs []string) ([]*relation.MsgArchiveModel, error)
+	// 从对象存储读取归档的消息文档
+	Load(ctx context.Context, archive *relation.MsgArchiveModel) (*unrelationtb.MsgDocModel, error)
+	// 删除归档索引，对象存储中的文件保留，再次归档时覆盖
+	Delete(ctx context.Context, docIDs []string) error
+	// 按seq升序获取会话的归档索引, 缺少统计信息的旧索引会读取归档补全
+	FindConversation(ctx context.Context, conversationID string) ([]*relation.MsgArchiveModel, error)
+	// 删除归档索引和对象存储中的文件, 用于过期清理
+	Destroy(ctx context.Context, archive *relation.MsgArchiveModel) error
+}
+
+func NewMsgArchive(archiveDB relation.MsgArchiveModelInterface, s3 s3.Interface) MsgArchive {
+	return &msgArchive{archiveDB: archiveDB, s3: s3}
+}
+
+// InitMsgArchive returns nil when msgArchive is not enabled in the config.
+func InitMsgArchive(rdb redis.UniversalClient, database *mongo.Database) (MsgArchive, error) {
+	if !config.Config.MsgArchive.Enable {
+		return nil, nil
+	}
+	archiveDB, err := mgo.NewMsgArchiveMongo(database)
+	if err != nil {
+		return nil, err
+	}
+	var o s3.Interface
+	if bucket := config.Config.MsgArchive.Bucket; bucket == "" {
+		o, err = NewS3(rdb)
+	} else {
+		o, err = NewPrivateS3(rdb, bucket)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// archived docs hold whole conversations at predictable object names, never serve them anonymously
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	publicRead, err := o.IsPublicRead(ctx, msgArchivePath)
+	if err != nil {
+		return nil, err
+	}
+	if publicRead {
+		return nil, errs.ErrArgs.Wrap("msg archive refuses a public read bucket, set msgArchive.bucket to a private bucket")
+	}
+	return NewMsgArchive(archiveDB, o), nil
+}
+
+type msgArchive struct {
+	archiveDB relation.MsgArchiveModelInterface
+	s3        s3.Interface
+}
+
+func (m *msgArchive) Archive(ctx context.Context, conversationID string, doc *unrelationtb.MsgDocModel) error {
+	data, err := encodeMsgDoc(doc)
+	if err != nil {
+		return err
+	}
+	name := msgArchivePath + strings.ReplaceAll(doc.DocID, ":", "/") + ".bson.gz"
+	if err := m.s3.PutObject(ctx, name, bytes.NewReader(data), int64(len(data))); err != nil {
+		return err
+	}
+	archive := &relation.MsgArchiveModel{
+		DocID:          doc.DocID,
+		ConversationID: conversationID,
+		Object:         name,
+		Size:           int64(len(data)),
+		CreateTime:     time.Now(),
+	}
+	setMsgArchiveStat(archive, doc)
+	return m.archiveDB.Upsert(ctx, archive)
+}
+
+func setMsgArchiveStat(archive *relation.MsgArchiveModel, doc *unrelationtb.MsgDocModel) {
+	archive.MsgCount = 0
+	for _, msg := range doc.Msg {
+		if msg == nil || msg.Msg == nil {
+			continue
+		}
+		archive.MsgCount++
+		if archive.BeginSeq == 0 || msg.Msg.Seq < archive.BeginSeq {
+			archive.BeginSeq = msg.Msg.Seq
+		}
+		if msg.Msg.Seq > archive.EndSeq {
+			archive.EndSeq = msg.Msg.Seq
+			archive.EndTime = msg.Msg.SendTime
+		}
+	}
+}
+
+func (m *msgArchive) FindConversation(ctx context.Context, conversationID string) ([]*relation.MsgArchiveModel, error) {
+	archives, err := m.archiveDB.FindByConversation(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	for _, archive := range archives {
+		if archive.EndTime != 0 {
+			continue
+		}
+		doc, err := m.Load(ctx, archive)
+		if err != nil {
+			return nil, err
+		}
+		setMsgArchiveStat(archive, doc)
+		if err := m.archiveDB.Upsert(ctx, archive); err != nil {
+			return nil, err
+		}
+	}
+	return archives, nil
+}
+
+func (m *msgArchive) Destroy(ctx context.Context, archive *relation.MsgArchiveModel) error {
+	if err := m.s3.DeleteObject(ctx, archive.Object); err != nil && !m.s3.IsNotFound(err) {
+		return err
+	}
+	return m.archiveDB.Delete(ctx, []string{archive.DocID})
+}
+
+func (m *msgArchive) Find(ctx context.Context, docIDs []string) ([]*relation.MsgArchiveModel, error) {
+	return m.archiveDB.Find(ctx, docIDs)
+}
+
+func (m *msgArchive) Load(ctx context.Context, archive *relation.MsgArchiveModel) (*unrelationtb.MsgDocModel, error) {
+	reader, err := m.s3.GetObject(ctx, archive.Object)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	doc, err := decodeMsgDoc(data)
+	if err != nil {
+		return nil, err
+	}
+	if doc.DocID != archive.DocID {
+		return nil, errs.ErrInternalServer.Wrap("archived doc id " + doc.DocID + " not match " + archive.DocID)
+	}
+	return doc, nil
+}
+
+func (m *msgArchive) Delete(ctx context.Context, docIDs []string) error {
+	return m.archiveDB.Delete(ctx, docIDs)
+}
+
+func encodeMsgDoc(doc *unrelationtb.MsgDocModel) ([]byte, error) {
+	data, err := bson.Marshal(doc)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeMsgDoc(data []byte) (*unrelationtb.MsgDocModel, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer r.Close()
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var doc unrelationtb.MsgDocModel
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &doc, nil
+}
@@ -0,0 +1,439 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/utils"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	unrelationtb "github.com/openimsdk/open-im-server/v3/pkg/common/db/table/unrelation"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/unrelation"
+)
+
+// fakeMsgDocDB only implements the doc reads and writes used when reading and deleting msgs and reacting to them.
+type fakeMsgDocDB struct {
+	unrelationtb.MsgDocModelInterface
+	docs map[string]*unrelationtb.MsgDocModel
+}
+
+func (f *fakeMsgDocDB) Create(ctx context.Context, model *unrelationtb.MsgDocModel) error {
+	if _, ok := f.docs[model.DocID]; ok {
+		return mongo.WriteException{WriteErrors: mongo.WriteErrors{{Code: 11000}}}
+	}
+	f.docs[model.DocID] = model
+	return nil
+}
+
+func (f *fakeMsgDocDB) GetMsgDocModelByIndex(ctx context.Context, conversationID string, index, order int64) (*unrelationtb.MsgDocModel, error) {
+	var docIDs []string
+	for docID := range f.docs {
+		if strings.HasPrefix(docID, conversationID+":") {
+			docIDs = append(docIDs, docID)
+		}
+	}
+	if index >= int64(len(docIDs)) {
+		return nil, unrelation.ErrMsgListNotExist
+	}
+	sort.Strings(docIDs)
+	if order < 0 {
+		index = int64(len(docIDs)) - 1 - index
+	}
+	return f.docs[docIDs[index]], nil
+}
+
+func (f *fakeMsgDocDB) DeleteDocs(ctx context.Context, docIDs []string) error {
+	for _, docID := range docIDs {
+		delete(f.docs, docID)
+	}
+	return nil
+}
+
+func (f *fakeMsgDocDB) FindOneAndDeleteByDocID(ctx context.Context, docID string) (*unrelationtb.MsgDocModel, error) {
+	doc, ok := f.docs[docID]
+	if !ok {
+		return nil, mongo.ErrNoDocuments
+	}
+	delete(f.docs, docID)
+	return doc, nil
+}
+
+// MergeMsg only merges the msg, revoke, edits and thread.
+func (f *fakeMsgDocDB) MergeMsg(ctx context.Context, docID string, index int64, info *unrelationtb.MsgInfoModel) error {
+	doc, ok := f.docs[docID]
+	if !ok {
+		return nil
+	}
+	stored := doc.Msg[index]
+	if stored == nil {
+		doc.Msg[index] = info
+		return nil
+	}
+	if stored.Msg == nil {
+		stored.Msg = info.Msg
+	}
+	if stored.Revoke == nil {
+		stored.Revoke = info.Revoke
+	}
+	stored.Edits = append(append([]*unrelationtb.EditModel{}, info.Edits...), stored.Edits...)
+	if stored.Thread == nil || (info.Thread != nil && info.Thread.ReplyCount > stored.Thread.ReplyCount) {
+		stored.Thread = info.Thread
+	}
+	return nil
+}
+
+func (f *fakeMsgDocDB) IsExistDocID(ctx context.Context, docID string) (bool, error) {
+	_, ok := f.docs[docID]
+	return ok, nil
+}
+
+func (f *fakeMsgDocDB) PushReaction(ctx context.Context, docID string, index int64, emoji string, userID string, maxEmojis int) (*mongo.UpdateResult, error) {
+	doc, ok := f.docs[docID]
+	if !ok {
+		return &mongo.UpdateResult{}, nil
+	}
+	if doc.Msg[index] == nil {
+		doc.Msg[index] = &unrelationtb.MsgInfoModel{}
+	}
+	msg := doc.Msg[index]
+	userIDs, ok := msg.Reactions[emoji]
+	if !ok && maxEmojis > 0 && len(msg.Reactions) >= maxEmojis {
+		return &mongo.UpdateResult{}, nil
+	}
+	if utils.IsContain(userID, userIDs) {
+		return &mongo.UpdateResult{MatchedCount: 1}, nil
+	}
+	if msg.Reactions == nil {
+		msg.Reactions = make(map[string][]string)
+	}
+	msg.Reactions[emoji] = append(userIDs, userID)
+	return &mongo.UpdateResult{MatchedCount: 1, ModifiedCount: 1}, nil
+}
+
+func (f *fakeMsgDocDB) PullReaction(ctx context.Context, docID string, index int64, emoji string, userID string) (*mongo.UpdateResult, error) {
+	doc, ok := f.docs[docID]
+	if !ok {
+		return &mongo.UpdateResult{}, nil
+	}
+	msg := doc.Msg[index]
+	if msg == nil {
+		return &mongo.UpdateResult{MatchedCount: 1}, nil
+	}
+	i := utils.IndexOf(userID, msg.Reactions[emoji]...)
+	if i < 0 {
+		return &mongo.UpdateResult{MatchedCount: 1}, nil
+	}
+	msg.Reactions[emoji] = utils.Delete(msg.Reactions[emoji], i)
+	if len(msg.Reactions[emoji]) == 0 {
+		delete(msg.Reactions, emoji)
+	}
+	return &mongo.UpdateResult{MatchedCount: 1, ModifiedCount: 1}, nil
+}
+
+func (f *fakeMsgDocDB) DeleteMsgsInOneDocByIndex(ctx context.Context, docID string, indexes []int) error {
+	doc, ok := f.docs[docID]
+	if !ok {
+		return nil
+	}
+	for _, index := range indexes {
+		doc.Msg[index].Msg = nil
+	}
+	return nil
+}
+
+// GetMsgBySeqIndexIn1Doc skips the entries without a stored msg like the mongo driver.
+func (f *fakeMsgDocDB) GetMsgBySeqIndexIn1Doc(ctx context.Context, userID, docID string, seqs []int64) ([]*unrelationtb.MsgInfoModel, error) {
+	doc, ok := f.docs[docID]
+	if !ok {
+		return nil, mongo.ErrNoDocuments
+	}
+	var msgs []*unrelationtb.MsgInfoModel
+	for _, seq := range seqs {
+		if msg := doc.Msg[doc.GetMsgIndex(seq)]; msg != nil && msg.Msg != nil {
+			msgs = append(msgs, msg)
+		}
+	}
+	return msgs, nil
+}
+
+func (f *fakeMsgDocDB) GetMsgEdits(ctx context.Context, docID string, index int64) (*unrelationtb.MsgInfoModel, error) {
+	doc, ok := f.docs[docID]
+	if !ok || doc.Msg[index] == nil {
+		return &unrelationtb.MsgInfoModel{}, nil
+	}
+	return doc.Msg[index], nil
+}
+
+// fakeMsgCache only implements the seqs, the cached msgs, DeleteMessages and SetMinSeq.
+type fakeMsgCache struct {
+	cache.MsgModel
+	minSeq int64
+	maxSeq int64
+	msgs   map[int64]*sdkws.MsgData
+}
+
+func (f *fakeMsgCache) GetMinSeq(ctx context.Context, conversationID string) (int64, error) {
+	return f.minSeq, nil
+}
+
+func (f *fakeMsgCache) GetMaxSeq(ctx context.Context, conversationID string) (int64, error) {
+	return f.maxSeq, nil
+}
+
+func (f *fakeMsgCache) GetConversationUserMinSeq(ctx context.Context, conversationID string, userID string) (int64, error) {
+	return 0, nil
+}
+
+func (f *fakeMsgCache) GetMessagesBySeq(ctx context.Context, conversationID string, seqs []int64) ([]*sdkws.MsgData, []int64, error) {
+	var (
+		msgs       []*sdkws.MsgData
+		failedSeqs []int64
+	)
+	for _, seq := range seqs {
+		if msg, ok := f.msgs[seq]; ok {
+			msgs = append(msgs, msg)
+		} else {
+			failedSeqs = append(failedSeqs, seq)
+		}
+	}
+	return msgs, failedSeqs, nil
+}
+
+func (f *fakeMsgCache) DeleteMessages(ctx context.Context, conversationID string, seqs []int64) error {
+	return nil
+}
+
+func (f *fakeMsgCache) SetMinSeq(ctx context.Context, conversationID string, minSeq int64) error {
+	f.minSeq = minSeq
+	return nil
+}
+
+type fakeMsgArchive struct {
+	docs map[string]*unrelationtb.MsgDocModel
+	// onArchive runs after each archive, it stands for a write landing before the doc is deleted from mongo
+	onArchive func(doc *unrelationtb.MsgDocModel)
+}
+
+func (f *fakeMsgArchive) Archive(ctx context.Context, conversationID string, doc *unrelationtb.MsgDocModel) error {
+	f.docs[doc.DocID] = doc
+	if f.onArchive != nil {
+		f.onArchive(doc)
+	}
+	return nil
+}
+
+func (f *fakeMsgArchive) Find(ctx context.Context, docIDs []string) ([]*relation.MsgArchiveModel, error) {
+	var archives []*relation.MsgArchiveModel
+	for _, docID := range docIDs {
+		if _, ok := f.docs[docID]; ok {
+			archives = append(archives, &relation.MsgArchiveModel{DocID: docID})
+		}
+	}
+	return archives, nil
+}
+
+func (f *fakeMsgArchive) Load(ctx context.Context, archive *relation.MsgArchiveModel) (*unrelationtb.MsgDocModel, error) {
+	return f.docs[archive.DocID], nil
+}
+
+func (f *fakeMsgArchive) Delete(ctx context.Context, docIDs []string) error {
+	for _, docID := range docIDs {
+		delete(f.docs, docID)
+	}
+	return nil
+}
+
+func (f *fakeMsgArchive) FindConversation(ctx context.Context, conversationID string) ([]*relation.MsgArchiveModel, error) {
+	var archives []*relation.MsgArchiveModel
+	for _, doc := range f.docs {
+		archive := &relation.MsgArchiveModel{DocID: doc.DocID, ConversationID: conversationID}
+		setMsgArchiveStat(archive, doc)
+		archives = append(archives, archive)
+	}
+	return archives, nil
+}
+
+func (f *fakeMsgArchive) Destroy(ctx context.Context, archive *relation.MsgArchiveModel) error {
+	delete(f.docs, archive.DocID)
+	return nil
+}
+
+func newArchivedDoc(conversationID string, sendTime int64) *unrelationtb.MsgDocModel {
+	var msgDoc unrelationtb.MsgDocModel
+	doc := &unrelationtb.MsgDocModel{DocID: msgDoc.GetDocID(conversationID, 1), Msg: make([]*unrelationtb.MsgInfoModel, msgDoc.GetSingleGocMsgNum())}
+	for i := range doc.Msg {
+		doc.Msg[i] = &unrelationtb.MsgInfoModel{Msg: &unrelationtb.MsgDataModel{Seq: int64(i) + 1, SendTime: sendTime}}
+	}
+	return doc
+}
+
+func TestDeleteMsgsPhysicalBySeqsInArchivedDoc(t *testing.T) {
+	const conversationID = "si_1_2"
+	doc := newArchivedDoc(conversationID, utils.GetCurrentTimestampByMill())
+	docID := doc.DocID
+	docDB := &fakeMsgDocDB{docs: make(map[string]*unrelationtb.MsgDocModel)}
+	archive := &fakeMsgArchive{docs: map[string]*unrelationtb.MsgDocModel{docID: doc}}
+	db := &commonMsgDatabase{msgDocDatabase: docDB, cache: &fakeMsgCache{}, archive: archive}
+
+	if err := db.DeleteMsgsPhysicalBySeqs(context.Background(), conversationID, []int64{3}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := archive.docs[docID]; ok {
+		t.Fatal("archive index not removed after rehydrate")
+	}
+	restored, ok := docDB.docs[docID]
+	if !ok {
+		t.Fatal("archived doc not restored to mongo")
+	}
+	if restored.Msg[2].Msg != nil {
+		t.Fatal("seq 3 not deleted from the restored doc")
+	}
+	if restored.Msg[1].Msg == nil || restored.Msg[3].Msg == nil {
+		t.Fatal("neighbouring seqs deleted")
+	}
+}
+
+func TestRetentionOfArchivedDoc(t *testing.T) {
+	const conversationID = "si_1_2"
+	const day = 24 * 60 * 60
+	doc := newArchivedDoc(conversationID, utils.GetCurrentTimestampByMill()-10*day*1000)
+	msgCache := &fakeMsgCache{}
+	archive := &fakeMsgArchive{docs: map[string]*unrelationtb.MsgDocModel{doc.DocID: doc}}
+	db := &commonMsgDatabase{msgDocDatabase: &fakeMsgDocDB{docs: make(map[string]*unrelationtb.MsgDocModel)}, cache: msgCache, archive: archive}
+	ctx := context.Background()
+
+	count, minSeq, err := db.CountConversationExpiredMsgs(ctx, conversationID, 30*day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 || minSeq != 0 {
+		t.Fatalf("unexpired archive counted, count %d minSeq %d", count, minSeq)
+	}
+	count, minSeq, err = db.CountConversationExpiredMsgs(ctx, conversationID, 7*day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != int64(len(doc.Msg)) || minSeq != int64(len(doc.Msg))+1 {
+		t.Fatalf("expired archive count %d minSeq %d", count, minSeq)
+	}
+	if err := db.DeleteConversationMsgsAndSetMinSeq(ctx, conversationID, 7*day); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := archive.docs[doc.DocID]; ok {
+		t.Fatal("expired archive not destroyed")
+	}
+	if msgCache.minSeq != int64(len(doc.Msg))+1 {
+		t.Fatalf("min seq %d", msgCache.minSeq)
+	}
+}
+
+func TestRangeConversationMsgsSkipsArchivedDocs(t *testing.T) {
+	const conversationID = "si_1_2"
+	archived := newArchivedDoc(conversationID, utils.GetCurrentTimestampByMill())
+	var msgDoc unrelationtb.MsgDocModel
+	seq := msgDoc.GetSingleGocMsgNum() + 1
+	doc := &unrelationtb.MsgDocModel{DocID: msgDoc.GetDocID(conversationID, seq), Msg: make([]*unrelationtb.MsgInfoModel, msgDoc.GetSingleGocMsgNum())}
+	doc.Msg[0] = &unrelationtb.MsgInfoModel{
+		Msg:   &unrelationtb.MsgDataModel{Seq: seq, Content: "v1"},
+		Edits: []*unrelationtb.EditModel{{Content: "v2"}},
+	}
+	doc.Msg[1] = &unrelationtb.MsgInfoModel{Msg: &unrelationtb.MsgDataModel{Seq: seq + 1}, Revoke: &unrelationtb.RevokeModel{}}
+	doc.Msg[2] = &unrelationtb.MsgInfoModel{Msg: &unrelationtb.MsgDataModel{Seq: seq + 2}, DelList: []string{unrelationtb.DelListAllUsers}}
+	archive := &fakeMsgArchive{docs: map[string]*unrelationtb.MsgDocModel{archived.DocID: archived}}
+	db := &commonMsgDatabase{msgDocDatabase: &fakeMsgDocDB{docs: map[string]*unrelationtb.MsgDocModel{doc.DocID: doc}}, cache: &fakeMsgCache{}, archive: archive}
+
+	var msgs []*sdkws.MsgData
+	err := db.RangeConversationMsgs(context.Background(), conversationID, func(docMsgs []*sdkws.MsgData) error {
+		msgs = append(msgs, docMsgs...)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 1 || msgs[0].Seq != seq || string(msgs[0].Content) != "v2" {
+		t.Fatalf("msgs %+v", msgs)
+	}
+	if _, ok := archive.docs[archived.DocID]; !ok {
+		t.Fatal("archived doc rehydrated")
+	}
+}
+
+func TestRehydrateMergesIntoWrittenDoc(t *testing.T) {
+	const conversationID = "sg_1"
+	archived := newArchivedDoc(conversationID, utils.GetCurrentTimestampByMill())
+	archived.Msg[0].Edits = []*unrelationtb.EditModel{{Content: "v2"}}
+	// a thread reply written while the root was archived created a doc holding only the thread
+	written := &unrelationtb.MsgDocModel{DocID: archived.DocID, Msg: make([]*unrelationtb.MsgInfoModel, len(archived.Msg))}
+	written.Msg[0] = &unrelationtb.MsgInfoModel{
+		Thread: &unrelationtb.ThreadModel{ReplyCount: 3},
+		Edits:  []*unrelationtb.EditModel{{Content: "v3"}},
+	}
+	docDB := &fakeMsgDocDB{docs: map[string]*unrelationtb.MsgDocModel{written.DocID: written}}
+	archive := &fakeMsgArchive{docs: map[string]*unrelationtb.MsgDocModel{archived.DocID: archived}}
+	db := &commonMsgDatabase{msgDocDatabase: docDB, cache: &fakeMsgCache{}, archive: archive}
+
+	if err := db.rehydrateSeqs(context.Background(), conversationID, []int64{1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := archive.docs[archived.DocID]; ok {
+		t.Fatal("archive index not removed after merge")
+	}
+	doc := docDB.docs[archived.DocID]
+	for i, info := range doc.Msg {
+		if info == nil || info.Msg == nil || info.Msg.Seq != int64(i)+1 {
+			t.Fatalf("archived msg %d lost in merge", i+1)
+		}
+	}
+	root := doc.Msg[0]
+	if root.Thread == nil || root.Thread.ReplyCount != 3 {
+		t.Fatalf("thread written while archived lost, %+v", root.Thread)
+	}
+	if len(root.Edits) != 2 || root.Edits[0].Content != "v2" || root.Edits[1].Content != "v3" {
+		t.Fatalf("edits %+v", root.Edits)
+	}
+}
+
+func TestArchiveKeepsWriteBeforeDelete(t *testing.T) {
+	const conversationID = "si_1_2"
+	doc := newArchivedDoc(conversationID, utils.GetCurrentTimestampByMill()-24*60*60*1000)
+	docDB := &fakeMsgDocDB{docs: map[string]*unrelationtb.MsgDocModel{doc.DocID: doc}}
+	archive := &fakeMsgArchive{docs: make(map[string]*unrelationtb.MsgDocModel)}
+	archive.onArchive = func(archived *unrelationtb.MsgDocModel) {
+		archive.onArchive = nil
+		written := *archived
+		written.Msg = append([]*unrelationtb.MsgInfoModel{}, archived.Msg...)
+		written.Msg[0] = &unrelationtb.MsgInfoModel{Msg: archived.Msg[0].Msg, Revoke: &unrelationtb.RevokeModel{}}
+		docDB.docs[archived.DocID] = &written
+	}
+	db := &commonMsgDatabase{msgDocDatabase: docDB, cache: &fakeMsgCache{}, archive: archive}
+
+	count, err := db.ArchiveConversationMsgs(context.Background(), conversationID, 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 || len(docDB.docs) != 0 {
+		t.Fatalf("archived %d, docs left %d", count, len(docDB.docs))
+	}
+	if archive.docs[doc.DocID].Msg[0].Revoke == nil {
+		t.Fatal("write landing between archive and delete lost")
+	}
+}
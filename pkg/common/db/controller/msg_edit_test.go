@@ -0,0 +1,51 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OpenIMSDK/protocol/sdkws"
+
+	unrelationtb "github.com/openimsdk/open-im-server/v3/pkg/common/db/table/unrelation"
+)
+
+func TestGetMsgEditHistoryOfCachedMsg(t *testing.T) {
+	const conversationID = "si_1_2"
+	var msgDoc unrelationtb.MsgDocModel
+	docID := msgDoc.GetDocID(conversationID, 5)
+	// the msg is not stored in mongo yet, its edits were written to a doc of their own
+	doc := &unrelationtb.MsgDocModel{DocID: docID, Msg: make([]*unrelationtb.MsgInfoModel, msgDoc.GetSingleGocMsgNum())}
+	doc.Msg[msgDoc.GetMsgIndex(5)] = &unrelationtb.MsgInfoModel{Edits: []*unrelationtb.EditModel{
+		{UserID: "1", Content: "v2", PrevContent: "v1", Time: 1},
+	}}
+	msgCache := &fakeMsgCache{maxSeq: 5, msgs: map[int64]*sdkws.MsgData{5: {Seq: 5, SendID: "1", Content: []byte("v2")}}}
+	db := &commonMsgDatabase{msgDocDatabase: &fakeMsgDocDB{docs: map[string]*unrelationtb.MsgDocModel{docID: doc}}, cache: msgCache}
+
+	msg, edits, err := db.GetMsgEditHistory(context.Background(), "2", conversationID, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(msg.Content) != "v1" {
+		t.Fatalf("original content %q", msg.Content)
+	}
+	if len(edits) != 1 || edits[0].Content != "v2" {
+		t.Fatalf("edits %+v", edits)
+	}
+	if _, _, err := db.GetMsgEditHistory(context.Background(), "2", conversationID, 4); err == nil {
+		t.Fatal("msg missing from cache and mongo found")
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	unrelationtb "github.com/openimsdk/open-im-server/v3/pkg/common/db/table/unrelation"
+)
+
+func TestAddMsgReactionLimitsEmojis(t *testing.T) {
+	maxEmojis := config.Config.MsgReaction.MaxEmojis
+	defer func() { config.Config.MsgReaction.MaxEmojis = maxEmojis }()
+	config.Config.MsgReaction.MaxEmojis = 2
+
+	const conversationID = "si_1_2"
+	var msgDoc unrelationtb.MsgDocModel
+	docID := msgDoc.GetDocID(conversationID, 5)
+	doc := &unrelationtb.MsgDocModel{DocID: docID, Msg: make([]*unrelationtb.MsgInfoModel, msgDoc.GetSingleGocMsgNum())}
+	db := &commonMsgDatabase{msgDocDatabase: &fakeMsgDocDB{docs: map[string]*unrelationtb.MsgDocModel{docID: doc}}, cache: &fakeMsgCache{}}
+	ctx := context.Background()
+	react := func(emoji, userID string) error {
+		_, err := db.AddMsgReaction(ctx, conversationID, 5, &unrelationtb.ReactionModel{Emoji: emoji, UserID: userID})
+		return err
+	}
+
+	if err := react("👍", "1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := react("❤️", "1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := react("😂", "2"); err == nil {
+		t.Fatal("a third emoji is accepted")
+	}
+	// more users can still react with the emojis the msg has
+	if err := react("👍", "2"); err != nil {
+		t.Fatal(err)
+	}
+	// an emoji whose last user is gone frees its place
+	if _, err := db.RemoveMsgReaction(ctx, conversationID, 5, &unrelationtb.ReactionModel{Emoji: "❤️", UserID: "1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := react("😂", "2"); err != nil {
+		t.Fatal(err)
+	}
+	reactions := doc.Msg[msgDoc.GetMsgIndex(5)].Reactions
+	if len(reactions) != 2 || len(reactions["👍"]) != 2 || len(reactions["😂"]) != 1 {
+		t.Fatalf("reactions %v", reactions)
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/search"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/search/local"
+)
+
+type MsgSearchDatabase interface {
+	// 持久化后的消息写入检索索引, key为conversationID
+	IndexMsgs(ctx context.Context, conversationMsgs map[string][]*sdkws.MsgData) error
+	// 从检索索引删除消息
+	DeleteMsgs(ctx context.Context, conversationID string, seqs []int64) error
+	// 消息被编辑后按最新内容重建索引
+	ReindexMsgs(ctx context.Context, conversationID string, seqs []int64) error
+	// 把会话在mongo中的历史消息写入检索索引, 返回写入的消息数, 已索引的消息会被覆盖
+	IndexConversationMsgs(ctx context.Context, conversationID string) (int, error)
+	// 检索消息, userID为空时不过滤用户删除的消息, total为命中总数减去本页过滤掉的消息
+	SearchMessage(ctx context.Context, userID string, query *search.Query) (total int64, nextCursor string, msgs []*sdkws.MsgData, err error)
+}
+
+const (
+	msgSearchIndexIDKey  = "MSG_SEARCH_INDEX_ID"
+	msgSearchIndexIDFile = "index.id"
+)
+
+func NewMsgSearchIndex(rdb redis.UniversalClient) (search.MsgSearchIndex, error) {
+	switch config.Config.MsgSearch.Engine {
+	case "", search.EngineLocal:
+		dir := config.Config.MsgSearch.Local.Dir
+		index, err := local.NewIndex(dir, config.Config.MsgSearch.Local.MergeFactor)
+		if err != nil {
+			return nil, err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := checkMsgSearchIndexDir(ctx, rdb, dir); err != nil {
+			_ = index.Close()
+			return nil, err
+		}
+		return index, nil
+	default:
+		return nil, errs.ErrArgs.Wrap("unknown msg search engine " + config.Config.MsgSearch.Engine)
+	}
+}
+
+// msgSearchIndexID reads the id of dir, creating it when missing. The id is linked into place,
+// so processes starting together on one filesystem never read a partly written id.
+func msgSearchIndexID(dir string) (string, error) {
+	path := filepath.Join(dir, msgSearchIndexIDFile)
+	data, err := os.ReadFile(path)
+	if err == nil {
+		return string(data), nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return "", errs.Wrap(err)
+	}
+	tmp := path + "." + uuid.New().String()
+	id := uuid.New().String()
+	if err := os.WriteFile(tmp, []byte(id), 0o644); err != nil {
+		return "", errs.Wrap(err)
+	}
+	defer os.Remove(tmp)
+	if err := os.Link(tmp, path); err != nil {
+		if !errors.Is(err, os.ErrExist) {
+			return "", errs.Wrap(err)
+		}
+		// another process on the same filesystem created it first
+		if data, err = os.ReadFile(path); err != nil {
+			return "", errs.Wrap(err)
+		}
+		return string(data), nil
+	}
+	return id, nil
+}
+
+// checkMsgSearchIndexDir fails when dir is not the directory the other processes use. msgtransfer writes the
+// local index and the msg rpc reads it, so they must share one filesystem. The first process writes a random
+// id to dir and redis, every later process must find the same id in its dir.
+func checkMsgSearchIndexDir(ctx context.Context, rdb redis.UniversalClient, dir string) error {
+	id, err := msgSearchIndexID(dir)
+	if err != nil {
+		return err
+	}
+	ok, err := rdb.SetNX(ctx, msgSearchIndexIDKey, id, 0).Result()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if ok {
+		return nil
+	}
+	stored, err := rdb.Get(ctx, msgSearchIndexIDKey).Result()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if stored != id {
+		return errs.ErrArgs.Wrap("msg search index dir " + dir + " is not shared with the other msgtransfer and msg rpc processes, " +
+			"mount the same directory for all of them, or delete the redis key " + msgSearchIndexIDKey + " to start a new index")
+	}
+	return nil
+}
+
+func NewMsgSearchDatabase(index search.MsgSearchIndex, msgDatabase CommonMsgDatabase) MsgSearchDatabase {
+	return &msgSearchDatabase{index: index, msgDatabase: msgDatabase}
+}
+
+type msgSearchDatabase struct {
+	index       search.MsgSearchIndex
+	msgDatabase CommonMsgDatabase
+}
+
+func (db *msgSearchDatabase) IndexMsgs(ctx context.Context, conversationMsgs map[string][]*sdkws.MsgData) error {
+	var docs []*search.MsgDocument
+	for conversationID, msgs := range conversationMsgs {
+		for _, msg := range msgs {
+			if doc, ok := search.NewMsgDocument(conversationID, msg); ok {
+				docs = append(docs, doc)
+			}
+		}
+	}
+	return db.index.Index(ctx, docs)
+}
+
+func (db *msgSearchDatabase) DeleteMsgs(ctx context.Context, conversationID string, seqs []int64) error {
+	keys := make([]search.DocKey, 0, len(seqs))
+	for _, seq := range seqs {
+		keys = append(keys, search.DocKey{ConversationID: conversationID, Seq: seq})
+	}
+	return db.index.Delete(ctx, keys)
+}
+
+func (db *msgSearchDatabase) ReindexMsgs(ctx context.Context, conversationID string, seqs []int64) error {
+	_, _, msgs, err := db.msgDatabase.GetMsgBySeqs(ctx, "", conversationID, seqs)
+	if err != nil {
+		return err
+	}
+	// the old copy is deleted first, otherwise it still matches the replaced words
+	if err := db.DeleteMsgs(ctx, conversationID, seqs); err != nil {
+		return err
+	}
+	return db.IndexMsgs(ctx, map[string][]*sdkws.MsgData{conversationID: msgs})
+}
+
+func (db *msgSearchDatabase) IndexConversationMsgs(ctx context.Context, conversationID string) (int, error) {
+	var count int
+	err := db.msgDatabase.RangeConversationMsgs(ctx, conversationID, func(msgs []*sdkws.MsgData) error {
+		docs := make([]*search.MsgDocument, 0, len(msgs))
+		for _, msg := range msgs {
+			if doc, ok := search.NewMsgDocument(conversationID, msg); ok {
+				docs = append(docs, doc)
+			}
+		}
+		if len(docs) == 0 {
+			return nil
+		}
+		count += len(docs)
+		return db.index.Index(ctx, docs)
+	})
+	return count, err
+}
+
+func (db *msgSearchDatabase) SearchMessage(ctx context.Context, userID string, query *search.Query) (int64, string, []*sdkws.MsgData, error) {
+	res, err := db.index.Search(ctx, query)
+	if err != nil {
+		return 0, "", nil, err
+	}
+	var conversationIDs []string
+	conversationSeqs := make(map[string][]int64)
+	for _, hit := range res.Hits {
+		if _, ok := conversationSeqs[hit.ConversationID]; !ok {
+			conversationIDs = append(conversationIDs, hit.ConversationID)
+		}
+		conversationSeqs[hit.ConversationID] = append(conversationSeqs[hit.ConversationID], hit.Seq)
+	}
+	found := make(map[search.DocKey]*sdkws.MsgData, len(res.Hits))
+	for _, conversationID := range conversationIDs {
+		_, _, msgs, err := db.msgDatabase.GetMsgBySeqs(ctx, userID, conversationID, conversationSeqs[conversationID])
+		if err != nil {
+			return 0, "", nil, err
+		}
+		for _, msg := range msgs {
+			found[search.DocKey{ConversationID: conversationID, Seq: msg.Seq}] = msg
+		}
+	}
+	// hits whose message was deleted, cleared or revoked after indexing are dropped, and no longer counted in the total
+	msgs := make([]*sdkws.MsgData, 0, len(res.Hits))
+	for _, hit := range res.Hits {
+		msg, ok := found[hit.Key()]
+		if !ok || (query.Keyword != "" && msg.ContentType == constant.MsgRevokeNotification) {
+			continue
+		}
+		msgs = append(msgs, msg)
+	}
+	return res.Total - int64(len(res.Hits)-len(msgs)), res.NextCursor, msgs, nil
+}
@@ -0,0 +1,89 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/OpenIMSDK/protocol/sdkws"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/search"
+	unrelationtb "github.com/openimsdk/open-im-server/v3/pkg/common/db/table/unrelation"
+)
+
+type fakeMsgSearchIndex struct {
+	search.MsgSearchIndex
+	res *search.Result
+}
+
+func (f *fakeMsgSearchIndex) Search(ctx context.Context, query *search.Query) (*search.Result, error) {
+	return f.res, nil
+}
+
+func TestSearchMessageTotalDropsMissingHits(t *testing.T) {
+	const conversationID = "si_1_2"
+	var msgDoc unrelationtb.MsgDocModel
+	docID := msgDoc.GetDocID(conversationID, 1)
+	// seq 2 was deleted after it was indexed
+	doc := &unrelationtb.MsgDocModel{DocID: docID, Msg: make([]*unrelationtb.MsgInfoModel, msgDoc.GetSingleGocMsgNum())}
+	msgCache := &fakeMsgCache{maxSeq: 3, msgs: map[int64]*sdkws.MsgData{1: {Seq: 1}, 3: {Seq: 3}}}
+	msgDatabase := &commonMsgDatabase{msgDocDatabase: &fakeMsgDocDB{docs: map[string]*unrelationtb.MsgDocModel{docID: doc}}, cache: msgCache}
+	index := &fakeMsgSearchIndex{res: &search.Result{Total: 10, Hits: []*search.Hit{
+		{ConversationID: conversationID, Seq: 1},
+		{ConversationID: conversationID, Seq: 2},
+		{ConversationID: conversationID, Seq: 3},
+	}}}
+	db := NewMsgSearchDatabase(index, msgDatabase)
+
+	total, _, msgs, err := db.SearchMessage(context.Background(), "1", &search.Query{Keyword: "hi"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 2 || total != 9 {
+		t.Fatalf("total %d msgs %d", total, len(msgs))
+	}
+}
+
+func TestMsgSearchIndexID(t *testing.T) {
+	dir := t.TempDir()
+	id, err := msgSearchIndexID(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := msgSearchIndexID(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id == "" || again != id {
+		t.Fatalf("id %q then %q", id, again)
+	}
+	other, err := msgSearchIndexID(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == id {
+		t.Fatal("two dirs share an id")
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Name() != filepath.Base(msgSearchIndexIDFile) {
+		t.Fatalf("temp id file left in %v", entries)
+	}
+}
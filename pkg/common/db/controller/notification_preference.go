@@ -0,0 +1,49 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+type NotificationPreferenceDatabase interface {
+	// 设置用户通知偏好
+	SetPreference(ctx context.Context, preference *relation.NotificationPreferenceModel) error
+	// 批量获取用户通知偏好, 未设置的用户返回默认偏好
+	GetPreferences(ctx context.Context, userIDs []string) ([]*relation.NotificationPreferenceModel, error)
+}
+
+func NewNotificationPreferenceDatabase(preferenceDB relation.NotificationPreferenceInterface, cache cache.NotificationPreferenceCache) NotificationPreferenceDatabase {
+	return &notificationPreferenceDatabase{preferenceDB: preferenceDB, cache: cache}
+}
+
+type notificationPreferenceDatabase struct {
+	preferenceDB relation.NotificationPreferenceInterface
+	cache        cache.NotificationPreferenceCache
+}
+
+func (n *notificationPreferenceDatabase) SetPreference(ctx context.Context, preference *relation.NotificationPreferenceModel) error {
+	if err := n.preferenceDB.Set(ctx, preference); err != nil {
+		return err
+	}
+	return n.cache.DelPreferences(preference.UserID).ExecDel(ctx)
+}
+
+func (n *notificationPreferenceDatabase) GetPreferences(ctx context.Context, userIDs []string) ([]*relation.NotificationPreferenceModel, error) {
+	return n.cache.GetPreferences(ctx, userIDs)
+}
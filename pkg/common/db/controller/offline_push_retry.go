@@ -0,0 +1,97 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/pagination"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+type OfflinePushRetryDatabase interface {
+	// 加入离线推送重试队列
+	AddRetry(ctx context.Context, task string, dueAt time.Time) error
+	// 领取到期的重试任务, lease时间内不会被再次领取
+	ClaimRetry(ctx context.Context, lease time.Duration, limit int64) ([]string, error)
+	// 用下一次重试替换已领取的任务
+	RescheduleRetry(ctx context.Context, task string, next string, dueAt time.Time) error
+	// 移除已领取的任务
+	RemoveRetry(ctx context.Context, tasks ...string) error
+	// 重试耗尽, 从重试队列移入死信
+	MoveToDeadLetter(ctx context.Context, task string, letter *relation.OfflinePushDeadLetterModel) error
+	// 分页查询死信, 按进入死信时间倒序
+	SearchDeadLetters(ctx context.Context, sendID string, conversationID string, pagination pagination.Pagination) (int64, []*relation.OfflinePushDeadLetterModel, error)
+	// 获取死信
+	FindDeadLetters(ctx context.Context, ids []string) ([]*relation.OfflinePushDeadLetterModel, error)
+	// 死信重新加入重试队列
+	ReplayDeadLetters(ctx context.Context, ids []string, tasks []string) error
+}
+
+func NewOfflinePushRetryDatabase(cache cache.OfflinePushRetryCache, deadLetterDB relation.OfflinePushDeadLetterInterface) OfflinePushRetryDatabase {
+	return &offlinePushRetryDatabase{cache: cache, deadLetterDB: deadLetterDB}
+}
+
+type offlinePushRetryDatabase struct {
+	cache        cache.OfflinePushRetryCache
+	deadLetterDB relation.OfflinePushDeadLetterInterface
+}
+
+func (o *offlinePushRetryDatabase) AddRetry(ctx context.Context, task string, dueAt time.Time) error {
+	return o.cache.Add(ctx, task, dueAt)
+}
+
+func (o *offlinePushRetryDatabase) ClaimRetry(ctx context.Context, lease time.Duration, limit int64) ([]string, error) {
+	return o.cache.Claim(ctx, lease, limit)
+}
+
+func (o *offlinePushRetryDatabase) RescheduleRetry(ctx context.Context, task string, next string, dueAt time.Time) error {
+	return o.cache.Replace(ctx, task, next, dueAt)
+}
+
+func (o *offlinePushRetryDatabase) RemoveRetry(ctx context.Context, tasks ...string) error {
+	return o.cache.Remove(ctx, tasks...)
+}
+
+func (o *offlinePushRetryDatabase) MoveToDeadLetter(ctx context.Context, task string, letter *relation.OfflinePushDeadLetterModel) error {
+	// insert first, a crash in between only leaves a task that is claimed again after its lease
+	// and then finds its dead letter already stored
+	if err := o.deadLetterDB.Create(ctx, []*relation.OfflinePushDeadLetterModel{letter}); err != nil && !mongo.IsDuplicateKeyError(err) {
+		return err
+	}
+	return o.cache.Remove(ctx, task)
+}
+
+func (o *offlinePushRetryDatabase) SearchDeadLetters(ctx context.Context, sendID string, conversationID string, pagination pagination.Pagination) (int64, []*relation.OfflinePushDeadLetterModel, error) {
+	return o.deadLetterDB.Search(ctx, sendID, conversationID, pagination)
+}
+
+func (o *offlinePushRetryDatabase) FindDeadLetters(ctx context.Context, ids []string) ([]*relation.OfflinePushDeadLetterModel, error) {
+	return o.deadLetterDB.Find(ctx, ids)
+}
+
+func (o *offlinePushRetryDatabase) ReplayDeadLetters(ctx context.Context, ids []string, tasks []string) error {
+	now := time.Now()
+	for _, task := range tasks {
+		if err := o.cache.Add(ctx, task, now); err != nil {
+			return err
+		}
+	}
+	return o.deadLetterDB.Delete(ctx, ids)
+}
@@ -16,12 +16,16 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
 )
 
 type PushDatabase interface {
 	DelFcmToken(ctx context.Context, userID string, platformID int) error
+	DelApnsToken(ctx context.Context, userID string, platformID int) error
+	SetMsgDeliveryStatus(ctx context.Context, conversationID string, seq int64, statuses map[string]*cache.MsgDeliveryStatus, expire time.Duration) error
+	UpdateMsgDeliveryStatus(ctx context.Context, conversationID string, seq int64, statuses map[string]*cache.MsgDeliveryStatus) error
 }
 
 type pushDataBase struct {
@@ -35,3 +39,15 @@ func NewPushDatabase(cache cache.MsgModel) PushDatabase {
 func (p *pushDataBase) DelFcmToken(ctx context.Context, userID string, platformID int) error {
 	return p.cache.DelFcmToken(ctx, userID, platformID)
 }
+
+func (p *pushDataBase) DelApnsToken(ctx context.Context, userID string, platformID int) error {
+	return p.cache.DelApnsToken(ctx, userID, platformID)
+}
+
+func (p *pushDataBase) SetMsgDeliveryStatus(ctx context.Context, conversationID string, seq int64, statuses map[string]*cache.MsgDeliveryStatus, expire time.Duration) error {
+	return p.cache.SetMsgDeliveryStatus(ctx, conversationID, seq, statuses, expire)
+}
+
+func (p *pushDataBase) UpdateMsgDeliveryStatus(ctx context.Context, conversationID string, seq int64, statuses map[string]*cache.MsgDeliveryStatus) error {
+	return p.cache.UpdateMsgDeliveryStatus(ctx, conversationID, seq, statuses)
+}
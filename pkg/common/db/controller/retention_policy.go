@@ -0,0 +1,111 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	"github.com/OpenIMSDK/tools/pagination"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+type RetentionPolicyDatabase interface {
+	// 设置保留策略, 已存在则覆盖
+	SetPolicy(ctx context.Context, policy *relation.RetentionPolicyModel) error
+	// 删除保留策略
+	DeletePolicy(ctx context.Context, scope int32, key string) error
+	// 分页获取保留策略, scope为0时获取全部
+	PagePolicies(ctx context.Context, scope int32, pagination pagination.Pagination) (int64, []*relation.RetentionPolicyModel, error)
+	// 获取全部保留策略用于匹配会话
+	GetPolicies(ctx context.Context) (*RetentionPolicies, error)
+}
+
+func NewRetentionPolicyDatabase(policyDB relation.RetentionPolicyInterface) RetentionPolicyDatabase {
+	return &retentionPolicyDatabase{policyDB: policyDB}
+}
+
+type retentionPolicyDatabase struct {
+	policyDB relation.RetentionPolicyInterface
+}
+
+func (r *retentionPolicyDatabase) SetPolicy(ctx context.Context, policy *relation.RetentionPolicyModel) error {
+	return r.policyDB.Set(ctx, policy)
+}
+
+func (r *retentionPolicyDatabase) DeletePolicy(ctx context.Context, scope int32, key string) error {
+	return r.policyDB.Delete(ctx, scope, key)
+}
+
+func (r *retentionPolicyDatabase) PagePolicies(ctx context.Context, scope int32, pagination pagination.Pagination) (int64, []*relation.RetentionPolicyModel, error) {
+	return r.policyDB.Page(ctx, scope, pagination)
+}
+
+func (r *retentionPolicyDatabase) GetPolicies(ctx context.Context) (*RetentionPolicies, error) {
+	policies, err := r.policyDB.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewRetentionPolicies(policies), nil
+}
+
+// RetentionPolicies picks the policy of a conversation: its own policy first, then the longest matching prefix,
+// then the stored default, and config retainChatRecords when no default is stored.
+type RetentionPolicies struct {
+	conversations map[string]*relation.RetentionPolicyModel
+	prefixes      []*relation.RetentionPolicyModel
+	def           *relation.RetentionPolicyModel
+}
+
+func NewRetentionPolicies(policies []*relation.RetentionPolicyModel) *RetentionPolicies {
+	r := &RetentionPolicies{conversations: make(map[string]*relation.RetentionPolicyModel)}
+	for _, policy := range policies {
+		switch policy.Scope {
+		case relation.RetentionScopeConversation:
+			r.conversations[policy.Key] = policy
+		case relation.RetentionScopePrefix:
+			r.prefixes = append(r.prefixes, policy)
+		case relation.RetentionScopeDefault:
+			r.def = policy
+		}
+	}
+	return r
+}
+
+// Match returns the policy applied to conversationID.
+func (r *RetentionPolicies) Match(conversationID string) *relation.RetentionPolicyModel {
+	if policy, ok := r.conversations[conversationID]; ok {
+		return policy
+	}
+	id := conversationID
+	if i := strings.Index(id, "_"); i >= 0 {
+		id = id[i+1:]
+	}
+	var match *relation.RetentionPolicyModel
+	for _, policy := range r.prefixes {
+		if strings.HasPrefix(id, policy.Key) && (match == nil || len(policy.Key) > len(match.Key)) {
+			match = policy
+		}
+	}
+	if match != nil {
+		return match
+	}
+	if r.def != nil {
+		return r.def
+	}
+	return &relation.RetentionPolicyModel{Scope: relation.RetentionScopeDefault, RetainDays: int32(config.Config.RetainChatRecords)}
+}
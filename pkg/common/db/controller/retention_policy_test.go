@@ -0,0 +1,50 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+func TestRetentionPoliciesMatch(t *testing.T) {
+	config.Config.RetainChatRecords = 365
+	policies := NewRetentionPolicies([]*relation.RetentionPolicyModel{
+		{Scope: relation.RetentionScopeConversation, Key: "sg_acme_legal", RetainDays: 2557},
+		{Scope: relation.RetentionScopePrefix, Key: "acme_", RetainDays: 90},
+		{Scope: relation.RetentionScopePrefix, Key: "acme_trial_", RetainDays: 30},
+	})
+	cases := map[string]int32{
+		"sg_acme_legal":       2557,
+		"sg_acme_sales":       90,
+		"si_acme_1_acme_2":    90,
+		"n_acme_1_acme_2":     90,
+		"sg_acme_trial_1":     30,
+		"sg_other":            365,
+		"si_acmex_1_acmex_2":  365,
+		"sg_acme_legal_extra": 90,
+	}
+	for conversationID, days := range cases {
+		if got := policies.Match(conversationID).RetainDays; got != days {
+			t.Errorf("%s: retain days %d, want %d", conversationID, got, days)
+		}
+	}
+	policies = NewRetentionPolicies([]*relation.RetentionPolicyModel{{Scope: relation.RetentionScopeDefault, RetainDays: 180}})
+	if got := policies.Match("sg_other").RetainDays; got != 180 {
+		t.Errorf("default retain days %d, want 180", got)
+	}
+}
@@ -16,14 +16,19 @@ package controller
 
 import (
 	"context"
+	"fmt"
 	"path/filepath"
 	"time"
 
 	"github.com/redis/go-redis/v9"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/s3"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/s3/cont"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/s3/cos"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/s3/minio"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/s3/oss"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
 )
 
@@ -39,6 +44,35 @@ type S3Database interface {
 	FormData(ctx context.Context, name string, size int64, contentType string, duration time.Duration) (*s3.FormData, error)
 }
 
+// NewS3 creates the object storage selected by config.Config.Object.Enable.
+func NewS3(rdb redis.UniversalClient) (s3.Interface, error) {
+	switch enable := config.Config.Object.Enable; enable {
+	case "minio":
+		return minio.NewMinio(cache.NewMinioCache(rdb))
+	case "cos":
+		return cos.NewCos()
+	case "oss":
+		return oss.NewOSS()
+	default:
+		return nil, fmt.Errorf("invalid object enable: %s", enable)
+	}
+}
+
+// NewPrivateS3 creates the object storage selected by config.Config.Object.Enable on bucket instead of the configured one,
+// bucket is a bucket name for minio and oss and a bucket url for cos.
+func NewPrivateS3(rdb redis.UniversalClient, bucket string) (s3.Interface, error) {
+	switch enable := config.Config.Object.Enable; enable {
+	case "minio":
+		return minio.NewPrivateMinio(cache.NewMinioCache(rdb), bucket)
+	case "cos":
+		return cos.NewPrivateCos(bucket)
+	case "oss":
+		return oss.NewPrivateOSS(bucket)
+	default:
+		return nil, fmt.Errorf("invalid object enable: %s", enable)
+	}
+}
+
 func NewS3Database(rdb redis.UniversalClient, s3 s3.Interface, obj relation.ObjectInfoModelInterface) S3Database {
 	return &s3Database{
 		s3:    cont.New(cache.NewS3Cache(rdb, s3), s3),
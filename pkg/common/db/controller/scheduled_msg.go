@@ -0,0 +1,161 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/pagination"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+type ScheduledMsgDatabase interface {
+	// 保存待发送的定时消息
+	CreateScheduledMsg(ctx context.Context, scheduledMsgID string, sendAt time.Time, msg *sdkws.MsgData) error
+	// 获取定时消息及其消息内容
+	GetScheduledMsg(ctx context.Context, scheduledMsgID string) (*relation.ScheduledMsgModel, *sdkws.MsgData, error)
+	// 解析定时消息的消息内容
+	UnmarshalMsg(scheduledMsg *relation.ScheduledMsgModel) (*sdkws.MsgData, error)
+	// 取消未发送的定时消息
+	CancelScheduledMsg(ctx context.Context, scheduledMsgID string) error
+	// 修改未发送的定时消息的发送时间
+	RescheduleMsg(ctx context.Context, scheduledMsgID string, sendAt time.Time) error
+	// 分页获取用户的定时消息
+	PageUserScheduledMsgs(ctx context.Context, userID string, status []int32, pagination pagination.Pagination) (int64, []*relation.ScheduledMsgModel, error)
+	// 获取已到发送时间的定时消息
+	GetDueScheduledMsgs(ctx context.Context, now time.Time, limit int64) ([]*relation.ScheduledMsgModel, error)
+	// 抢占定时消息, 只有一个调用者能成功
+	ClaimScheduledMsg(ctx context.Context, scheduledMsgID string) error
+	// 记录定时消息的发送结果
+	FinishScheduledMsg(ctx context.Context, scheduledMsgID string, serverMsgID string, sendErr error) error
+	// 将长时间处于发送中的定时消息标记为失败
+	FailStuckScheduledMsgs(ctx context.Context, before time.Time) ([]*relation.ScheduledMsgModel, error)
+}
+
+func NewScheduledMsgDatabase(scheduledMsgDB relation.ScheduledMsgInterface) ScheduledMsgDatabase {
+	return &scheduledMsgDatabase{scheduledMsgDB: scheduledMsgDB}
+}
+
+type scheduledMsgDatabase struct {
+	scheduledMsgDB relation.ScheduledMsgInterface
+}
+
+func (s *scheduledMsgDatabase) CreateScheduledMsg(ctx context.Context, scheduledMsgID string, sendAt time.Time, msg *sdkws.MsgData) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	now := time.Now()
+	return s.scheduledMsgDB.Create(ctx, []*relation.ScheduledMsgModel{{
+		ScheduledMsgID: scheduledMsgID,
+		UserID:         msg.SendID,
+		SendAt:         sendAt,
+		Status:         relation.ScheduledMsgStatusPending,
+		Msg:            data,
+		CreateTime:     now,
+		UpdateTime:     now,
+	}})
+}
+
+func (s *scheduledMsgDatabase) GetScheduledMsg(ctx context.Context, scheduledMsgID string) (*relation.ScheduledMsgModel, *sdkws.MsgData, error) {
+	scheduledMsg, err := s.scheduledMsgDB.Take(ctx, scheduledMsgID)
+	if err != nil {
+		return nil, nil, err
+	}
+	msg, err := s.UnmarshalMsg(scheduledMsg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return scheduledMsg, msg, nil
+}
+
+func (s *scheduledMsgDatabase) UnmarshalMsg(scheduledMsg *relation.ScheduledMsgModel) (*sdkws.MsgData, error) {
+	var msg sdkws.MsgData
+	if err := proto.Unmarshal(scheduledMsg.Msg, &msg); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &msg, nil
+}
+
+func (s *scheduledMsgDatabase) CancelScheduledMsg(ctx context.Context, scheduledMsgID string) error {
+	return s.scheduledMsgDB.UpdateStatus(ctx, scheduledMsgID, []int32{relation.ScheduledMsgStatusPending}, map[string]any{
+		"status":      relation.ScheduledMsgStatusCancelled,
+		"update_time": time.Now(),
+	})
+}
+
+func (s *scheduledMsgDatabase) RescheduleMsg(ctx context.Context, scheduledMsgID string, sendAt time.Time) error {
+	return s.scheduledMsgDB.UpdateStatus(ctx, scheduledMsgID, []int32{relation.ScheduledMsgStatusPending}, map[string]any{
+		"send_at":     sendAt,
+		"update_time": time.Now(),
+	})
+}
+
+func (s *scheduledMsgDatabase) PageUserScheduledMsgs(ctx context.Context, userID string, status []int32, pagination pagination.Pagination) (int64, []*relation.ScheduledMsgModel, error) {
+	return s.scheduledMsgDB.FindByUser(ctx, userID, status, pagination)
+}
+
+func (s *scheduledMsgDatabase) GetDueScheduledMsgs(ctx context.Context, now time.Time, limit int64) ([]*relation.ScheduledMsgModel, error) {
+	return s.scheduledMsgDB.FindDue(ctx, now, limit)
+}
+
+func (s *scheduledMsgDatabase) ClaimScheduledMsg(ctx context.Context, scheduledMsgID string) error {
+	return s.scheduledMsgDB.UpdateStatus(ctx, scheduledMsgID, []int32{relation.ScheduledMsgStatusPending}, map[string]any{
+		"status":      relation.ScheduledMsgStatusSending,
+		"update_time": time.Now(),
+	})
+}
+
+func (s *scheduledMsgDatabase) FinishScheduledMsg(ctx context.Context, scheduledMsgID string, serverMsgID string, sendErr error) error {
+	data := map[string]any{
+		"status":        relation.ScheduledMsgStatusSent,
+		"server_msg_id": serverMsgID,
+		"update_time":   time.Now(),
+	}
+	if sendErr != nil {
+		data["status"] = relation.ScheduledMsgStatusFailed
+		data["err_msg"] = sendErr.Error()
+	}
+	return s.scheduledMsgDB.UpdateStatus(ctx, scheduledMsgID, []int32{relation.ScheduledMsgStatusSending}, data)
+}
+
+func (s *scheduledMsgDatabase) FailStuckScheduledMsgs(ctx context.Context, before time.Time) ([]*relation.ScheduledMsgModel, error) {
+	scheduledMsgs, err := s.scheduledMsgDB.FindStuck(ctx, before)
+	if err != nil {
+		return nil, err
+	}
+	failed := make([]*relation.ScheduledMsgModel, 0, len(scheduledMsgs))
+	for _, scheduledMsg := range scheduledMsgs {
+		// the dispatcher stopped before recording the result, whether the msg was sent is unknown, so it is not retried
+		err := s.scheduledMsgDB.UpdateStatus(ctx, scheduledMsg.ScheduledMsgID, []int32{relation.ScheduledMsgStatusSending}, map[string]any{
+			"status":      relation.ScheduledMsgStatusFailed,
+			"err_msg":     "dispatch interrupted",
+			"update_time": time.Now(),
+		})
+		if err != nil {
+			if relation.IsNotFound(err) {
+				continue
+			}
+			return failed, err
+		}
+		failed = append(failed, scheduledMsg)
+	}
+	return failed, nil
+}
@@ -26,6 +26,7 @@ import (
 
 type ThirdDatabase interface {
 	FcmUpdateToken(ctx context.Context, account string, platformID int, fcmToken string, expireTime int64) error
+	ApnsUpdateToken(ctx context.Context, account string, platformID int, apnsToken string, expireTime int64) error
 	SetAppBadge(ctx context.Context, userID string, value int) error
 	// about log for debug
 	UploadLogs(ctx context.Context, logs []*relation.LogModel) error
@@ -73,6 +74,16 @@ func (t *thirdDatabase) FcmUpdateToken(
 	return t.cache.SetFcmToken(ctx, account, platformID, fcmToken, expireTime)
 }
 
+func (t *thirdDatabase) ApnsUpdateToken(
+	ctx context.Context,
+	account string,
+	platformID int,
+	apnsToken string,
+	expireTime int64,
+) error {
+	return t.cache.SetApnsToken(ctx, account, platformID, apnsToken, expireTime)
+}
+
 func (t *thirdDatabase) SetAppBadge(ctx context.Context, userID string, value int) error {
 	return t.cache.SetUserBadgeUnreadCountSum(ctx, userID, value)
 }
@@ -0,0 +1,77 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/mgoutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+func NewConversationPinMongo(db *mongo.Database) (relation.ConversationPinInterface, error) {
+	coll := db.Collection("conversation_pin")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "conversation_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ConversationPinMgo{coll: coll}, nil
+}
+
+type ConversationPinMgo struct {
+	coll *mongo.Collection
+}
+
+func (c *ConversationPinMgo) AddPin(ctx context.Context, conversationID string, pin *relation.PinnedMsgModel, maxNum int) (bool, error) {
+	_, err := c.coll.UpdateOne(ctx, bson.M{"conversation_id": conversationID},
+		bson.M{"$setOnInsert": bson.M{"pins": bson.A{}}}, options.Update().SetUpsert(true))
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	filter := bson.M{
+		"conversation_id": conversationID,
+		"pins.seq":        bson.M{"$ne": pin.Seq},
+		// the array has fewer than maxNum elements
+		"pins." + strconv.Itoa(maxNum-1): bson.M{"$exists": false},
+	}
+	res, err := c.coll.UpdateOne(ctx, filter, bson.M{"$push": bson.M{"pins": pin}})
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return res.ModifiedCount > 0, nil
+}
+
+func (c *ConversationPinMgo) RemovePin(ctx context.Context, conversationID string, seq int64) (bool, error) {
+	res, err := c.coll.UpdateOne(ctx, bson.M{"conversation_id": conversationID}, bson.M{"$pull": bson.M{"pins": bson.M{"seq": seq}}})
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return res.ModifiedCount > 0, nil
+}
+
+func (c *ConversationPinMgo) Find(ctx context.Context, conversationIDs []string) ([]*relation.ConversationPinModel, error) {
+	return mgoutil.Find[*relation.ConversationPinModel](ctx, c.coll, bson.M{"conversation_id": bson.M{"$in": conversationIDs}})
+}
@@ -0,0 +1,104 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/mgoutil"
+	"github.com/OpenIMSDK/tools/pagination"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+func NewLegalHoldMongo(db *mongo.Database) (relation.LegalHoldInterface, error) {
+	coll := db.Collection("legal_hold")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "target_type", Value: 1},
+			{Key: "target_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &LegalHoldMgo{coll: coll}, nil
+}
+
+type LegalHoldMgo struct {
+	coll *mongo.Collection
+}
+
+func (l *LegalHoldMgo) Create(ctx context.Context, hold *relation.LegalHoldModel) error {
+	return mgoutil.InsertMany(ctx, l.coll, []*relation.LegalHoldModel{hold})
+}
+
+func (l *LegalHoldMgo) Delete(ctx context.Context, targetType int32, targetID string) error {
+	return mgoutil.DeleteOne(ctx, l.coll, bson.M{"target_type": targetType, "target_id": targetID})
+}
+
+func (l *LegalHoldMgo) Take(ctx context.Context, targetType int32, targetID string) (*relation.LegalHoldModel, error) {
+	return mgoutil.FindOne[*relation.LegalHoldModel](ctx, l.coll, bson.M{"target_type": targetType, "target_id": targetID})
+}
+
+func (l *LegalHoldMgo) FindAll(ctx context.Context) ([]*relation.LegalHoldModel, error) {
+	return mgoutil.Find[*relation.LegalHoldModel](ctx, l.coll, bson.M{})
+}
+
+func (l *LegalHoldMgo) Page(ctx context.Context, targetType int32, pagination pagination.Pagination) (int64, []*relation.LegalHoldModel, error) {
+	filter := bson.M{}
+	if targetType != 0 {
+		filter["target_type"] = targetType
+	}
+	return mgoutil.FindPage[*relation.LegalHoldModel](ctx, l.coll, filter, pagination, options.Find().SetSort(bson.M{"create_time": -1}))
+}
+
+func NewLegalHoldLogMongo(db *mongo.Database) (relation.LegalHoldLogInterface, error) {
+	coll := db.Collection("legal_hold_log")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "target_type", Value: 1},
+			{Key: "target_id", Value: 1},
+			{Key: "create_time", Value: -1},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &LegalHoldLogMgo{coll: coll}, nil
+}
+
+type LegalHoldLogMgo struct {
+	coll *mongo.Collection
+}
+
+func (l *LegalHoldLogMgo) Create(ctx context.Context, logs []*relation.LegalHoldLogModel) error {
+	return mgoutil.InsertMany(ctx, l.coll, logs)
+}
+
+func (l *LegalHoldLogMgo) Search(ctx context.Context, targetType int32, targetID string, pagination pagination.Pagination) (int64, []*relation.LegalHoldLogModel, error) {
+	filter := bson.M{}
+	if targetType != 0 {
+		filter["target_type"] = targetType
+	}
+	if targetID != "" {
+		filter["target_id"] = targetID
+	}
+	return mgoutil.FindPage[*relation.LegalHoldLogModel](ctx, l.coll, filter, pagination, options.Find().SetSort(bson.M{"create_time": -1}))
+}
@@ -0,0 +1,84 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/mgoutil"
+	"github.com/OpenIMSDK/tools/pagination"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+func NewModerationRecordMongo(db *mongo.Database) (relation.ModerationRecordInterface, error) {
+	coll := db.Collection("moderation_record")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "create_time", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "send_id", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "conversation_id", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ModerationRecordMgo{coll: coll}, nil
+}
+
+type ModerationRecordMgo struct {
+	coll *mongo.Collection
+}
+
+func (m *ModerationRecordMgo) Create(ctx context.Context, records []*relation.ModerationRecordModel) error {
+	return mgoutil.InsertMany(ctx, m.coll, records)
+}
+
+func (m *ModerationRecordMgo) Search(ctx context.Context, sendID string, conversationID string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*relation.ModerationRecordModel, error) {
+	filter := bson.M{}
+	if sendID != "" {
+		filter["send_id"] = sendID
+	}
+	if conversationID != "" {
+		filter["conversation_id"] = conversationID
+	}
+	createTime := bson.M{}
+	if !start.IsZero() {
+		createTime["$gte"] = start
+	}
+	if !end.IsZero() {
+		createTime["$lt"] = end
+	}
+	if len(createTime) > 0 {
+		filter["create_time"] = createTime
+	}
+	return mgoutil.FindPage[*relation.ModerationRecordModel](ctx, m.coll, filter, pagination, options.Find().SetSort(bson.M{"create_time": -1}))
+}
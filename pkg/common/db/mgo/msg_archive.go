@@ -0,0 +1,75 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/mgoutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+func NewMsgArchiveMongo(db *mongo.Database) (relation.MsgArchiveModelInterface, error) {
+	coll := db.Collection("msg_archive")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "doc_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "conversation_id", Value: 1},
+				{Key: "begin_seq", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &MsgArchiveMgo{coll: coll}, nil
+}
+
+type MsgArchiveMgo struct {
+	coll *mongo.Collection
+}
+
+func (m *MsgArchiveMgo) Upsert(ctx context.Context, archive *relation.MsgArchiveModel) error {
+	return mgoutil.UpdateOne(ctx, m.coll, bson.M{"doc_id": archive.DocID}, bson.M{"$set": archive}, false, options.Update().SetUpsert(true))
+}
+
+func (m *MsgArchiveMgo) Find(ctx context.Context, docIDs []string) ([]*relation.MsgArchiveModel, error) {
+	if len(docIDs) == 0 {
+		return nil, nil
+	}
+	return mgoutil.Find[*relation.MsgArchiveModel](ctx, m.coll, bson.M{"doc_id": bson.M{"$in": docIDs}})
+}
+
+func (m *MsgArchiveMgo) FindByConversation(ctx context.Context, conversationID string) ([]*relation.MsgArchiveModel, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "begin_seq", Value: 1}})
+	return mgoutil.Find[*relation.MsgArchiveModel](ctx, m.coll, bson.M{"conversation_id": conversationID}, opts)
+}
+
+func (m *MsgArchiveMgo) Delete(ctx context.Context, docIDs []string) error {
+	if len(docIDs) == 0 {
+		return nil
+	}
+	return mgoutil.DeleteMany(ctx, m.coll, bson.M{"doc_id": bson.M{"$in": docIDs}})
+}
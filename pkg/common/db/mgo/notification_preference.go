@@ -0,0 +1,52 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/mgoutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+func NewNotificationPreferenceMongo(db *mongo.Database) (relation.NotificationPreferenceInterface, error) {
+	coll := db.Collection("notification_preference")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &NotificationPreferenceMgo{coll: coll}, nil
+}
+
+type NotificationPreferenceMgo struct {
+	coll *mongo.Collection
+}
+
+func (n *NotificationPreferenceMgo) Set(ctx context.Context, preference *relation.NotificationPreferenceModel) error {
+	return mgoutil.UpdateOne(ctx, n.coll, bson.M{"user_id": preference.UserID}, bson.M{"$set": preference}, false, options.Update().SetUpsert(true))
+}
+
+func (n *NotificationPreferenceMgo) Take(ctx context.Context, userID string) (*relation.NotificationPreferenceModel, error) {
+	return mgoutil.FindOne[*relation.NotificationPreferenceModel](ctx, n.coll, bson.M{"user_id": userID})
+}
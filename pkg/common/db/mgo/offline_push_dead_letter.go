@@ -0,0 +1,84 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/mgoutil"
+	"github.com/OpenIMSDK/tools/pagination"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+func NewOfflinePushDeadLetterMongo(db *mongo.Database) (relation.OfflinePushDeadLetterInterface, error) {
+	coll := db.Collection("offline_push_dead_letter")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "dead_time", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "send_id", Value: 1},
+				{Key: "dead_time", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "conversation_id", Value: 1},
+				{Key: "dead_time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &OfflinePushDeadLetterMgo{coll: coll}, nil
+}
+
+type OfflinePushDeadLetterMgo struct {
+	coll *mongo.Collection
+}
+
+func (o *OfflinePushDeadLetterMgo) Create(ctx context.Context, letters []*relation.OfflinePushDeadLetterModel) error {
+	return mgoutil.InsertMany(ctx, o.coll, letters)
+}
+
+func (o *OfflinePushDeadLetterMgo) Find(ctx context.Context, ids []string) ([]*relation.OfflinePushDeadLetterModel, error) {
+	return mgoutil.Find[*relation.OfflinePushDeadLetterModel](ctx, o.coll, bson.M{"_id": bson.M{"$in": ids}})
+}
+
+func (o *OfflinePushDeadLetterMgo) Search(ctx context.Context, sendID string, conversationID string, pagination pagination.Pagination) (int64, []*relation.OfflinePushDeadLetterModel, error) {
+	filter := bson.M{}
+	if sendID != "" {
+		filter["send_id"] = sendID
+	}
+	if conversationID != "" {
+		filter["conversation_id"] = conversationID
+	}
+	return mgoutil.FindPage[*relation.OfflinePushDeadLetterModel](ctx, o.coll, filter, pagination, options.Find().SetSort(bson.M{"dead_time": -1}))
+}
+
+func (o *OfflinePushDeadLetterMgo) Delete(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return mgoutil.DeleteMany(ctx, o.coll, bson.M{"_id": bson.M{"$in": ids}})
+}
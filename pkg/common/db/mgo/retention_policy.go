@@ -0,0 +1,77 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/mgoutil"
+	"github.com/OpenIMSDK/tools/pagination"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+func NewRetentionPolicyMongo(db *mongo.Database) (relation.RetentionPolicyInterface, error) {
+	coll := db.Collection("retention_policy")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "scope", Value: 1},
+			{Key: "key", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &RetentionPolicyMgo{coll: coll}, nil
+}
+
+type RetentionPolicyMgo struct {
+	coll *mongo.Collection
+}
+
+func (r *RetentionPolicyMgo) Set(ctx context.Context, policy *relation.RetentionPolicyModel) error {
+	filter := bson.M{"scope": policy.Scope, "key": policy.Key}
+	update := bson.M{
+		"$set": bson.M{
+			"retain_days": policy.RetainDays,
+			"op_user_id":  policy.OpUserID,
+			"update_time": policy.UpdateTime,
+		},
+		"$setOnInsert": bson.M{
+			"create_time": policy.CreateTime,
+		},
+	}
+	return mgoutil.UpdateOne(ctx, r.coll, filter, update, false, options.Update().SetUpsert(true))
+}
+
+func (r *RetentionPolicyMgo) Delete(ctx context.Context, scope int32, key string) error {
+	return mgoutil.DeleteOne(ctx, r.coll, bson.M{"scope": scope, "key": key})
+}
+
+func (r *RetentionPolicyMgo) FindAll(ctx context.Context) ([]*relation.RetentionPolicyModel, error) {
+	return mgoutil.Find[*relation.RetentionPolicyModel](ctx, r.coll, bson.M{})
+}
+
+func (r *RetentionPolicyMgo) Page(ctx context.Context, scope int32, pagination pagination.Pagination) (int64, []*relation.RetentionPolicyModel, error) {
+	filter := bson.M{}
+	if scope != 0 {
+		filter["scope"] = scope
+	}
+	return mgoutil.FindPage[*relation.RetentionPolicyModel](ctx, r.coll, filter, pagination, options.Find().SetSort(bson.D{{Key: "scope", Value: 1}, {Key: "key", Value: 1}}))
+}
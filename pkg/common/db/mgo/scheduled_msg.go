@@ -0,0 +1,94 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/mgoutil"
+	"github.com/OpenIMSDK/tools/pagination"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+func NewScheduledMsgMongo(db *mongo.Database) (relation.ScheduledMsgInterface, error) {
+	coll := db.Collection("scheduled_msg")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "scheduled_msg_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "send_at", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "send_at", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ScheduledMsgMgo{coll: coll}, nil
+}
+
+type ScheduledMsgMgo struct {
+	coll *mongo.Collection
+}
+
+func (s *ScheduledMsgMgo) Create(ctx context.Context, msgs []*relation.ScheduledMsgModel) error {
+	return mgoutil.InsertMany(ctx, s.coll, msgs)
+}
+
+func (s *ScheduledMsgMgo) Take(ctx context.Context, scheduledMsgID string) (*relation.ScheduledMsgModel, error) {
+	return mgoutil.FindOne[*relation.ScheduledMsgModel](ctx, s.coll, bson.M{"scheduled_msg_id": scheduledMsgID})
+}
+
+func (s *ScheduledMsgMgo) UpdateStatus(ctx context.Context, scheduledMsgID string, from []int32, data map[string]any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	filter := bson.M{"scheduled_msg_id": scheduledMsgID, "status": bson.M{"$in": from}}
+	return mgoutil.UpdateOne(ctx, s.coll, filter, bson.M{"$set": data}, true)
+}
+
+func (s *ScheduledMsgMgo) FindDue(ctx context.Context, now time.Time, limit int64) ([]*relation.ScheduledMsgModel, error) {
+	filter := bson.M{"status": relation.ScheduledMsgStatusPending, "send_at": bson.M{"$lte": now}}
+	return mgoutil.Find[*relation.ScheduledMsgModel](ctx, s.coll, filter, options.Find().SetSort(bson.M{"send_at": 1}).SetLimit(limit))
+}
+
+func (s *ScheduledMsgMgo) FindStuck(ctx context.Context, before time.Time) ([]*relation.ScheduledMsgModel, error) {
+	filter := bson.M{"status": relation.ScheduledMsgStatusSending, "update_time": bson.M{"$lt": before}}
+	return mgoutil.Find[*relation.ScheduledMsgModel](ctx, s.coll, filter)
+}
+
+func (s *ScheduledMsgMgo) FindByUser(ctx context.Context, userID string, status []int32, pagination pagination.Pagination) (int64, []*relation.ScheduledMsgModel, error) {
+	filter := bson.M{"user_id": userID}
+	if len(status) > 0 {
+		filter["status"] = bson.M{"$in": status}
+	}
+	return mgoutil.FindPage[*relation.ScheduledMsgModel](ctx, s.coll, filter, pagination, options.Find().SetSort(bson.M{"send_at": 1}))
+}
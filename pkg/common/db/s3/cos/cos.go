@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -57,8 +58,17 @@ const (
 )
 
 func NewCos() (s3.Interface, error) {
+	return newCos(config.Config.Object.Cos.BucketURL)
+}
+
+// NewPrivateCos uses bucketURL with the credentials of the cos in the config.
+func NewPrivateCos(bucketURL string) (s3.Interface, error) {
+	return newCos(bucketURL)
+}
+
+func newCos(bucketURL string) (s3.Interface, error) {
 	conf := config.Config.Object.Cos
-	u, err := url.Parse(conf.BucketURL)
+	u, err := url.Parse(bucketURL)
 	if err != nil {
 		panic(err)
 	}
@@ -214,6 +224,37 @@ func (c *Cos) StatObject(ctx context.Context, name string) (*s3.ObjectInfo, erro
 	return res, nil
 }
 
+func (c *Cos) PutObject(ctx context.Context, name string, reader io.Reader, size int64) error {
+	_, err := c.client.Object.Put(ctx, name, reader, &cos.ObjectPutOptions{
+		ObjectPutHeaderOptions: &cos.ObjectPutHeaderOptions{ContentLength: size},
+	})
+	return err
+}
+
+func (c *Cos) GetObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	resp, err := c.client.Object.Get(ctx, name, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (c *Cos) IsPublicRead(ctx context.Context, prefix string) (bool, error) {
+	acl, _, err := c.client.Bucket.GetACL(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, grant := range acl.AccessControlList {
+		if grant.Grantee == nil || !strings.HasSuffix(grant.Grantee.URI, "/AllUsers") {
+			continue
+		}
+		if grant.Permission == "READ" || grant.Permission == "FULL_CONTROL" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (c *Cos) CopyObject(ctx context.Context, src string, dst string) (*s3.CopyObjectInfo, error) {
 	sourceURL := c.copyURL + src
 	result, _, err := c.client.Object.Copy(ctx, dst, sourceURL, nil)
@@ -16,6 +16,7 @@ package minio
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -34,6 +35,7 @@ import (
 	"github.com/OpenIMSDK/tools/log"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/policy"
 	"github.com/minio/minio-go/v7/pkg/signer"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
@@ -60,6 +62,15 @@ const (
 const successCode = http.StatusOK
 
 func NewMinio(cache cache.MinioCache) (s3.Interface, error) {
+	return newMinio(cache, config.Config.Object.Minio.Bucket, config.Config.Object.Minio.PublicRead)
+}
+
+// NewPrivateMinio uses bucket of the minio in the config instead of the configured one, the bucket is never made public read.
+func NewPrivateMinio(cache cache.MinioCache, bucket string) (s3.Interface, error) {
+	return newMinio(cache, bucket, false)
+}
+
+func newMinio(cache cache.MinioCache, bucket string, publicRead bool) (s3.Interface, error) {
 	u, err := url.Parse(config.Config.Object.Minio.Endpoint)
 	if err != nil {
 		return nil, err
@@ -73,11 +84,12 @@ func NewMinio(cache cache.MinioCache) (s3.Interface, error) {
 		return nil, err
 	}
 	m := &Minio{
-		bucket: config.Config.Object.Minio.Bucket,
-		core:   &minio.Core{Client: client},
-		lock:   &sync.Mutex{},
-		init:   false,
-		cache:  cache,
+		bucket:     bucket,
+		publicRead: publicRead,
+		core:       &minio.Core{Client: client},
+		lock:       &sync.Mutex{},
+		init:       false,
+		cache:      cache,
 	}
 	if config.Config.Object.Minio.SignEndpoint == "" || config.Config.Object.Minio.SignEndpoint == config.Config.Object.Minio.Endpoint {
 		m.opts = opts
@@ -114,6 +126,7 @@ func NewMinio(cache cache.MinioCache) (s3.Interface, error) {
 
 type Minio struct {
 	bucket       string
+	publicRead   bool
 	signEndpoint string
 	location     string
 	opts         *minio.Options
@@ -134,29 +147,29 @@ func (m *Minio) initMinio(ctx context.Context) error {
 	if m.init {
 		return nil
 	}
-	conf := config.Config.Object.Minio
-	exists, err := m.core.Client.BucketExists(ctx, conf.Bucket)
+	exists, err := m.core.Client.BucketExists(ctx, m.bucket)
 	if err != nil {
 		return fmt.Errorf("check bucket exists error: %w", err)
 	}
 	if !exists {
-		if err := m.core.Client.MakeBucket(ctx, conf.Bucket, minio.MakeBucketOptions{}); err != nil {
+		if err := m.core.Client.MakeBucket(ctx, m.bucket, minio.MakeBucketOptions{}); err != nil {
 			return fmt.Errorf("make bucket error: %w", err)
 		}
 	}
-	if conf.PublicRead {
+	if m.publicRead {
 		policy := fmt.Sprintf(
 			`{"Version": "2012-10-17","Statement": [{"Action": ["s3:GetObject","s3:PutObject"],"Effect": "Allow","Principal": {"AWS": ["*"]},"Resource": ["arn:aws:s3:::%s/*"],"Sid": ""}]}`,
-			conf.Bucket,
+			m.bucket,
 		)
-		if err := m.core.Client.SetBucketPolicy(ctx, conf.Bucket, policy); err != nil {
+		if err := m.core.Client.SetBucketPolicy(ctx, m.bucket, policy); err != nil {
 			return err
 		}
 	}
-	m.location, err = m.core.Client.GetBucketLocation(ctx, conf.Bucket)
+	m.location, err = m.core.Client.GetBucketLocation(ctx, m.bucket)
 	if err != nil {
 		return err
 	}
+	conf := config.Config.Object.Minio
 	func() {
 		if conf.SignEndpoint == "" || conf.SignEndpoint == conf.Endpoint {
 			return
@@ -178,7 +191,7 @@ func (m *Minio) initMinio(ctx context.Context) error {
 		blc := reflect.ValueOf(m.sign).Elem().FieldByName("bucketLocCache")
 		vblc := reflect.New(reflect.PtrTo(blc.Type()))
 		*(*unsafe.Pointer)(vblc.UnsafePointer()) = unsafe.Pointer(blc.UnsafeAddr())
-		vblc.Elem().Elem().Interface().(interface{ Set(string, string) }).Set(conf.Bucket, m.location)
+		vblc.Elem().Elem().Interface().(interface{ Set(string, string) }).Set(m.bucket, m.location)
 	}()
 	m.init = true
 	return nil
@@ -322,6 +335,50 @@ func (m *Minio) StatObject(ctx context.Context, name string) (*s3.ObjectInfo, er
 	}, nil
 }
 
+func (m *Minio) PutObject(ctx context.Context, name string, reader io.Reader, size int64) error {
+	if err := m.initMinio(ctx); err != nil {
+		return err
+	}
+	_, err := m.core.Client.PutObject(ctx, m.bucket, name, reader, size, minio.PutObjectOptions{})
+	return err
+}
+
+func (m *Minio) GetObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	if err := m.initMinio(ctx); err != nil {
+		return nil, err
+	}
+	return m.core.Client.GetObject(ctx, m.bucket, name, minio.GetObjectOptions{})
+}
+
+func (m *Minio) IsPublicRead(ctx context.Context, prefix string) (bool, error) {
+	if err := m.initMinio(ctx); err != nil {
+		return false, err
+	}
+	data, err := m.core.Client.GetBucketPolicy(ctx, m.bucket)
+	if err != nil || data == "" {
+		return false, err
+	}
+	var bucketPolicy policy.BucketAccessPolicy
+	if err := json.Unmarshal([]byte(data), &bucketPolicy); err != nil {
+		return false, err
+	}
+	object := "arn:aws:s3:::" + m.bucket + "/" + prefix
+	for _, statement := range bucketPolicy.Statements {
+		if statement.Effect != "Allow" || !statement.Principal.AWS.Contains("*") {
+			continue
+		}
+		if !statement.Actions.Contains("s3:GetObject") && !statement.Actions.Contains("s3:*") && !statement.Actions.Contains("*") {
+			continue
+		}
+		for resource := range statement.Resources {
+			if resource == object || (strings.HasSuffix(resource, "*") && strings.HasPrefix(object, strings.TrimSuffix(resource, "*"))) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
 func (m *Minio) CopyObject(ctx context.Context, src string, dst string) (*s3.CopyObjectInfo, error) {
 	if err := m.initMinio(ctx); err != nil {
 		return nil, err
@@ -399,7 +456,7 @@ func (m *Minio) PresignedGetObject(ctx context.Context, name string, expire time
 		rawURL *url.URL
 		err    error
 	)
-	if config.Config.Object.Minio.PublicRead {
+	if m.publicRead {
 		rawURL, err = makeTargetURL(m.sign, m.bucket, name, m.location, false, query)
 	} else {
 		rawURL, err = m.sign.PresignedGetObject(ctx, m.bucket, name, expire, query)
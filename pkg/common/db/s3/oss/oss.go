@@ -58,23 +58,37 @@ const (
 )
 
 func NewOSS() (s3.Interface, error) {
+	return newOSS(config.Config.Object.Oss.Bucket, config.Config.Object.Oss.BucketURL)
+}
+
+// NewPrivateOSS uses bucket in the endpoint of the oss in the config, its url is the default one of the endpoint.
+func NewPrivateOSS(bucket string) (s3.Interface, error) {
+	u, err := url.Parse(config.Config.Object.Oss.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	u.Host = bucket + "." + u.Host
+	return newOSS(bucket, u.String())
+}
+
+func newOSS(bucketName string, bucketURL string) (s3.Interface, error) {
 	conf := config.Config.Object.Oss
-	if conf.BucketURL == "" {
+	if bucketURL == "" {
 		return nil, errors.New("bucket url is empty")
 	}
 	client, err := oss.New(conf.Endpoint, conf.AccessKeyID, conf.AccessKeySecret)
 	if err != nil {
 		return nil, err
 	}
-	bucket, err := client.Bucket(conf.Bucket)
+	bucket, err := client.Bucket(bucketName)
 	if err != nil {
 		return nil, err
 	}
-	if conf.BucketURL[len(conf.BucketURL)-1] != '/' {
-		conf.BucketURL += "/"
+	if bucketURL[len(bucketURL)-1] != '/' {
+		bucketURL += "/"
 	}
 	return &OSS{
-		bucketURL:   conf.BucketURL,
+		bucketURL:   bucketURL,
 		bucket:      bucket,
 		credentials: client.Config.GetCredentials(),
 		um:          *(*urlMaker)(reflect.ValueOf(bucket.Client.Conn).Elem().FieldByName("url").UnsafePointer()),
@@ -224,6 +238,22 @@ func (o *OSS) DeleteObject(ctx context.Context, name string) error {
 	return o.bucket.DeleteObject(name)
 }
 
+func (o *OSS) PutObject(ctx context.Context, name string, reader io.Reader, size int64) error {
+	return o.bucket.PutObject(name, reader, oss.ContentLength(size))
+}
+
+func (o *OSS) GetObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	return o.bucket.GetObject(name)
+}
+
+func (o *OSS) IsPublicRead(ctx context.Context, prefix string) (bool, error) {
+	acl, err := o.bucket.Client.GetBucketACL(o.bucket.BucketName)
+	if err != nil {
+		return false, err
+	}
+	return acl.ACL == string(oss.ACLPublicRead) || acl.ACL == string(oss.ACLPublicReadWrite), nil
+}
+
 func (o *OSS) CopyObject(ctx context.Context, src string, dst string) (*s3.CopyObjectInfo, error) {
 	result, err := o.bucket.CopyObject(src, dst)
 	if err != nil {
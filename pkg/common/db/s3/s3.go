@@ -16,6 +16,7 @@ package s3
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/url"
 	"time"
@@ -155,6 +156,10 @@ type Interface interface {
 
 	StatObject(ctx context.Context, name string) (*ObjectInfo, error)
 
+	PutObject(ctx context.Context, name string, reader io.Reader, size int64) error
+	GetObject(ctx context.Context, name string) (io.ReadCloser, error)
+	IsPublicRead(ctx context.Context, prefix string) (bool, error)
+
 	IsNotFound(err error) bool
 
 	AbortMultipartUpload(ctx context.Context, uploadID string, name string) error
@@ -0,0 +1,484 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/search"
+)
+
+const (
+	tombstoneFile   = "tombstones.log"
+	mergeLockFile   = "merge.lock"
+	refreshInterval = time.Second
+	mergeLockExpire = 10 * time.Minute
+	defaultMerge    = 8
+
+	// bm25 parameters.
+	k1 = 1.2
+	b  = 0.75
+)
+
+// Index is an embedded inverted index stored in a directory. Every Index call writes an
+// immutable segment and Delete appends to a tombstone log, so one process (msgtransfer)
+// can write while others (msg rpc) search the same directory. Small segments are merged
+// in the background once mergeFactor segments of the same size class pile up.
+type Index struct {
+	dir         string
+	mergeFactor int
+
+	lock        sync.RWMutex
+	segments    []*segment
+	tombstones  map[search.DocKey]int64
+	tombOffset  int64
+	refreshTime time.Time
+
+	writeLock sync.Mutex
+	lastGen   int64
+	merging   int32
+	mergeWG   sync.WaitGroup
+}
+
+func NewIndex(dir string, mergeFactor int) (*Index, error) {
+	if dir == "" {
+		return nil, errs.ErrArgs.Wrap("msg search index dir is empty")
+	}
+	if mergeFactor < 2 {
+		mergeFactor = defaultMerge
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	x := &Index{
+		dir:         dir,
+		mergeFactor: mergeFactor,
+		tombstones:  make(map[search.DocKey]int64),
+	}
+	if err := x.refresh(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// nextGen returns a unique, increasing generation, segments and tombstones are ordered by it.
+func (x *Index) nextGen() int64 {
+	for {
+		last := atomic.LoadInt64(&x.lastGen)
+		gen := time.Now().UnixNano()
+		if gen <= last {
+			gen = last + 1
+		}
+		if atomic.CompareAndSwapInt64(&x.lastGen, last, gen) {
+			return gen
+		}
+	}
+}
+
+func (x *Index) Index(ctx context.Context, docs []*search.MsgDocument) error {
+	if len(docs) == 0 {
+		return nil
+	}
+	data := newSegmentData()
+	for _, doc := range docs {
+		data.add(doc)
+	}
+	x.writeLock.Lock()
+	gen := x.nextGen()
+	err := data.write(filepath.Join(x.dir, segmentName(gen, gen)), gen, gen)
+	x.writeLock.Unlock()
+	if err != nil {
+		return err
+	}
+	x.mergeWG.Add(1)
+	go func() {
+		defer x.mergeWG.Done()
+		x.merge(ctx)
+	}()
+	return nil
+}
+
+func (x *Index) Delete(ctx context.Context, keys []search.DocKey) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	gen := x.nextGen()
+	var buf bytes.Buffer
+	for _, key := range keys {
+		fmt.Fprintf(&buf, "%d %s %d\n", gen, key.ConversationID, key.Seq)
+	}
+	f, err := os.OpenFile(filepath.Join(x.dir, tombstoneFile), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		_ = f.Close()
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(f.Close())
+}
+
+func (x *Index) Search(ctx context.Context, query *search.Query) (*search.Result, error) {
+	if err := x.maybeRefresh(); err != nil {
+		return nil, err
+	}
+	terms := uniqueTerms(search.QueryTerms(query.Keyword))
+	if strings.TrimSpace(query.Keyword) != "" && len(terms) == 0 {
+		return &search.Result{}, nil
+	}
+	required := append([]string{}, terms...)
+	if query.SendID != "" {
+		required = append(required, search.SendIDTerm(query.SendID))
+	}
+	if query.RecvID != "" {
+		required = append(required, search.RecvIDTerm(query.RecvID))
+	}
+	anyOf := make([]string, 0, len(query.ConversationIDs))
+	for _, conversationID := range query.ConversationIDs {
+		anyOf = append(anyOf, search.ConversationIDTerm(conversationID))
+	}
+	contentTypes := make(map[int32]struct{}, len(query.ContentTypes))
+	for _, contentType := range query.ContentTypes {
+		contentTypes[contentType] = struct{}{}
+	}
+
+	x.lock.RLock()
+	defer x.lock.RUnlock()
+	idf, avgLen := x.termStats(terms)
+	hits := make(map[search.DocKey]*search.Hit)
+	for _, seg := range x.segments {
+		if err := ctx.Err(); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		docs, tfs, err := seg.match(required, anyOf)
+		if err != nil {
+			return nil, err
+		}
+		for i, id := range docs {
+			doc := seg.docs[id]
+			if query.SessionType != 0 && doc.sessionType != query.SessionType {
+				continue
+			}
+			if len(contentTypes) > 0 {
+				if _, ok := contentTypes[doc.contentType]; !ok {
+					continue
+				}
+			}
+			if doc.sendTime < query.StartTime || (query.EndTime > 0 && doc.sendTime >= query.EndTime) {
+				continue
+			}
+			key := seg.key(id)
+			if gen, ok := x.tombstones[key]; ok && gen > seg.maxGen {
+				continue
+			}
+			var score float64
+			for j := range terms {
+				tf := float64(tfs[i][j])
+				norm := k1 * (1 - b + b*float64(doc.length)/avgLen)
+				score += idf[j] * tf * (k1 + 1) / (tf + norm)
+			}
+			// segments are ordered by generation, a message indexed twice keeps the newest copy
+			hits[key] = &search.Hit{ConversationID: key.ConversationID, Seq: key.Seq, SendTime: doc.sendTime, Score: score}
+		}
+	}
+	list := make([]*search.Hit, 0, len(hits))
+	for _, hit := range hits {
+		list = append(list, hit)
+	}
+	return search.Page(list, query)
+}
+
+func (x *Index) termStats(terms []string) (idf []float64, avgLen float64) {
+	var numDocs, totalLen float64
+	df := make([]float64, len(terms))
+	for _, seg := range x.segments {
+		numDocs += float64(len(seg.docs))
+		totalLen += float64(seg.totalLen)
+		for i, term := range terms {
+			df[i] += float64(seg.dict[term].df)
+		}
+	}
+	idf = make([]float64, len(terms))
+	for i := range terms {
+		idf[i] = math.Log(1 + (numDocs-df[i]+0.5)/(df[i]+0.5))
+	}
+	avgLen = 1
+	if numDocs > 0 && totalLen > 0 {
+		avgLen = totalLen / numDocs
+	}
+	return idf, avgLen
+}
+
+func uniqueTerms(terms []string) []string {
+	seen := make(map[string]struct{}, len(terms))
+	res := terms[:0]
+	for _, term := range terms {
+		if _, ok := seen[term]; ok {
+			continue
+		}
+		seen[term] = struct{}{}
+		res = append(res, term)
+	}
+	return res
+}
+
+func (x *Index) Close() error {
+	x.mergeWG.Wait()
+	x.lock.Lock()
+	defer x.lock.Unlock()
+	for _, seg := range x.segments {
+		_ = seg.file.Close()
+	}
+	x.segments = nil
+	return nil
+}
+
+func (x *Index) maybeRefresh() error {
+	x.lock.RLock()
+	fresh := time.Since(x.refreshTime) < refreshInterval
+	x.lock.RUnlock()
+	if fresh {
+		return nil
+	}
+	return x.refresh()
+}
+
+// refresh picks up segments and tombstones written by other processes.
+func (x *Index) refresh() error {
+	entries, err := os.ReadDir(x.dir)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	x.lock.Lock()
+	defer x.lock.Unlock()
+	opened := make(map[string]*segment, len(x.segments))
+	for _, seg := range x.segments {
+		opened[seg.name] = seg
+	}
+	segments := make([]*segment, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+		if seg, ok := opened[name]; ok {
+			segments = append(segments, seg)
+			delete(opened, name)
+			continue
+		}
+		seg, err := openSegment(filepath.Join(x.dir, name), name)
+		if err != nil {
+			// removed by a merge in the meantime
+			if !os.IsNotExist(errs.Unwrap(err)) {
+				log.ZWarn(context.Background(), "open msg search segment failed", err, "name", name)
+			}
+			continue
+		}
+		segments = append(segments, seg)
+	}
+	// a merged segment and its sources coexist until the sources are removed
+	merged := make(map[string]struct{})
+	for _, seg := range segments {
+		for _, source := range seg.sources {
+			merged[source] = struct{}{}
+		}
+	}
+	live := segments[:0]
+	for _, seg := range segments {
+		if _, ok := merged[seg.name]; ok {
+			opened[seg.name] = seg
+			continue
+		}
+		live = append(live, seg)
+	}
+	sort.Slice(live, func(i, j int) bool { return live[i].maxGen < live[j].maxGen })
+	for _, seg := range opened {
+		_ = seg.file.Close()
+	}
+	x.segments = live
+	if err := x.loadTombstones(); err != nil {
+		return err
+	}
+	x.refreshTime = time.Now()
+	return nil
+}
+
+func (x *Index) loadTombstones() error {
+	f, err := os.Open(filepath.Join(x.dir, tombstoneFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errs.Wrap(err)
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if info.Size() < x.tombOffset {
+		x.tombstones = make(map[search.DocKey]int64)
+		x.tombOffset = 0
+	}
+	if info.Size() == x.tombOffset {
+		return nil
+	}
+	r := bufio.NewReader(io.NewSectionReader(f, x.tombOffset, info.Size()-x.tombOffset))
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			// a partially written line is read again next time
+			break
+		}
+		x.tombOffset += int64(len(line))
+		var (
+			gen int64
+			key search.DocKey
+		)
+		if _, err := fmt.Sscanf(line, "%d %s %d\n", &gen, &key.ConversationID, &key.Seq); err != nil {
+			continue
+		}
+		if gen > x.tombstones[key] {
+			x.tombstones[key] = gen
+		}
+	}
+	return nil
+}
+
+// lockDir guards merges between processes sharing the directory.
+func (x *Index) lockDir() (unlock func(), ok bool) {
+	path := filepath.Join(x.dir, mergeLockFile)
+	for i := 0; i < 2; i++ {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+		if err == nil {
+			_ = f.Close()
+			return func() { _ = os.Remove(path) }, true
+		}
+		info, err := os.Stat(path)
+		if err != nil || time.Since(info.ModTime()) < mergeLockExpire {
+			return nil, false
+		}
+		_ = os.Remove(path)
+	}
+	return nil, false
+}
+
+// pickMerge returns a run of at least mergeFactor adjacent segments of the same size class.
+func (x *Index) pickMerge() []*segment {
+	level := func(seg *segment) int {
+		var l int
+		for n := len(seg.docs); n >= x.mergeFactor; n /= x.mergeFactor {
+			l++
+		}
+		return l
+	}
+	for i := 0; i+x.mergeFactor <= len(x.segments); {
+		j := i + 1
+		for j < len(x.segments) && level(x.segments[j]) == level(x.segments[i]) {
+			j++
+		}
+		if j-i >= x.mergeFactor {
+			return append([]*segment{}, x.segments[i:j]...)
+		}
+		i = j
+	}
+	return nil
+}
+
+func (x *Index) merge(ctx context.Context) {
+	if !atomic.CompareAndSwapInt32(&x.merging, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&x.merging, 0)
+	unlock, ok := x.lockDir()
+	if !ok {
+		return
+	}
+	defer unlock()
+	// wait for segments being written by this process
+	x.writeLock.Lock()
+	err := x.refresh()
+	x.writeLock.Unlock()
+	if err != nil {
+		log.ZWarn(ctx, "msg search refresh failed", err)
+		return
+	}
+	x.lock.RLock()
+	inputs := x.pickMerge()
+	if len(inputs) == 0 {
+		x.lock.RUnlock()
+		return
+	}
+	start := time.Now()
+	err = x.writeMerged(inputs)
+	x.lock.RUnlock()
+	if err != nil {
+		log.ZError(ctx, "msg search merge failed", err, "segments", len(inputs))
+		return
+	}
+	for _, seg := range inputs {
+		if err := os.Remove(filepath.Join(x.dir, seg.name)); err != nil {
+			log.ZWarn(ctx, "remove merged msg search segment failed", err, "name", seg.name)
+		}
+	}
+	if err := x.refresh(); err != nil {
+		log.ZWarn(ctx, "msg search refresh failed", err)
+	}
+	log.ZInfo(ctx, "msg search segments merged", "segments", len(inputs), "cost", time.Since(start))
+}
+
+// writeMerged writes inputs as one segment, dropping deleted docs and older copies of re-indexed ones.
+func (x *Index) writeMerged(inputs []*segment) error {
+	seen := make(map[search.DocKey]struct{})
+	dead := make([][]bool, len(inputs))
+	for i := len(inputs) - 1; i >= 0; i-- {
+		seg := inputs[i]
+		dead[i] = make([]bool, len(seg.docs))
+		for id := range seg.docs {
+			key := seg.key(uint32(id))
+			if _, ok := seen[key]; ok {
+				dead[i][id] = true
+				continue
+			}
+			seen[key] = struct{}{}
+			if gen, ok := x.tombstones[key]; ok && gen > seg.maxGen {
+				dead[i][id] = true
+			}
+		}
+	}
+	data := newSegmentData()
+	for i, seg := range inputs {
+		if err := data.addSegment(seg, func(doc uint32) bool { return !dead[i][doc] }); err != nil {
+			return err
+		}
+	}
+	minGen, maxGen := inputs[0].minGen, inputs[len(inputs)-1].maxGen
+	return data.write(filepath.Join(x.dir, segmentName(minGen, maxGen)), minGen, maxGen)
+}
@@ -0,0 +1,121 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/search"
+)
+
+func newTestIndex(t *testing.T) *Index {
+	index, err := NewIndex(t.TempDir(), 2)
+	assert.Nil(t, err)
+	t.Cleanup(func() { _ = index.Close() })
+	return index
+}
+
+func hitSeqs(res *search.Result) []int64 {
+	seqs := make([]int64, 0, len(res.Hits))
+	for _, hit := range res.Hits {
+		seqs = append(seqs, hit.Seq)
+	}
+	return seqs
+}
+
+func TestIndexSearch(t *testing.T) {
+	ctx := context.Background()
+	index := newTestIndex(t)
+	docs := []*search.MsgDocument{
+		{ConversationID: "si_a_b", Seq: 1, SendID: "a", RecvID: "b", SessionType: 1, ContentType: 101, SendTime: 1000, Text: "明天一起去吃火锅"},
+		{ConversationID: "si_a_b", Seq: 2, SendID: "b", RecvID: "a", SessionType: 1, ContentType: 101, SendTime: 2000, Text: "火锅 火锅 火锅!"},
+		{ConversationID: "sg_g1", Seq: 1, SendID: "a", SessionType: 3, ContentType: 101, SendTime: 3000, Text: "meeting at 3pm, hotpot later"},
+		{ConversationID: "sg_g1", Seq: 2, SendID: "c", SessionType: 3, ContentType: 102, SendTime: 4000},
+	}
+	assert.Nil(t, index.Index(ctx, docs))
+	assert.Nil(t, index.refresh())
+
+	res, err := index.Search(ctx, &search.Query{Keyword: "火锅"})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{2, 1}, hitSeqs(res))
+	assert.True(t, res.Hits[0].Score > res.Hits[1].Score)
+
+	res, err = index.Search(ctx, &search.Query{Keyword: "HOTPOT"})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1}, hitSeqs(res))
+
+	res, err = index.Search(ctx, &search.Query{SendID: "a"})
+	assert.Nil(t, err)
+	assert.EqualValues(t, 2, res.Total)
+
+	res, err = index.Search(ctx, &search.Query{ConversationIDs: []string{"sg_g1"}, ContentTypes: []int32{102}})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{2}, hitSeqs(res))
+
+	res, err = index.Search(ctx, &search.Query{StartTime: 2000, EndTime: 4000})
+	assert.Nil(t, err)
+	assert.EqualValues(t, 2, res.Total)
+
+	res, err = index.Search(ctx, &search.Query{Keyword: "火锅", SendID: "c"})
+	assert.Nil(t, err)
+	assert.Empty(t, res.Hits)
+}
+
+func TestIndexDeleteAndMerge(t *testing.T) {
+	ctx := context.Background()
+	index := newTestIndex(t)
+	for i := int64(1); i <= 4; i++ {
+		doc := &search.MsgDocument{ConversationID: "si_a_b", Seq: i, SendID: "a", SendTime: i, Text: fmt.Sprintf("hello %d", i)}
+		assert.Nil(t, index.Index(ctx, []*search.MsgDocument{doc}))
+	}
+	assert.Nil(t, index.Delete(ctx, []search.DocKey{{ConversationID: "si_a_b", Seq: 2}}))
+	// edited message indexed again after the delete
+	assert.Nil(t, index.Delete(ctx, []search.DocKey{{ConversationID: "si_a_b", Seq: 3}}))
+	assert.Nil(t, index.Index(ctx, []*search.MsgDocument{{ConversationID: "si_a_b", Seq: 3, SendTime: 3, Text: "hello again"}}))
+
+	index.mergeWG.Wait()
+	index.merge(ctx)
+	files, err := filepath.Glob(filepath.Join(index.dir, "*"+segmentExt))
+	assert.Nil(t, err)
+	assert.Less(t, len(files), 5)
+	_, err = os.Stat(filepath.Join(index.dir, mergeLockFile))
+	assert.True(t, os.IsNotExist(err))
+
+	res, err := index.Search(ctx, &search.Query{Keyword: "hello", Limit: 2})
+	assert.Nil(t, err)
+	assert.EqualValues(t, 3, res.Total)
+	assert.Equal(t, []int64{4, 3}, hitSeqs(res))
+	res, err = index.Search(ctx, &search.Query{Keyword: "hello", Limit: 2, Cursor: res.NextCursor})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1}, hitSeqs(res))
+
+	res, err = index.Search(ctx, &search.Query{Keyword: "again"})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{3}, hitSeqs(res))
+
+	// another process sees the same data
+	reader, err := NewIndex(index.dir, 2)
+	assert.Nil(t, err)
+	defer reader.Close()
+	res, err = reader.Search(ctx, &search.Query{Keyword: "hello"})
+	assert.Nil(t, err)
+	assert.EqualValues(t, 3, res.Total)
+}
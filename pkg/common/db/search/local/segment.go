@@ -0,0 +1,488 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/OpenIMSDK/tools/errs"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/search"
+)
+
+// A segment is an immutable file:
+//
+//	magic | postings | dictionary | conversations + sources | docs | footer
+//
+// postings of a term are uvarint(count) followed by (uvarint(doc delta), uvarint(tf)) pairs,
+// docs are fixed size records so they can be loaded without parsing.
+const (
+	segmentMagic   = "OIMS"
+	segmentExt     = ".seg"
+	docRecordSize  = 32
+	footerSize     = 7*8 + len(segmentMagic)
+	maxVarintBytes = binary.MaxVarintLen64
+)
+
+var errCorruptSegment = errors.New("corrupt search segment")
+
+type segmentDoc struct {
+	sendTime    int64
+	seq         int64
+	conv        uint32
+	length      uint32
+	sessionType int32
+	contentType int32
+}
+
+type posting struct {
+	doc uint32
+	tf  uint32
+}
+
+type termInfo struct {
+	offset int64
+	df     uint32
+}
+
+// segmentData is a segment being built in memory.
+type segmentData struct {
+	convs    []string
+	convIdx  map[string]uint32
+	docs     []segmentDoc
+	postings map[string][]posting
+	totalLen uint64
+	sources  []string
+}
+
+func newSegmentData() *segmentData {
+	return &segmentData{convIdx: make(map[string]uint32), postings: make(map[string][]posting)}
+}
+
+func (s *segmentData) conv(conversationID string) uint32 {
+	idx, ok := s.convIdx[conversationID]
+	if !ok {
+		idx = uint32(len(s.convs))
+		s.convIdx[conversationID] = idx
+		s.convs = append(s.convs, conversationID)
+	}
+	return idx
+}
+
+func (s *segmentData) add(doc *search.MsgDocument) {
+	id := uint32(len(s.docs))
+	terms := search.Tokenize(doc.Text)
+	tfs := make(map[string]uint32, len(terms)+3)
+	for _, term := range terms {
+		tfs[term]++
+	}
+	tfs[search.ConversationIDTerm(doc.ConversationID)] = 1
+	if doc.SendID != "" {
+		tfs[search.SendIDTerm(doc.SendID)] = 1
+	}
+	if doc.RecvID != "" {
+		tfs[search.RecvIDTerm(doc.RecvID)] = 1
+	}
+	for term, tf := range tfs {
+		s.postings[term] = append(s.postings[term], posting{doc: id, tf: tf})
+	}
+	s.docs = append(s.docs, segmentDoc{
+		sendTime:    doc.SendTime,
+		seq:         doc.Seq,
+		conv:        s.conv(doc.ConversationID),
+		length:      uint32(len(terms)),
+		sessionType: doc.SessionType,
+		contentType: doc.ContentType,
+	})
+	s.totalLen += uint64(len(terms))
+}
+
+// addSegment copies the docs of seg accepted by live, keeping postings sorted by doc.
+func (s *segmentData) addSegment(seg *segment, live func(doc uint32) bool) error {
+	remap := make([]int64, len(seg.docs))
+	for i, doc := range seg.docs {
+		if !live(uint32(i)) {
+			remap[i] = -1
+			continue
+		}
+		remap[i] = int64(len(s.docs))
+		doc.conv = s.conv(seg.convs[doc.conv])
+		s.docs = append(s.docs, doc)
+		s.totalLen += uint64(doc.length)
+	}
+	for term := range seg.dict {
+		postings, err := seg.postings(term)
+		if err != nil {
+			return err
+		}
+		for _, p := range postings {
+			if id := remap[p.doc]; id >= 0 {
+				s.postings[term] = append(s.postings[term], posting{doc: uint32(id), tf: p.tf})
+			}
+		}
+	}
+	s.sources = append(s.sources, seg.name)
+	return nil
+}
+
+type countWriter struct {
+	w *bufio.Writer
+	n int64
+}
+
+func (c *countWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func (c *countWriter) uvarint(v uint64) {
+	var buf [maxVarintBytes]byte
+	_, _ = c.Write(buf[:binary.PutUvarint(buf[:], v)])
+}
+
+func (c *countWriter) string(v string) {
+	c.uvarint(uint64(len(v)))
+	_, _ = c.Write([]byte(v))
+}
+
+func (c *countWriter) uint64(v uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	_, _ = c.Write(buf[:])
+}
+
+func segmentName(minGen, maxGen int64) string {
+	return fmt.Sprintf("%020d-%020d%s", minGen, maxGen, segmentExt)
+}
+
+// write stores the segment under path, the file only becomes visible once it is complete.
+func (s *segmentData) write(path string, minGen, maxGen int64) (err error) {
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			_ = f.Close()
+			_ = os.Remove(tmp)
+		}
+	}()
+	w := &countWriter{w: bufio.NewWriterSize(f, 64*1024)}
+	_, _ = w.Write([]byte(segmentMagic))
+	terms := make([]string, 0, len(s.postings))
+	for term := range s.postings {
+		terms = append(terms, term)
+	}
+	sort.Strings(terms)
+	offsets := make([]int64, len(terms))
+	for i, term := range terms {
+		offsets[i] = w.n
+		postings := s.postings[term]
+		w.uvarint(uint64(len(postings)))
+		var last uint32
+		for _, p := range postings {
+			w.uvarint(uint64(p.doc - last))
+			w.uvarint(uint64(p.tf))
+			last = p.doc
+		}
+	}
+	dictOff := w.n
+	w.uvarint(uint64(len(terms)))
+	for i, term := range terms {
+		w.string(term)
+		w.uvarint(uint64(offsets[i]))
+		w.uvarint(uint64(len(s.postings[term])))
+	}
+	convOff := w.n
+	w.uvarint(uint64(len(s.convs)))
+	for _, conv := range s.convs {
+		w.string(conv)
+	}
+	w.uvarint(uint64(len(s.sources)))
+	for _, source := range s.sources {
+		w.string(source)
+	}
+	docOff := w.n
+	var record [docRecordSize]byte
+	for _, doc := range s.docs {
+		binary.LittleEndian.PutUint64(record[0:], uint64(doc.sendTime))
+		binary.LittleEndian.PutUint64(record[8:], uint64(doc.seq))
+		binary.LittleEndian.PutUint32(record[16:], doc.conv)
+		binary.LittleEndian.PutUint32(record[20:], doc.length)
+		binary.LittleEndian.PutUint32(record[24:], uint32(doc.sessionType))
+		binary.LittleEndian.PutUint32(record[28:], uint32(doc.contentType))
+		_, _ = w.Write(record[:])
+	}
+	for _, v := range []uint64{uint64(dictOff), uint64(convOff), uint64(docOff), uint64(len(s.docs)), s.totalLen, uint64(minGen), uint64(maxGen)} {
+		w.uint64(v)
+	}
+	_, _ = w.Write([]byte(segmentMagic))
+	if err := w.w.Flush(); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := f.Sync(); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := f.Close(); err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(os.Rename(tmp, path))
+}
+
+// segment is an opened segment file, everything but the postings is kept in memory.
+type segment struct {
+	name     string
+	file     *os.File
+	minGen   int64
+	maxGen   int64
+	dict     map[string]termInfo
+	convs    []string
+	sources  []string
+	docs     []segmentDoc
+	totalLen uint64
+}
+
+func openSegment(path, name string) (seg *segment, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			_ = f.Close()
+		}
+	}()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	size := info.Size()
+	if size < int64(len(segmentMagic)+footerSize) {
+		return nil, errs.Wrap(errCorruptSegment, name)
+	}
+	footer := make([]byte, footerSize)
+	if _, err := f.ReadAt(footer, size-int64(footerSize)); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if string(footer[footerSize-len(segmentMagic):]) != segmentMagic {
+		return nil, errs.Wrap(errCorruptSegment, name)
+	}
+	field := func(i int) uint64 { return binary.LittleEndian.Uint64(footer[i*8:]) }
+	dictOff, convOff, docOff, numDocs := int64(field(0)), int64(field(1)), int64(field(2)), int64(field(3))
+	if dictOff > convOff || convOff > docOff || docOff+numDocs*docRecordSize != size-int64(footerSize) {
+		return nil, errs.Wrap(errCorruptSegment, name)
+	}
+	meta := make([]byte, size-int64(footerSize)-dictOff)
+	if _, err := f.ReadAt(meta, dictOff); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	seg = &segment{
+		name:     name,
+		file:     f,
+		totalLen: field(4),
+		minGen:   int64(field(5)),
+		maxGen:   int64(field(6)),
+	}
+	r := bytes.NewReader(meta[:docOff-dictOff])
+	numTerms, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, errs.Wrap(errCorruptSegment, name)
+	}
+	seg.dict = make(map[string]termInfo, numTerms)
+	for i := uint64(0); i < numTerms; i++ {
+		term, err := readString(r)
+		if err != nil {
+			return nil, errs.Wrap(errCorruptSegment, name)
+		}
+		offset, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, errs.Wrap(errCorruptSegment, name)
+		}
+		df, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, errs.Wrap(errCorruptSegment, name)
+		}
+		seg.dict[term] = termInfo{offset: int64(offset), df: uint32(df)}
+	}
+	if seg.convs, err = readStrings(r); err != nil {
+		return nil, errs.Wrap(errCorruptSegment, name)
+	}
+	if seg.sources, err = readStrings(r); err != nil {
+		return nil, errs.Wrap(errCorruptSegment, name)
+	}
+	records := meta[docOff-dictOff:]
+	seg.docs = make([]segmentDoc, numDocs)
+	for i := range seg.docs {
+		record := records[i*docRecordSize:]
+		seg.docs[i] = segmentDoc{
+			sendTime:    int64(binary.LittleEndian.Uint64(record[0:])),
+			seq:         int64(binary.LittleEndian.Uint64(record[8:])),
+			conv:        binary.LittleEndian.Uint32(record[16:]),
+			length:      binary.LittleEndian.Uint32(record[20:]),
+			sessionType: int32(binary.LittleEndian.Uint32(record[24:])),
+			contentType: int32(binary.LittleEndian.Uint32(record[28:])),
+		}
+		if int(seg.docs[i].conv) >= len(seg.convs) {
+			return nil, errs.Wrap(errCorruptSegment, name)
+		}
+	}
+	return seg, nil
+}
+
+func readString(r *bytes.Reader) (string, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return "", err
+	}
+	if n > uint64(r.Len()) {
+		return "", io.ErrUnexpectedEOF
+	}
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
+
+func readStrings(r *bytes.Reader) ([]string, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(r.Len()) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	res := make([]string, n)
+	for i := range res {
+		if res[i], err = readString(r); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (s *segment) key(doc uint32) search.DocKey {
+	return search.DocKey{ConversationID: s.convs[s.docs[doc].conv], Seq: s.docs[doc].seq}
+}
+
+func (s *segment) postings(term string) ([]posting, error) {
+	info, ok := s.dict[term]
+	if !ok {
+		return nil, nil
+	}
+	r := bufio.NewReader(io.NewSectionReader(s.file, info.offset, 1<<62))
+	count, err := binary.ReadUvarint(r)
+	if err != nil || count != uint64(info.df) || int(count) > len(s.docs) {
+		return nil, errs.Wrap(errCorruptSegment, s.name)
+	}
+	postings := make([]posting, count)
+	var doc uint64
+	for i := range postings {
+		delta, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, errs.Wrap(errCorruptSegment, s.name)
+		}
+		tf, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, errs.Wrap(errCorruptSegment, s.name)
+		}
+		doc += delta
+		if doc >= uint64(len(s.docs)) {
+			return nil, errs.Wrap(errCorruptSegment, s.name)
+		}
+		postings[i] = posting{doc: uint32(doc), tf: uint32(tf)}
+	}
+	return postings, nil
+}
+
+// match returns the docs containing every required term and, when anyOf is not empty,
+// at least one of anyOf. tfs[i][j] is the frequency of required[j] in docs[i].
+func (s *segment) match(required []string, anyOf []string) (docs []uint32, tfs [][]uint32, err error) {
+	lists := make([][]posting, 0, len(required)+1)
+	for _, term := range required {
+		postings, err := s.postings(term)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(postings) == 0 {
+			return nil, nil, nil
+		}
+		lists = append(lists, postings)
+	}
+	if len(anyOf) > 0 {
+		var union []posting
+		for _, term := range anyOf {
+			postings, err := s.postings(term)
+			if err != nil {
+				return nil, nil, err
+			}
+			union = append(union, postings...)
+		}
+		if len(union) == 0 {
+			return nil, nil, nil
+		}
+		sort.Slice(union, func(i, j int) bool { return union[i].doc < union[j].doc })
+		lists = append(lists, union)
+	}
+	if len(lists) == 0 {
+		docs = make([]uint32, len(s.docs))
+		for i := range docs {
+			docs[i] = uint32(i)
+		}
+		return docs, make([][]uint32, len(docs)), nil
+	}
+	shortest := 0
+	for i := range lists {
+		if len(lists[i]) < len(lists[shortest]) {
+			shortest = i
+		}
+	}
+	pos := make([]int, len(lists))
+	var last int64 = -1
+	for _, p := range lists[shortest] {
+		if int64(p.doc) == last {
+			continue
+		}
+		last = int64(p.doc)
+		tf := make([]uint32, len(required))
+		found := true
+		for i, list := range lists {
+			for pos[i] < len(list) && list[pos[i]].doc < p.doc {
+				pos[i]++
+			}
+			if pos[i] == len(list) || list[pos[i]].doc != p.doc {
+				found = false
+				break
+			}
+			if i < len(required) {
+				tf[i] = list[pos[i]].tf
+			}
+		}
+		if found {
+			docs = append(docs, p.doc)
+			tfs = append(tfs, tf)
+		}
+	}
+	return docs, tfs, nil
+}
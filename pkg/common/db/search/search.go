@@ -0,0 +1,151 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"sort"
+)
+
+const (
+	// EngineLocal is the embedded on-disk inverted index.
+	EngineLocal = "local"
+)
+
+// MsgDocument is the searchable projection of a persisted message, only the
+// fields needed for filtering and ranking are kept, hits are resolved back to
+// the message through ConversationID and Seq.
+type MsgDocument struct {
+	ConversationID string
+	Seq            int64
+	SendID         string
+	RecvID         string
+	SessionType    int32
+	ContentType    int32
+	SendTime       int64
+	Text           string
+}
+
+func (d *MsgDocument) Key() DocKey {
+	return DocKey{ConversationID: d.ConversationID, Seq: d.Seq}
+}
+
+type DocKey struct {
+	ConversationID string
+	Seq            int64
+}
+
+type Query struct {
+	// Keyword is matched against the message text, every term must be present.
+	Keyword         string
+	SendID          string
+	RecvID          string
+	ConversationIDs []string
+	SessionType     int32
+	ContentTypes    []int32
+	// StartTime and EndTime bound SendTime in milliseconds, [StartTime, EndTime).
+	StartTime int64
+	EndTime   int64
+	// Cursor is the NextCursor of the previous page, Offset is only used without Cursor.
+	Cursor string
+	Offset int
+	Limit  int
+}
+
+type Hit struct {
+	ConversationID string  `json:"c"`
+	Seq            int64   `json:"q"`
+	SendTime       int64   `json:"t"`
+	Score          float64 `json:"s"`
+}
+
+func (h *Hit) Key() DocKey {
+	return DocKey{ConversationID: h.ConversationID, Seq: h.Seq}
+}
+
+type Result struct {
+	Hits       []*Hit
+	Total      int64
+	NextCursor string
+}
+
+type MsgSearchIndex interface {
+	// Index adds messages to the index, it is fed by msgtransfer after the messages are persisted.
+	Index(ctx context.Context, docs []*MsgDocument) error
+	// Delete removes messages from the index, a message indexed again afterwards becomes visible again.
+	Delete(ctx context.Context, keys []DocKey) error
+	Search(ctx context.Context, query *Query) (*Result, error)
+	Close() error
+}
+
+// Less reports whether a ranks before b: higher score first, then newer messages.
+func Less(a, b *Hit) bool {
+	if a.Score != b.Score {
+		return a.Score > b.Score
+	}
+	if a.SendTime != b.SendTime {
+		return a.SendTime > b.SendTime
+	}
+	if a.ConversationID != b.ConversationID {
+		return a.ConversationID < b.ConversationID
+	}
+	return a.Seq > b.Seq
+}
+
+func EncodeCursor(hit *Hit) string {
+	data, _ := json.Marshal(hit)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeCursor(cursor string) (*Hit, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+	var hit Hit
+	if err := json.Unmarshal(data, &hit); err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+	return &hit, nil
+}
+
+// Page sorts hits and cuts the page described by the query out of them.
+func Page(hits []*Hit, query *Query) (*Result, error) {
+	sort.Slice(hits, func(i, j int) bool { return Less(hits[i], hits[j]) })
+	start := 0
+	if query.Cursor != "" {
+		after, err := DecodeCursor(query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		start = sort.Search(len(hits), func(i int) bool { return Less(after, hits[i]) })
+	} else if query.Offset > 0 {
+		start = query.Offset
+	}
+	res := &Result{Total: int64(len(hits))}
+	if start >= len(hits) {
+		return res, nil
+	}
+	end := len(hits)
+	if query.Limit > 0 && start+query.Limit < end {
+		end = start + query.Limit
+		res.NextCursor = EncodeCursor(hits[end-1])
+	}
+	res.Hits = hits[start:end]
+	return res, nil
+}
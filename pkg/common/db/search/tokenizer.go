@@ -0,0 +1,166 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/sdkws"
+)
+
+const maxTermLen = 32
+
+// NewMsgDocument returns the search document of a persisted message, ok is false
+// for messages that should not be searchable.
+func NewMsgDocument(conversationID string, msg *sdkws.MsgData) (doc *MsgDocument, ok bool) {
+	if msg.ContentType >= constant.NotificationBegin || msg.ContentType == constant.Typing || msg.Seq <= 0 {
+		return nil, false
+	}
+	return &MsgDocument{
+		ConversationID: conversationID,
+		Seq:            msg.Seq,
+		SendID:         msg.SendID,
+		RecvID:         msg.RecvID,
+		SessionType:    msg.SessionType,
+		ContentType:    msg.ContentType,
+		SendTime:       msg.SendTime,
+		Text:           msgText(msg.ContentType, msg.Content),
+	}, true
+}
+
+// msgText picks the human readable parts out of the elem json of the content types sent by the sdk.
+func msgText(contentType int32, content []byte) string {
+	var elem struct {
+		Content     string `json:"content"`
+		Text        string `json:"text"`
+		FileName    string `json:"fileName"`
+		Description string `json:"description"`
+		Title       string `json:"title"`
+		Nickname    string `json:"nickname"`
+	}
+	if len(content) == 0 || json.Unmarshal(content, &elem) != nil {
+		return ""
+	}
+	switch contentType {
+	case constant.Text:
+		return elem.Content
+	case constant.AtText, constant.Quote, constant.AdvancedText:
+		return elem.Text
+	case constant.File:
+		return elem.FileName
+	case constant.Location, constant.Custom:
+		return elem.Description
+	case constant.Merger:
+		return elem.Title
+	case constant.Card:
+		return elem.Nickname
+	default:
+		return ""
+	}
+}
+
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r)
+}
+
+// Tokenize splits text into index terms. Letters and digits form lower-cased
+// words, CJK characters are indexed both as single characters and as
+// overlapping bigrams so that one character and longer queries both match.
+func Tokenize(text string) []string {
+	return tokenize(text, true)
+}
+
+// QueryTerms splits a keyword the same way Tokenize does, except that runs of
+// CJK characters longer than one only produce bigrams.
+func QueryTerms(keyword string) []string {
+	return tokenize(keyword, false)
+}
+
+func tokenize(text string, unigrams bool) []string {
+	var (
+		terms []string
+		word  []rune
+		cjk   []rune
+	)
+	flushWord := func() {
+		if len(word) > 0 {
+			if len(word) > maxTermLen {
+				word = word[:maxTermLen]
+			}
+			terms = append(terms, string(word))
+			word = word[:0]
+		}
+	}
+	flushCJK := func() {
+		switch {
+		case len(cjk) == 1:
+			terms = append(terms, string(cjk))
+		case len(cjk) > 1:
+			for i := range cjk {
+				if unigrams {
+					terms = append(terms, string(cjk[i]))
+				}
+				if i+1 < len(cjk) {
+					terms = append(terms, string(cjk[i:i+2]))
+				}
+			}
+		}
+		cjk = cjk[:0]
+	}
+	for _, r := range text {
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word = append(word, unicode.ToLower(r))
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+	return terms
+}
+
+// filter terms share the posting lists with text terms, the prefix can not be produced by Tokenize.
+const (
+	sendIDTermPrefix         = "\x01s"
+	recvIDTermPrefix         = "\x01r"
+	conversationIDTermPrefix = "\x01c"
+)
+
+func SendIDTerm(sendID string) string {
+	return sendIDTermPrefix + sendID
+}
+
+func RecvIDTerm(recvID string) string {
+	return recvIDTermPrefix + recvID
+}
+
+func ConversationIDTerm(conversationID string) string {
+	return conversationIDTermPrefix + conversationID
+}
+
+// IsFilterTerm reports whether term was added by SendIDTerm, RecvIDTerm or ConversationIDTerm.
+func IsFilterTerm(term string) bool {
+	return strings.HasPrefix(term, "\x01")
+}
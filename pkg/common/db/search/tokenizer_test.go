@@ -0,0 +1,68 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		text  string
+		index []string
+		query []string
+	}{
+		{"Hello, World!", []string{"hello", "world"}, []string{"hello", "world"}},
+		{"今天天气", []string{"今", "今天", "天", "天天", "天", "天气", "气"}, []string{"今天", "天天", "天气"}},
+		{"好", []string{"好"}, []string{"好"}},
+		{"OpenIM发布v3", []string{"openim", "发", "发布", "布", "v3"}, []string{"openim", "发布", "v3"}},
+		{"  ... ", nil, nil},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.index, Tokenize(tt.text), tt.text)
+		assert.Equal(t, tt.query, QueryTerms(tt.text), tt.text)
+	}
+}
+
+func TestPage(t *testing.T) {
+	hits := []*Hit{
+		{ConversationID: "si_a_b", Seq: 1, SendTime: 100, Score: 1},
+		{ConversationID: "si_a_b", Seq: 2, SendTime: 200, Score: 1},
+		{ConversationID: "si_a_b", Seq: 3, SendTime: 300, Score: 2},
+	}
+	res, err := Page(hits, &Query{Limit: 2})
+	assert.Nil(t, err)
+	assert.EqualValues(t, 3, res.Total)
+	assert.Equal(t, []int64{3, 2}, seqs(res.Hits))
+	assert.NotEmpty(t, res.NextCursor)
+
+	res, err = Page(hits, &Query{Limit: 2, Cursor: res.NextCursor})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1}, seqs(res.Hits))
+	assert.Empty(t, res.NextCursor)
+
+	_, err = Page(hits, &Query{Cursor: "!"})
+	assert.NotNil(t, err)
+}
+
+func seqs(hits []*Hit) []int64 {
+	res := make([]int64, 0, len(hits))
+	for _, hit := range hits {
+		res = append(res, hit.Seq)
+	}
+	return res
+}
@@ -0,0 +1,39 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+)
+
+// PinnedMsgModel is a message pinned to a conversation, pins are shared by all members of the conversation.
+type PinnedMsgModel struct {
+	Seq       int64     `bson:"seq"`
+	PinUserID string    `bson:"pin_user_id"`
+	PinTime   time.Time `bson:"pin_time"`
+}
+
+type ConversationPinModel struct {
+	ConversationID string            `bson:"conversation_id"`
+	Pins           []*PinnedMsgModel `bson:"pins"`
+}
+
+type ConversationPinInterface interface {
+	// AddPin appends the pin unless the seq is already pinned or maxNum pins exist, pinned reports whether it was added.
+	AddPin(ctx context.Context, conversationID string, pin *PinnedMsgModel, maxNum int) (pinned bool, err error)
+	RemovePin(ctx context.Context, conversationID string, seq int64) (removed bool, err error)
+	Find(ctx context.Context, conversationIDs []string) ([]*ConversationPinModel, error)
+}
@@ -0,0 +1,66 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/pagination"
+)
+
+const (
+	// LegalHoldTargetConversation holds one conversation, TargetID is the conversationID.
+	LegalHoldTargetConversation = 1
+	// LegalHoldTargetUser holds every conversation the user is in, TargetID is the userID.
+	LegalHoldTargetUser = 2
+)
+
+const (
+	LegalHoldActionPlace   = 1
+	LegalHoldActionRelease = 2
+)
+
+// LegalHoldModel keeps the msgs of its target in storage, deletes only hide them from users while it exists.
+type LegalHoldModel struct {
+	TargetType int32     `bson:"target_type"`
+	TargetID   string    `bson:"target_id"`
+	Reason     string    `bson:"reason"`
+	OpUserID   string    `bson:"op_user_id"`
+	CreateTime time.Time `bson:"create_time"`
+}
+
+// LegalHoldLogModel is the audit record of a hold being placed or released.
+type LegalHoldLogModel struct {
+	TargetType int32     `bson:"target_type"`
+	TargetID   string    `bson:"target_id"`
+	Action     int32     `bson:"action"`
+	Reason     string    `bson:"reason"`
+	OpUserID   string    `bson:"op_user_id"`
+	CreateTime time.Time `bson:"create_time"`
+}
+
+type LegalHoldInterface interface {
+	Create(ctx context.Context, hold *LegalHoldModel) error
+	Delete(ctx context.Context, targetType int32, targetID string) error
+	Take(ctx context.Context, targetType int32, targetID string) (*LegalHoldModel, error)
+	FindAll(ctx context.Context) ([]*LegalHoldModel, error)
+	Page(ctx context.Context, targetType int32, pagination pagination.Pagination) (int64, []*LegalHoldModel, error)
+}
+
+type LegalHoldLogInterface interface {
+	Create(ctx context.Context, logs []*LegalHoldLogModel) error
+	Search(ctx context.Context, targetType int32, targetID string, pagination pagination.Pagination) (int64, []*LegalHoldLogModel, error)
+}
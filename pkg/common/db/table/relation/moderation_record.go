@@ -0,0 +1,41 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/pagination"
+)
+
+// ModerationRecordModel is a message flagged by the moderation dictionary, kept for admins to review.
+type ModerationRecordModel struct {
+	ServerMsgID    string    `bson:"server_msg_id"`
+	ClientMsgID    string    `bson:"client_msg_id"`
+	ConversationID string    `bson:"conversation_id"`
+	SendID         string    `bson:"send_id"`
+	SessionType    int32     `bson:"session_type"`
+	ContentType    int32     `bson:"content_type"`
+	Content        string    `bson:"content"`
+	Words          []string  `bson:"words"`
+	SendTime       int64     `bson:"send_time"`
+	CreateTime     time.Time `bson:"create_time"`
+}
+
+type ModerationRecordInterface interface {
+	Create(ctx context.Context, records []*ModerationRecordModel) error
+	Search(ctx context.Context, sendID string, conversationID string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*ModerationRecordModel, error)
+}
@@ -0,0 +1,41 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+)
+
+// MsgArchiveModel indexes a msg doc that was moved out of mongo into object storage.
+// EndTime is the send time in milliseconds of the newest msg in the doc.
+type MsgArchiveModel struct {
+	DocID          string    `bson:"doc_id"`
+	ConversationID string    `bson:"conversation_id"`
+	BeginSeq       int64     `bson:"begin_seq"`
+	EndSeq         int64     `bson:"end_seq"`
+	EndTime        int64     `bson:"end_time"`
+	MsgCount       int64     `bson:"msg_count"`
+	Object         string    `bson:"object"`
+	Size           int64     `bson:"size"`
+	CreateTime     time.Time `bson:"create_time"`
+}
+
+type MsgArchiveModelInterface interface {
+	Upsert(ctx context.Context, archive *MsgArchiveModel) error
+	Find(ctx context.Context, docIDs []string) ([]*MsgArchiveModel, error)
+	FindByConversation(ctx context.Context, conversationID string) ([]*MsgArchiveModel, error)
+	Delete(ctx context.Context, docIDs []string) error
+}
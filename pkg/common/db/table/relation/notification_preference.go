@@ -0,0 +1,38 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+)
+
+// NotificationPreferenceModel is how a user wants to be notified of offline messages.
+type NotificationPreferenceModel struct {
+	UserID                  string    `bson:"user_id"`
+	Timezone                string    `bson:"timezone"`
+	QuietHoursEnable        bool      `bson:"quiet_hours_enable"`
+	QuietHoursStart         string    `bson:"quiet_hours_start"`
+	QuietHoursEnd           string    `bson:"quiet_hours_end"`
+	DisabledPushPlatformIDs []int32   `bson:"disabled_push_platform_ids"`
+	GroupMentionOnly        bool      `bson:"group_mention_only"`
+	UpdateTime              time.Time `bson:"update_time"`
+}
+
+type NotificationPreferenceInterface interface {
+	// Set creates or replaces the preference of the user.
+	Set(ctx context.Context, preference *NotificationPreferenceModel) error
+	Take(ctx context.Context, userID string) (*NotificationPreferenceModel, error)
+}
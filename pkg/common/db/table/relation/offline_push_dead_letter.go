@@ -0,0 +1,44 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/pagination"
+)
+
+// OfflinePushDeadLetterModel is an offline push that still failed after all retries, kept for admins to inspect and replay.
+type OfflinePushDeadLetterModel struct {
+	ID             string    `bson:"_id"`
+	ConversationID string    `bson:"conversation_id"`
+	UserIDs        []string  `bson:"user_ids"`
+	ClientMsgID    string    `bson:"client_msg_id"`
+	SendID         string    `bson:"send_id"`
+	ContentType    int32     `bson:"content_type"`
+	Msg            []byte    `bson:"msg"`
+	Attempts       int32     `bson:"attempts"`
+	LastError      string    `bson:"last_error"`
+	CreateTime     time.Time `bson:"create_time"`
+	DeadTime       time.Time `bson:"dead_time"`
+}
+
+type OfflinePushDeadLetterInterface interface {
+	Create(ctx context.Context, letters []*OfflinePushDeadLetterModel) error
+	Find(ctx context.Context, ids []string) ([]*OfflinePushDeadLetterModel, error)
+	Search(ctx context.Context, sendID string, conversationID string, pagination pagination.Pagination) (int64, []*OfflinePushDeadLetterModel, error)
+	Delete(ctx context.Context, ids []string) error
+}
@@ -0,0 +1,48 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/pagination"
+)
+
+const (
+	// RetentionScopeConversation applies to one conversation, Key is the conversationID.
+	RetentionScopeConversation = 1
+	// RetentionScopePrefix applies to the conversations whose ID, without the "si_"/"sg_"/"n_" type prefix, starts with Key.
+	RetentionScopePrefix = 2
+	// RetentionScopeDefault applies to every other conversation, Key is empty.
+	RetentionScopeDefault = 3
+)
+
+// RetentionPolicyModel is how many days the msgs of the conversations in its scope are kept in mongo.
+type RetentionPolicyModel struct {
+	Scope      int32     `bson:"scope"`
+	Key        string    `bson:"key"`
+	RetainDays int32     `bson:"retain_days"`
+	OpUserID   string    `bson:"op_user_id"`
+	CreateTime time.Time `bson:"create_time"`
+	UpdateTime time.Time `bson:"update_time"`
+}
+
+type RetentionPolicyInterface interface {
+	Set(ctx context.Context, policy *RetentionPolicyModel) error
+	Delete(ctx context.Context, scope int32, key string) error
+	FindAll(ctx context.Context) ([]*RetentionPolicyModel, error)
+	Page(ctx context.Context, scope int32, pagination pagination.Pagination) (int64, []*RetentionPolicyModel, error)
+}
@@ -0,0 +1,52 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/pagination"
+)
+
+const (
+	ScheduledMsgStatusPending int32 = iota
+	ScheduledMsgStatusSending
+	ScheduledMsgStatusSent
+	ScheduledMsgStatusCancelled
+	ScheduledMsgStatusFailed
+)
+
+type ScheduledMsgModel struct {
+	ScheduledMsgID string    `bson:"scheduled_msg_id"`
+	UserID         string    `bson:"user_id"`
+	SendAt         time.Time `bson:"send_at"`
+	Status         int32     `bson:"status"`
+	Msg            []byte    `bson:"msg"`
+	ServerMsgID    string    `bson:"server_msg_id"`
+	ErrMsg         string    `bson:"err_msg"`
+	CreateTime     time.Time `bson:"create_time"`
+	UpdateTime     time.Time `bson:"update_time"`
+}
+
+type ScheduledMsgInterface interface {
+	Create(ctx context.Context, msgs []*ScheduledMsgModel) error
+	Take(ctx context.Context, scheduledMsgID string) (*ScheduledMsgModel, error)
+	// UpdateStatus only succeeds when the current status is one of from, otherwise mongo.ErrNoDocuments is returned.
+	UpdateStatus(ctx context.Context, scheduledMsgID string, from []int32, data map[string]any) error
+	FindDue(ctx context.Context, now time.Time, limit int64) ([]*ScheduledMsgModel, error)
+	FindStuck(ctx context.Context, before time.Time) ([]*ScheduledMsgModel, error)
+	FindByUser(ctx context.Context, userID string, status []int32, pagination pagination.Pagination) (int64, []*ScheduledMsgModel, error)
+}
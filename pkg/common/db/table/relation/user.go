@@ -30,6 +30,7 @@ type UserModel struct {
 	Ex               string    `bson:"ex"`
 	AppMangerLevel   int32     `bson:"app_manger_level"`
 	GlobalRecvMsgOpt int32     `bson:"global_recv_msg_opt"`
+	Locale           string    `bson:"locale"` // BCP 47 language tag offline pushes are rendered in
 	CreateTime       time.Time `bson:"create_time"`
 }
 
@@ -19,11 +19,10 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/OpenIMSDK/protocol/msg"
-
 	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/pagination"
 )
 
 const (
@@ -32,6 +31,8 @@ const (
 	Msg                 = "msg"
 	OldestList          = 0
 	NewestList          = -1
+	// DelListAllUsers in MsgInfoModel.DelList hides the msg from every user, used instead of a physical delete under legal hold.
+	DelListAllUsers = "*"
 )
 
 type MsgDocModel struct {
@@ -46,6 +47,37 @@ type RevokeModel struct {
 	Time     int64  `bson:"time"`
 }
 
+type EditModel struct {
+	UserID   string `bson:"user_id"`
+	Nickname string `bson:"nickname"`
+	Content  string `bson:"content"`
+	// the content this edit replaced, the cached msg only keeps the latest content
+	PrevContent string `bson:"prev_content,omitempty"`
+	Time        int64  `bson:"time"`
+}
+
+// ReactionModel is one user's reaction, kept in MsgInfoModel.Reactions as emoji -> userIDs.
+type ReactionModel struct {
+	Emoji  string
+	UserID string
+}
+
+// ThreadModel is the reply state of a root message, it is only updated with $max so
+// ReplyCount (the seq of the last reply) must stay the first field.
+type ThreadModel struct {
+	ReplyCount      int64  `bson:"reply_count"`
+	LastReplySendID string `bson:"last_reply_send_id"`
+	LastReplyTime   int64  `bson:"last_reply_time"`
+}
+
+// PollVoteModel is one user's ballot on a poll, kept in MsgInfoModel.PollVotes.
+// The counts per option index are kept in MsgInfoModel.PollTally and updated in the same write.
+type PollVoteModel struct {
+	UserID  string  `bson:"user_id"`
+	Options []int32 `bson:"options"`
+	Time    int64   `bson:"time"`
+}
+
 type OfflinePushModel struct {
 	Title         string `bson:"title"`
 	Desc          string `bson:"desc"`
@@ -80,10 +112,16 @@ type MsgDataModel struct {
 }
 
 type MsgInfoModel struct {
-	Msg     *MsgDataModel `bson:"msg"`
-	Revoke  *RevokeModel  `bson:"revoke"`
-	DelList []string      `bson:"del_list"`
-	IsRead  bool          `bson:"is_read"`
+	Msg         *MsgDataModel       `bson:"msg"`
+	Revoke      *RevokeModel        `bson:"revoke"`
+	Edits       []*EditModel        `bson:"edits,omitempty"`
+	Reactions   map[string][]string `bson:"reactions,omitempty"`
+	Thread      *ThreadModel        `bson:"thread,omitempty"`
+	ThreadUsers []string            `bson:"thread_users,omitempty"`
+	PollVotes   []*PollVoteModel    `bson:"poll_votes,omitempty"`
+	PollTally   map[string]int64    `bson:"poll_tally,omitempty"`
+	DelList     []string            `bson:"del_list"`
+	IsRead      bool                `bson:"is_read"`
 }
 
 type UserCount struct {
@@ -101,17 +139,28 @@ type MsgDocModelInterface interface {
 	Create(ctx context.Context, model *MsgDocModel) error
 	UpdateMsg(ctx context.Context, docID string, index int64, key string, value any) (*mongo.UpdateResult, error)
 	PushUnique(ctx context.Context, docID string, index int64, key string, value any) (*mongo.UpdateResult, error)
+	PullAll(ctx context.Context, docID string, index int64, key string, value any) (*mongo.UpdateResult, error)
+	// PushReaction adds userID to the emoji reactions of the msg at index unless it would have more than maxEmojis emojis, 0 means no limit.
+	PushReaction(ctx context.Context, docID string, index int64, emoji string, userID string, maxEmojis int) (*mongo.UpdateResult, error)
+	// PullReaction removes userID from the emoji reactions of the msg at index, the emoji goes once no user is left.
+	PullReaction(ctx context.Context, docID string, index int64, emoji string, userID string) (*mongo.UpdateResult, error)
+	UpdateMsgMax(ctx context.Context, docID string, index int64, key string, value any) (*mongo.UpdateResult, error)
 	UpdateMsgContent(ctx context.Context, docID string, index int64, msg []byte) error
 	IsExistDocID(ctx context.Context, docID string) (bool, error)
 	FindOneByDocID(ctx context.Context, docID string) (*MsgDocModel, error)
 	GetMsgBySeqIndexIn1Doc(ctx context.Context, userID, docID string, seqs []int64) ([]*MsgInfoModel, error)
+	GetMsgReactionUsers(ctx context.Context, docID string, index int64, emoji string, pagination pagination.Pagination) (total int64, userIDs []string, err error)
+	AddPollVote(ctx context.Context, docID string, index int64, vote *PollVoteModel) (*mongo.UpdateResult, error)
+	GetMsgPoll(ctx context.Context, docID string, index int64) (*MsgInfoModel, error)
+	GetMsgEdits(ctx context.Context, docID string, index int64) (*MsgInfoModel, error)
 	GetNewestMsg(ctx context.Context, conversationID string) (*MsgInfoModel, error)
 	GetOldestMsg(ctx context.Context, conversationID string) (*MsgInfoModel, error)
 	DeleteDocs(ctx context.Context, docIDs []string) error
+	FindOneAndDeleteByDocID(ctx context.Context, docID string) (*MsgDocModel, error)
+	MergeMsg(ctx context.Context, docID string, index int64, info *MsgInfoModel) error
 	GetMsgDocModelByIndex(ctx context.Context, conversationID string, index, sort int64) (*MsgDocModel, error)
 	DeleteMsgsInOneDocByIndex(ctx context.Context, docID string, indexes []int) error
 	MarkSingleChatMsgsAsRead(ctx context.Context, userID string, docID string, indexes []int64) error
-	SearchMessage(ctx context.Context, req *msg.SearchMessageReq) (int32, []*MsgInfoModel, error)
 	RangeUserSendCount(
 		ctx context.Context,
 		start time.Time,
@@ -19,12 +19,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
-	"github.com/OpenIMSDK/tools/log"
-
-	"github.com/OpenIMSDK/protocol/msg"
-
 	"github.com/OpenIMSDK/protocol/constant"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -35,6 +32,7 @@ import (
 
 	"github.com/OpenIMSDK/protocol/sdkws"
 	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/pagination"
 	"github.com/OpenIMSDK/tools/utils"
 
 	table "github.com/openimsdk/open-im-server/v3/pkg/common/db/table/unrelation"
@@ -84,6 +82,29 @@ func (m *MsgMongoDriver) UpdateMsg(
 	return res, nil
 }
 
+// UpdateMsgMax only replaces the field when value is greater than the stored one.
+func (m *MsgMongoDriver) UpdateMsgMax(
+	ctx context.Context,
+	docID string,
+	index int64,
+	key string,
+	value any,
+) (*mongo.UpdateResult, error) {
+	var field string
+	if key == "" {
+		field = fmt.Sprintf("msgs.%d", index)
+	} else {
+		field = fmt.Sprintf("msgs.%d.%s", index, key)
+	}
+	filter := bson.M{"doc_id": docID}
+	update := bson.M{"$max": bson.M{field: value}}
+	res, err := m.MsgCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	return res, nil
+}
+
 // PushUnique value must slice.
 func (m *MsgMongoDriver) PushUnique(
 	ctx context.Context,
@@ -111,6 +132,85 @@ func (m *MsgMongoDriver) PushUnique(
 	return res, nil
 }
 
+// PullAll value must slice.
+func (m *MsgMongoDriver) PushReaction(
+	ctx context.Context,
+	docID string,
+	index int64,
+	emoji string,
+	userID string,
+	maxEmojis int,
+) (*mongo.UpdateResult, error) {
+	field := fmt.Sprintf("msgs.%d.reactions.%s", index, emoji)
+	filter := bson.M{"doc_id": docID}
+	if maxEmojis > 0 {
+		// either the emoji is there already or the msg has room for another one
+		reactions := bson.M{"$let": bson.M{
+			"vars": bson.M{"msg": bson.M{"$arrayElemAt": bson.A{"$msgs", index}}},
+			"in":   "$$msg.reactions",
+		}}
+		filter["$or"] = bson.A{
+			bson.M{field: bson.M{"$exists": true}},
+			bson.M{"$expr": bson.M{"$lt": bson.A{
+				bson.M{"$size": bson.M{"$objectToArray": bson.M{"$ifNull": bson.A{reactions, bson.M{}}}}},
+				maxEmojis,
+			}}},
+		}
+	}
+	update := bson.M{"$addToSet": bson.M{field: userID}}
+	res, err := m.MsgCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	return res, nil
+}
+
+func (m *MsgMongoDriver) PullReaction(
+	ctx context.Context,
+	docID string,
+	index int64,
+	emoji string,
+	userID string,
+) (*mongo.UpdateResult, error) {
+	field := fmt.Sprintf("msgs.%d.reactions.%s", index, emoji)
+	res, err := m.MsgCollection.UpdateOne(ctx, bson.M{"doc_id": docID}, bson.M{"$pull": bson.M{field: userID}})
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	// an emoji left without users still counts against the emoji limit of the msg
+	_, err = m.MsgCollection.UpdateOne(ctx, bson.M{"doc_id": docID, field: bson.M{"$size": 0}}, bson.M{"$unset": bson.M{field: ""}})
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	return res, nil
+}
+
+func (m *MsgMongoDriver) PullAll(
+	ctx context.Context,
+	docID string,
+	index int64,
+	key string,
+	value any,
+) (*mongo.UpdateResult, error) {
+	var field string
+	if key == "" {
+		field = fmt.Sprintf("msgs.%d", index)
+	} else {
+		field = fmt.Sprintf("msgs.%d.%s", index, key)
+	}
+	filter := bson.M{"doc_id": docID}
+	update := bson.M{
+		"$pullAll": bson.M{
+			field: value,
+		},
+	}
+	res, err := m.MsgCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	return res, nil
+}
+
 func (m *MsgMongoDriver) UpdateMsgContent(ctx context.Context, docID string, index int64, msg []byte) error {
 	_, err := m.MsgCollection.UpdateOne(
 		ctx,
@@ -236,6 +336,72 @@ func (m *MsgMongoDriver) DeleteDocs(ctx context.Context, docIDs []string) error
 	return err
 }
 
+// FindOneAndDeleteByDocID deletes the doc and returns it as it was at the moment of the delete.
+func (m *MsgMongoDriver) FindOneAndDeleteByDocID(ctx context.Context, docID string) (*table.MsgDocModel, error) {
+	doc := &table.MsgDocModel{}
+	if err := m.MsgCollection.FindOneAndDelete(ctx, bson.M{"doc_id": docID}).Decode(doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// MergeMsg merges info into the msg at index without overwriting what was written there already,
+// the msg and revoke are only set when missing, edits are put in front and the sets are joined.
+func (m *MsgMongoDriver) MergeMsg(ctx context.Context, docID string, index int64, info *table.MsgInfoModel) error {
+	prefix := fmt.Sprintf("msgs.%d", index)
+	res, err := m.MsgCollection.UpdateOne(ctx, bson.M{"doc_id": docID, prefix: nil}, bson.M{"$set": bson.M{prefix: info}})
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if res.MatchedCount > 0 {
+		return nil
+	}
+	if info.Msg != nil {
+		if _, err := m.MsgCollection.UpdateOne(ctx, bson.M{"doc_id": docID, prefix + ".msg": nil}, bson.M{"$set": bson.M{prefix + ".msg": info.Msg}}); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	if info.Revoke != nil {
+		if _, err := m.MsgCollection.UpdateOne(ctx, bson.M{"doc_id": docID, prefix + ".revoke": nil}, bson.M{"$set": bson.M{prefix + ".revoke": info.Revoke}}); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	update := bson.M{}
+	if len(info.Edits) > 0 {
+		update["$push"] = bson.M{prefix + ".edits": bson.M{"$each": info.Edits, "$position": 0}}
+	}
+	addToSet := bson.M{}
+	for emoji, userIDs := range info.Reactions {
+		addToSet[prefix+".reactions."+emoji] = bson.M{"$each": userIDs}
+	}
+	if len(info.ThreadUsers) > 0 {
+		addToSet[prefix+".thread_users"] = bson.M{"$each": info.ThreadUsers}
+	}
+	if len(info.DelList) > 0 {
+		addToSet[prefix+".del_list"] = bson.M{"$each": info.DelList}
+	}
+	if len(addToSet) > 0 {
+		update["$addToSet"] = addToSet
+	}
+	maxSet := bson.M{}
+	if info.Thread != nil {
+		maxSet[prefix+".thread"] = info.Thread
+	}
+	if info.IsRead {
+		maxSet[prefix+".is_read"] = true
+	}
+	if len(maxSet) > 0 {
+		update["$max"] = maxSet
+	}
+	if len(update) == 0 {
+		return nil
+	}
+	if _, err := m.MsgCollection.UpdateOne(ctx, bson.M{"doc_id": docID}, update); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
 func (m *MsgMongoDriver) GetMsgBySeqIndexIn1Doc(
 	ctx context.Context,
 	userID string,
@@ -270,7 +436,10 @@ func (m *MsgMongoDriver) GetMsgBySeqIndexIn1Doc(
 								{"in", bson.D{
 									{"$cond", bson.D{
 										{"if", bson.D{
-											{"$in", []string{userID, "$$currentMsg.del_list"}},
+											{"$or", bson.A{
+												bson.D{{"$in", []string{userID, "$$currentMsg.del_list"}}},
+												bson.D{{"$in", []string{table.DelListAllUsers, "$$currentMsg.del_list"}}},
+											}},
 										}},
 										{"then", nil},
 										{"else", "$$currentMsg"},
@@ -339,6 +508,169 @@ func (m *MsgMongoDriver) GetMsgBySeqIndexIn1Doc(
 	return msgs, nil
 }
 
+func (m *MsgMongoDriver) GetMsgReactionUsers(
+	ctx context.Context,
+	docID string,
+	index int64,
+	emoji string,
+	pagination pagination.Pagination,
+) (int64, []string, error) {
+	skip := int64(pagination.GetPageNumber()-1) * int64(pagination.GetShowNumber())
+	if skip < 0 || pagination.GetShowNumber() <= 0 {
+		return 0, nil, errs.ErrArgs.Wrap("pagination is invalid")
+	}
+	pipeline := mongo.Pipeline{
+		{
+			{"$match", bson.D{
+				{"doc_id", docID},
+			}},
+		},
+		{
+			{"$project", bson.D{
+				{"_id", 0},
+				{"msg", bson.D{
+					{"$arrayElemAt", bson.A{"$msgs", index}},
+				}},
+			}},
+		},
+		{
+			{"$project", bson.D{
+				{"users", bson.D{
+					{"$ifNull", bson.A{"$msg.reactions." + emoji, bson.A{}}},
+				}},
+			}},
+		},
+		{
+			{"$project", bson.D{
+				{"total", bson.D{
+					{"$size", "$users"},
+				}},
+				{"user_ids", bson.D{
+					{"$slice", bson.A{"$users", skip, pagination.GetShowNumber()}},
+				}},
+			}},
+		},
+	}
+	cur, err := m.MsgCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return 0, nil, errs.Wrap(err)
+	}
+	defer cur.Close(ctx)
+	var res []struct {
+		Total   int64    `bson:"total"`
+		UserIDs []string `bson:"user_ids"`
+	}
+	if err := cur.All(ctx, &res); err != nil {
+		return 0, nil, errs.Wrap(err)
+	}
+	if len(res) == 0 {
+		return 0, nil, nil
+	}
+	return res[0].Total, res[0].UserIDs, nil
+}
+
+// AddPollVote records the vote and increments the tally of the chosen options in one write, it does nothing if the user already voted.
+func (m *MsgMongoDriver) AddPollVote(
+	ctx context.Context,
+	docID string,
+	index int64,
+	vote *table.PollVoteModel,
+) (*mongo.UpdateResult, error) {
+	prefix := fmt.Sprintf("msgs.%d.", index)
+	filter := bson.M{
+		"doc_id":                      docID,
+		prefix + "poll_votes.user_id": bson.M{"$ne": vote.UserID},
+	}
+	inc := bson.M{}
+	for _, option := range vote.Options {
+		inc[prefix+"poll_tally."+strconv.Itoa(int(option))] = 1
+	}
+	update := bson.M{
+		"$push": bson.M{prefix + "poll_votes": vote},
+		"$inc":  inc,
+	}
+	res, err := m.MsgCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return res, nil
+}
+
+func (m *MsgMongoDriver) GetMsgPoll(ctx context.Context, docID string, index int64) (*table.MsgInfoModel, error) {
+	pipeline := mongo.Pipeline{
+		{
+			{"$match", bson.D{
+				{"doc_id", docID},
+			}},
+		},
+		{
+			{"$project", bson.D{
+				{"_id", 0},
+				{"msg", bson.D{
+					{"$arrayElemAt", bson.A{"$msgs", index}},
+				}},
+			}},
+		},
+		{
+			{"$project", bson.D{
+				{"poll_votes", "$msg.poll_votes"},
+				{"poll_tally", "$msg.poll_tally"},
+			}},
+		},
+	}
+	cur, err := m.MsgCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer cur.Close(ctx)
+	var res []*table.MsgInfoModel
+	if err := cur.All(ctx, &res); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if len(res) == 0 {
+		return &table.MsgInfoModel{}, nil
+	}
+	return res[0], nil
+}
+
+// GetMsgEdits returns the stored msg and its edits, the msg is nil when only the edits were written so far.
+func (m *MsgMongoDriver) GetMsgEdits(ctx context.Context, docID string, index int64) (*table.MsgInfoModel, error) {
+	pipeline := mongo.Pipeline{
+		{
+			{"$match", bson.D{
+				{"doc_id", docID},
+			}},
+		},
+		{
+			{"$project", bson.D{
+				{"_id", 0},
+				{"msg", bson.D{
+					{"$arrayElemAt", bson.A{"$msgs", index}},
+				}},
+			}},
+		},
+		{
+			{"$project", bson.D{
+				{"msg", "$msg.msg"},
+				{"edits", "$msg.edits"},
+			}},
+		},
+	}
+	cur, err := m.MsgCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer cur.Close(ctx)
+	var res []*table.MsgInfoModel
+	if err := cur.All(ctx, &res); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if len(res) == 0 {
+		return &table.MsgInfoModel{}, nil
+	}
+	return res[0], nil
+}
+
 func (m *MsgMongoDriver) IsExistDocID(ctx context.Context, docID string) (bool, error) {
 	count, err := m.MsgCollection.CountDocuments(ctx, bson.M{"doc_id": docID})
 	if err != nil {
@@ -1069,150 +1401,3 @@ func (m *MsgMongoDriver) RangeGroupSendCount(
 	}
 	return result[0].MsgCount, result[0].UserCount, groups, dateCount, nil
 }
-
-func (m *MsgMongoDriver) SearchMessage(ctx context.Context, req *msg.SearchMessageReq) (int32, []*table.MsgInfoModel, error) {
-	total, msgs, err := m.searchMessage(ctx, req)
-	if err != nil {
-		return 0, nil, err
-	}
-	return total, msgs, nil
-}
-
-func (m *MsgMongoDriver) searchMessage(ctx context.Context, req *msg.SearchMessageReq) (int32, []*table.MsgInfoModel, error) {
-	var pipe mongo.Pipeline
-	condition := bson.A{}
-	if req.SendTime != "" {
-		condition = append(condition, bson.M{"$eq": bson.A{bson.M{"$dateToString": bson.M{"format": "%Y-%m-%d", "date": bson.M{"$toDate": "$$item.msg.send_time"}}}, req.SendTime}})
-	}
-	if req.MsgType != 0 {
-		condition = append(condition, bson.M{"$eq": bson.A{"$$item.msg.content_type", req.MsgType}})
-	}
-	if req.SessionType != 0 {
-		condition = append(condition, bson.M{"$eq": bson.A{"$$item.msg.session_type", req.SessionType}})
-	}
-	if req.RecvID != "" {
-		condition = append(condition, bson.M{"$regexFind": bson.M{"input": "$$item.msg.recv_id", "regex": req.RecvID}})
-	}
-	if req.SendID != "" {
-		condition = append(condition, bson.M{"$regexFind": bson.M{"input": "$$item.msg.send_id", "regex": req.SendID}})
-	}
-
-	or := bson.A{
-		bson.M{
-			"doc_id": bson.M{
-				"$regex":   "^si_",
-				"$options": "i",
-			},
-		},
-	}
-	or = append(or,
-		bson.M{
-			"doc_id": bson.M{
-				"$regex":   "^g_",
-				"$options": "i",
-			},
-		},
-		bson.M{
-			"doc_id": bson.M{
-				"$regex":   "^sg_",
-				"$options": "i",
-			},
-		},
-	)
-
-	pipe = mongo.Pipeline{
-		{
-			{"$match", bson.D{
-				{
-					"$or", or,
-				},
-			}},
-		},
-		{
-			{"$project", bson.D{
-				{
-					"msgs", bson.D{
-						{
-							"$filter", bson.D{
-								{"input", "$msgs"},
-								{"as", "item"},
-								{
-									"cond", bson.D{
-										{"$and", condition},
-									},
-								},
-							},
-						},
-					},
-				},
-				{"doc_id", 1},
-			}},
-		},
-		{
-			{"$unwind", bson.M{"path": "$msgs"}},
-		},
-		{
-			{"$sort", bson.M{"msgs.msg.send_time": -1}},
-		},
-	}
-	cursor, err := m.MsgCollection.Aggregate(ctx, pipe)
-	if err != nil {
-		return 0, nil, err
-	}
-	type docModel struct {
-		DocID string              `bson:"doc_id"`
-		Msg   *table.MsgInfoModel `bson:"msgs"`
-	}
-	var msgsDocs []docModel
-	err = cursor.All(ctx, &msgsDocs)
-	if err != nil {
-		return 0, nil, err
-	}
-	log.ZDebug(ctx, "query mongoDB", "result", msgsDocs)
-	msgs := make([]*table.MsgInfoModel, 0)
-	for index := range msgsDocs {
-		msgInfo := msgsDocs[index].Msg
-		if msgInfo == nil || msgInfo.Msg == nil {
-			continue
-		}
-		if msgInfo.Revoke != nil {
-			revokeContent := sdkws.MessageRevokedContent{
-				RevokerID:                   msgInfo.Revoke.UserID,
-				RevokerRole:                 msgInfo.Revoke.Role,
-				ClientMsgID:                 msgInfo.Msg.ClientMsgID,
-				RevokerNickname:             msgInfo.Revoke.Nickname,
-				RevokeTime:                  msgInfo.Revoke.Time,
-				SourceMessageSendTime:       msgInfo.Msg.SendTime,
-				SourceMessageSendID:         msgInfo.Msg.SendID,
-				SourceMessageSenderNickname: msgInfo.Msg.SenderNickname,
-				SessionType:                 msgInfo.Msg.SessionType,
-				Seq:                         msgInfo.Msg.Seq,
-				Ex:                          msgInfo.Msg.Ex,
-			}
-			data, err := json.Marshal(&revokeContent)
-			if err != nil {
-				return 0, nil, err
-			}
-			elem := sdkws.NotificationElem{
-				Detail: string(data),
-			}
-			content, err := json.Marshal(&elem)
-			if err != nil {
-				return 0, nil, err
-			}
-			msgInfo.Msg.ContentType = constant.MsgRevokeNotification
-			msgInfo.Msg.Content = string(content)
-		}
-		msgs = append(msgs, msgInfo)
-	}
-	start := (req.Pagination.PageNumber - 1) * req.Pagination.ShowNumber
-	n := int32(len(msgs))
-	if start >= n {
-		return n, []*table.MsgInfoModel{}, nil
-	} else if start+req.Pagination.ShowNumber < n {
-		msgs = msgs[start : start+req.Pagination.ShowNumber]
-	} else {
-		msgs = msgs[start:]
-	}
-	return n, msgs, nil
-}
@@ -0,0 +1,118 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"strings"
+
+	"github.com/IBM/sarama"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+)
+
+// DrainTopic consumes every partition of topic from the committed offset of groupID up to the high water mark
+// at call time, handing each message to fn and committing it once fn succeeds.
+// It stops at the first error so the failed message is consumed again by the next drain.
+func DrainTopic(ctx context.Context, addrs []string, topic, groupID string, fn func(msg *sarama.ConsumerMessage) error) (int, error) {
+	cfg := sarama.NewConfig()
+	cfg.Version = sarama.V2_0_0_0
+	cfg.Consumer.Offsets.Initial = sarama.OffsetOldest
+	if config.Config.Kafka.Username != "" && config.Config.Kafka.Password != "" {
+		cfg.Net.SASL.Enable = true
+		cfg.Net.SASL.User = config.Config.Kafka.Username
+		cfg.Net.SASL.Password = config.Config.Kafka.Password
+	}
+	SetupTLSConfig(cfg)
+	client, err := sarama.NewClient(addrs, cfg)
+	if err != nil {
+		return 0, errs.Wrap(err, strings.Join(addrs, ","))
+	}
+	defer client.Close()
+	partitions, err := client.Partitions(topic)
+	if err != nil {
+		return 0, errs.Wrap(err, topic)
+	}
+	offsetManager, err := sarama.NewOffsetManagerFromClient(groupID, client)
+	if err != nil {
+		return 0, errs.Wrap(err, groupID)
+	}
+	defer offsetManager.Close()
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	defer consumer.Close()
+	var total int
+	for _, partition := range partitions {
+		n, err := drainPartition(ctx, client, consumer, offsetManager, topic, partition, fn)
+		total += n
+		offsetManager.Commit()
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+func drainPartition(ctx context.Context, client sarama.Client, consumer sarama.Consumer, offsetManager sarama.OffsetManager,
+	topic string, partition int32, fn func(msg *sarama.ConsumerMessage) error,
+) (int, error) {
+	pom, err := offsetManager.ManagePartition(topic, partition)
+	if err != nil {
+		return 0, errs.Wrap(err, topic)
+	}
+	defer pom.Close()
+	highWaterMark, err := client.GetOffset(topic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return 0, errs.Wrap(err, topic)
+	}
+	oldest, err := client.GetOffset(topic, partition, sarama.OffsetOldest)
+	if err != nil {
+		return 0, errs.Wrap(err, topic)
+	}
+	// the committed offset may have been removed by retention already
+	offset, _ := pom.NextOffset()
+	if offset < oldest {
+		offset = oldest
+	}
+	if offset >= highWaterMark {
+		return 0, nil
+	}
+	pc, err := consumer.ConsumePartition(topic, partition, offset)
+	if err != nil {
+		return 0, errs.Wrap(err, topic)
+	}
+	defer pc.Close()
+	var n int
+	for {
+		select {
+		case <-ctx.Done():
+			return n, errs.Wrap(ctx.Err())
+		case msg := <-pc.Messages():
+			if err := fn(msg); err != nil {
+				return n, err
+			}
+			pom.MarkOffset(msg.Offset+1, "")
+			n++
+			if msg.Offset+1 >= highWaterMark {
+				log.ZInfo(ctx, "drain partition done", "topic", topic, "partition", partition, "count", n)
+				return n, nil
+			}
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mq
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/kafka"
+)
+
+func newKafkaProducer(topic string) (MQProducer, error) {
+	producer, err := kafka.NewKafkaProducer(config.Config.Kafka.Addr, topic)
+	if err != nil {
+		return nil, err
+	}
+	return producer, nil
+}
+
+type kafkaConsumer struct {
+	consumerGroup *kafka.MConsumerGroup
+}
+
+func newKafkaConsumer(topics []string, groupID string) (MQConsumer, error) {
+	consumerGroup, err := kafka.NewMConsumerGroup(&kafka.MConsumerGroupConfig{
+		KafkaVersion:   sarama.V2_0_0_0,
+		OffsetsInitial: sarama.OffsetNewest, IsReturnErr: false,
+	}, topics, config.Config.Kafka.Addr, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaConsumer{consumerGroup: consumerGroup}, nil
+}
+
+func (c *kafkaConsumer) RegisterHandleAndConsumer(ctx context.Context, handler Handler) {
+	c.consumerGroup.RegisterHandleAndConsumer(ctx, kafkaHandler{handler: handler})
+}
+
+func (c *kafkaConsumer) Close() {
+	c.consumerGroup.Close()
+}
+
+// kafkaHandler adapts a Handler to sarama.
+type kafkaHandler struct {
+	handler Handler
+}
+
+func (kafkaHandler) Setup(_ sarama.ConsumerGroupSession) error   { return nil }
+func (kafkaHandler) Cleanup(_ sarama.ConsumerGroupSession) error { return nil }
+
+func (h kafkaHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	msgs := make(chan *Message)
+	go func() {
+		defer close(msgs)
+		for cMsg := range claim.Messages() {
+			select {
+			case msgs <- newKafkaMessage(cMsg):
+			case <-sess.Context().Done():
+				return
+			}
+		}
+	}()
+	return h.handler.ConsumeClaim(kafkaSession{sess: sess}, kafkaClaim{ConsumerGroupClaim: claim, msgs: msgs})
+}
+
+func newKafkaMessage(cMsg *sarama.ConsumerMessage) *Message {
+	headers := make([]Header, 0, len(cMsg.Headers))
+	for _, header := range cMsg.Headers {
+		headers = append(headers, Header{Key: string(header.Key), Value: string(header.Value)})
+	}
+	return &Message{
+		Topic:     cMsg.Topic,
+		Partition: cMsg.Partition,
+		Offset:    cMsg.Offset,
+		Key:       cMsg.Key,
+		Value:     cMsg.Value,
+		Headers:   headers,
+	}
+}
+
+type kafkaSession struct {
+	sess sarama.ConsumerGroupSession
+}
+
+func (s kafkaSession) Context() context.Context {
+	return s.sess.Context()
+}
+
+func (s kafkaSession) MarkMessage(msg *Message) {
+	s.sess.MarkOffset(msg.Topic, msg.Partition, msg.Offset+1, "")
+}
+
+type kafkaClaim struct {
+	sarama.ConsumerGroupClaim
+	msgs chan *Message
+}
+
+func (c kafkaClaim) Messages() <-chan *Message {
+	return c.msgs
+}
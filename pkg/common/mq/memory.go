@@ -0,0 +1,207 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mq
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"google.golang.org/protobuf/proto"
+)
+
+const memoryGroupBuffer = 1024
+
+var errEmptyMsg = errors.New("mq binary msg is empty")
+
+// memoryBroker connects the producers and consumers in the process, every consumer group of a topic
+// gets each message once. Messages sent before any group consumes the topic are dropped, like the
+// kafka consumers starting from the newest offset.
+type memoryBroker struct {
+	lock   sync.Mutex
+	topics map[string]*memoryTopic
+}
+
+type memoryTopic struct {
+	offset int64
+	groups map[string]chan *Message
+}
+
+var defaultMemoryBroker = &memoryBroker{topics: make(map[string]*memoryTopic)}
+
+func (b *memoryBroker) topic(topic string) *memoryTopic {
+	t, ok := b.topics[topic]
+	if !ok {
+		t = &memoryTopic{groups: make(map[string]chan *Message)}
+		b.topics[topic] = t
+	}
+	return t
+}
+
+func (b *memoryBroker) group(topic, groupID string) chan *Message {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	t := b.topic(topic)
+	msgs, ok := t.groups[groupID]
+	if !ok {
+		msgs = make(chan *Message, memoryGroupBuffer)
+		t.groups[groupID] = msgs
+	}
+	return msgs
+}
+
+func (b *memoryBroker) highWaterMark(topic string) int64 {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.topic(topic).offset
+}
+
+func (b *memoryBroker) publish(ctx context.Context, msg *Message) error {
+	b.lock.Lock()
+	t := b.topic(msg.Topic)
+	msg.Offset = t.offset
+	t.offset++
+	groups := make([]chan *Message, 0, len(t.groups))
+	for _, msgs := range t.groups {
+		groups = append(groups, msgs)
+	}
+	b.lock.Unlock()
+	for _, msgs := range groups {
+		select {
+		case msgs <- msg:
+		case <-ctx.Done():
+			return errs.Wrap(ctx.Err())
+		}
+	}
+	return nil
+}
+
+type memoryProducer struct {
+	broker *memoryBroker
+	topic  string
+}
+
+func newMemoryProducer(topic string) MQProducer {
+	return &memoryProducer{broker: defaultMemoryBroker, topic: topic}
+}
+
+func (p *memoryProducer) SendMessage(ctx context.Context, key string, msg proto.Message) (int32, int64, error) {
+	value, err := proto.Marshal(msg)
+	if err != nil {
+		return 0, 0, errs.Wrap(err, "memory mq proto Marshal err")
+	}
+	if len(key) == 0 || len(value) == 0 {
+		return 0, 0, errs.Wrap(errEmptyMsg)
+	}
+	headers, err := GetMQHeaderWithContext(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	m := &Message{Topic: p.topic, Key: []byte(key), Value: value, Headers: headers}
+	if err := p.broker.publish(ctx, m); err != nil {
+		return 0, 0, err
+	}
+	return 0, m.Offset, nil
+}
+
+type memoryConsumer struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	broker *memoryBroker
+	groups map[string]chan *Message
+}
+
+func newMemoryConsumer(topics []string, groupID string) MQConsumer {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &memoryConsumer{
+		ctx:    ctx,
+		cancel: cancel,
+		broker: defaultMemoryBroker,
+		groups: make(map[string]chan *Message),
+	}
+	// the groups are registered here so the messages sent before consuming starts are kept
+	for _, topic := range topics {
+		c.groups[topic] = c.broker.group(topic, groupID)
+	}
+	return c
+}
+
+func (c *memoryConsumer) RegisterHandleAndConsumer(ctx context.Context, handler Handler) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-c.ctx.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	sess := memorySession{ctx: ctx}
+	var wg sync.WaitGroup
+	for topic, group := range c.groups {
+		claim := &memoryClaim{topic: topic, broker: c.broker, msgs: make(chan *Message)}
+		wg.Add(2)
+		go func(group chan *Message) {
+			defer wg.Done()
+			defer close(claim.msgs)
+			for {
+				select {
+				case msg := <-group:
+					select {
+					case claim.msgs <- msg:
+					case <-ctx.Done():
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(group)
+		go func() {
+			defer wg.Done()
+			// like a kafka session, the first claim returning ends the session
+			defer cancel()
+			_ = handler.ConsumeClaim(sess, claim)
+		}()
+	}
+	wg.Wait()
+}
+
+func (c *memoryConsumer) Close() {
+	c.cancel()
+}
+
+type memorySession struct {
+	ctx context.Context
+}
+
+func (s memorySession) Context() context.Context {
+	return s.ctx
+}
+
+// MarkMessage does nothing, the memory mq does not keep the offsets.
+func (s memorySession) MarkMessage(_ *Message) {}
+
+type memoryClaim struct {
+	topic  string
+	broker *memoryBroker
+	msgs   chan *Message
+}
+
+func (c *memoryClaim) Topic() string              { return c.topic }
+func (c *memoryClaim) Partition() int32           { return 0 }
+func (c *memoryClaim) HighWaterMarkOffset() int64 { return c.broker.highWaterMark(c.topic) }
+func (c *memoryClaim) Messages() <-chan *Message  { return c.msgs }
@@ -0,0 +1,87 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mq
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/mcontext"
+	"google.golang.org/protobuf/proto"
+)
+
+type testHandler struct {
+	msgs chan *Message
+}
+
+func (h testHandler) ConsumeClaim(sess Session, claim Claim) error {
+	for msg := range claim.Messages() {
+		h.msgs <- msg
+		sess.MarkMessage(msg)
+	}
+	return nil
+}
+
+func TestMemoryMQ(t *testing.T) {
+	const topic = "testMemoryMQ"
+	producer := newMemoryProducer(topic)
+	groups := []string{"a", "b"}
+	handlers := make([]testHandler, len(groups))
+	for i, groupID := range groups {
+		consumer := newMemoryConsumer([]string{topic}, groupID)
+		defer consumer.Close()
+		handlers[i] = testHandler{msgs: make(chan *Message, 1)}
+		go consumer.RegisterHandleAndConsumer(context.Background(), handlers[i])
+	}
+	ctx := mcontext.WithMustInfoCtx([]string{"operationID", "opUserID", "1", "connID"})
+	if _, _, err := producer.SendMessage(ctx, "key", &sdkws.MsgData{ClientMsgID: "clientMsgID"}); err != nil {
+		t.Fatal(err)
+	}
+	// every group gets the message once
+	for i, groupID := range groups {
+		select {
+		case msg := <-handlers[i].msgs:
+			var data sdkws.MsgData
+			if err := proto.Unmarshal(msg.Value, &data); err != nil {
+				t.Fatal(err)
+			}
+			if string(msg.Key) != "key" || data.ClientMsgID != "clientMsgID" {
+				t.Errorf("group %s got key %s msg %v", groupID, msg.Key, &data)
+			}
+			if operationID := mcontext.GetOperationID(GetContextFromMsg(msg)); operationID != "operationID" {
+				t.Errorf("group %s operationID = %s", groupID, operationID)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("group %s got no message", groupID)
+		}
+	}
+}
+
+func TestMemoryMQClose(t *testing.T) {
+	consumer := newMemoryConsumer([]string{"testMemoryMQClose"}, "a")
+	done := make(chan struct{})
+	go func() {
+		consumer.RegisterHandleAndConsumer(context.Background(), testHandler{msgs: make(chan *Message)})
+		close(done)
+	}()
+	consumer.Close()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("consumer did not stop after Close")
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mq
+
+import (
+	"context"
+	"strings"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/mcontext"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+)
+
+const (
+	TypeKafka = "kafka"
+	TypeRedis = "redis"
+	// TypeMemory only connects producers and consumers in the same process, the openim services run as
+	// separate binaries so it is rejected by the constructors below and only used by tests.
+	TypeMemory = "memory"
+)
+
+// Header is a key value pair carried with a message, the headers carry the context of the producer.
+type Header struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Message is a message read from the MQ.
+type Message struct {
+	Topic     string
+	Partition int32
+	Offset    int64
+	Key       []byte
+	Value     []byte
+	Headers   []Header
+
+	id string // redis stream entry id
+}
+
+// Session is the consumer group session a claim belongs to.
+type Session interface {
+	Context() context.Context
+	// MarkMessage marks msg and every message before it in the same claim as consumed.
+	MarkMessage(msg *Message)
+}
+
+// Claim is the stream of messages of one partition assigned to a consumer.
+type Claim interface {
+	Topic() string
+	Partition() int32
+	HighWaterMarkOffset() int64
+	Messages() <-chan *Message
+}
+
+// Handler consumes the claims of a consumer group, ConsumeClaim returns once the claim is closed or the session is done.
+type Handler interface {
+	ConsumeClaim(sess Session, claim Claim) error
+}
+
+// MQProducer sends messages to one topic.
+type MQProducer interface {
+	SendMessage(ctx context.Context, key string, msg proto.Message) (int32, int64, error)
+}
+
+// MQConsumer consumes topics as a member of a consumer group.
+type MQConsumer interface {
+	// RegisterHandleAndConsumer blocks handing the claims to handler until ctx is done or Close is called.
+	RegisterHandleAndConsumer(ctx context.Context, handler Handler)
+	Close()
+}
+
+// Type returns the configured MQ backend.
+func Type() string {
+	if config.Config.Kafka.Type == "" {
+		return TypeKafka
+	}
+	return strings.ToLower(config.Config.Kafka.Type)
+}
+
+// ErrMemoryType is returned when the memory backend is configured, messages sent by one binary would never
+// reach the consumers running in the others.
+func ErrMemoryType() error {
+	return errs.ErrArgs.Wrap("mq type memory only works inside one process, use kafka or redis")
+}
+
+// NewMQProducer creates a producer of topic on the configured MQ backend.
+func NewMQProducer(topic string) (MQProducer, error) {
+	switch Type() {
+	case TypeKafka:
+		return newKafkaProducer(topic)
+	case TypeRedis:
+		return newRedisProducer(topic)
+	case TypeMemory:
+		return nil, ErrMemoryType()
+	default:
+		return nil, errs.ErrArgs.Wrap("unknown mq type " + config.Config.Kafka.Type)
+	}
+}
+
+// NewMQConsumer creates a consumer of topics in the consumer group groupID on the configured MQ backend.
+func NewMQConsumer(topics []string, groupID string) (MQConsumer, error) {
+	switch Type() {
+	case TypeKafka:
+		return newKafkaConsumer(topics, groupID)
+	case TypeRedis:
+		return newRedisConsumer(topics, groupID)
+	case TypeMemory:
+		return nil, ErrMemoryType()
+	default:
+		return nil, errs.ErrArgs.Wrap("unknown mq type " + config.Config.Kafka.Type)
+	}
+}
+
+// GetMQHeaderWithContext extracts the message headers from the context.
+func GetMQHeaderWithContext(ctx context.Context) ([]Header, error) {
+	operationID, opUserID, platform, connID, err := mcontext.GetCtxInfos(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return []Header{
+		{Key: constant.OperationID, Value: operationID},
+		{Key: constant.OpUserID, Value: opUserID},
+		{Key: constant.OpUserPlatform, Value: platform},
+		{Key: constant.ConnID, Value: connID},
+	}, nil
+}
+
+// GetContextFromMsg creates a context from the message headers.
+func GetContextFromMsg(msg *Message) context.Context {
+	values := make([]string, 0, len(msg.Headers))
+	for _, header := range msg.Headers {
+		values = append(values, header.Value)
+	}
+	return mcontext.WithMustInfoCtx(values)
+}
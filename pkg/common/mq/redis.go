@@ -0,0 +1,432 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+)
+
+const (
+	redisStreamReadCount = 100
+	redisStreamBlock     = time.Second
+	// redisStreamClaimInterval is how often a consumer looks for messages other consumers left unacknowledged.
+	redisStreamClaimInterval    = 30 * time.Second
+	defaultRedisStreamClaimIdle = 5 * time.Minute
+	// redisStreamTrimInterval is how often a producer trims a stream holding more than maxLen entries.
+	redisStreamTrimInterval = 10 * time.Second
+)
+
+type redisProducer struct {
+	rdb      redis.UniversalClient
+	topic    string
+	maxLen   int64
+	lastTrim int64
+}
+
+func newRedisProducer(topic string) (MQProducer, error) {
+	rdb, err := cache.NewRedis()
+	if err != nil {
+		return nil, err
+	}
+	return &redisProducer{rdb: rdb, topic: topic, maxLen: config.Config.Kafka.RedisStream.MaxLen}, nil
+}
+
+// SendMessage appends the message to the stream of the topic, redis streams have no partitions or offsets so both are 0.
+func (p *redisProducer) SendMessage(ctx context.Context, key string, msg proto.Message) (int32, int64, error) {
+	value, err := proto.Marshal(msg)
+	if err != nil {
+		return 0, 0, errs.Wrap(err, "redis mq proto Marshal err")
+	}
+	if len(key) == 0 || len(value) == 0 {
+		return 0, 0, errs.Wrap(errEmptyMsg)
+	}
+	headers, err := GetMQHeaderWithContext(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	headersJSON, err := json.Marshal(headers)
+	if err != nil {
+		return 0, 0, errs.Wrap(err)
+	}
+	args := &redis.XAddArgs{
+		Stream: p.topic,
+		Values: []any{"key", key, "value", value, "headers", headersJSON},
+	}
+	if err := p.rdb.XAdd(ctx, args).Err(); err != nil {
+		return 0, 0, errs.Wrap(err)
+	}
+	if p.maxLen > 0 {
+		now := time.Now().UnixMilli()
+		last := atomic.LoadInt64(&p.lastTrim)
+		if now-last >= redisStreamTrimInterval.Milliseconds() && atomic.CompareAndSwapInt64(&p.lastTrim, last, now) {
+			go func() {
+				if err := p.trim(context.Background()); err != nil {
+					log.ZWarn(ctx, "redis mq trim failed", err, "topic", p.topic)
+				}
+			}()
+		}
+	}
+	return 0, 0, nil
+}
+
+// trim removes the entries every consumer group acknowledged once the stream holds more than maxLen entries.
+// XADD MAXLEN would also drop the entries a slow group has not read yet, so a backlog may exceed maxLen.
+func (p *redisProducer) trim(ctx context.Context) error {
+	n, err := p.rdb.XLen(ctx, p.topic).Result()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if n <= p.maxLen {
+		return nil
+	}
+	groups, err := p.rdb.XInfoGroups(ctx, p.topic).Result()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	oldestPending := make(map[string]string)
+	for _, group := range groups {
+		if group.Pending == 0 {
+			continue
+		}
+		pending, err := p.rdb.XPending(ctx, p.topic, group.Name).Result()
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		oldestPending[group.Name] = pending.Lower
+	}
+	minID := redisStreamMinID(groups, oldestPending)
+	if minID == "" {
+		return nil
+	}
+	return errs.Wrap(p.rdb.XTrimMinIDApprox(ctx, p.topic, minID, 0).Err())
+}
+
+// redisStreamMinID returns the lowest entry id any group still needs, the oldest pending entry of a group or,
+// without pending entries, its last delivered one. It is empty when there is no group to keep entries for.
+func redisStreamMinID(groups []redis.XInfoGroup, oldestPending map[string]string) string {
+	var minID string
+	for _, group := range groups {
+		id := group.LastDeliveredID
+		if pending, ok := oldestPending[group.Name]; ok && compareRedisStreamID(pending, id) < 0 {
+			id = pending
+		}
+		if minID == "" || compareRedisStreamID(id, minID) < 0 {
+			minID = id
+		}
+	}
+	return minID
+}
+
+// compareRedisStreamID compares two stream entry ids of the form milliseconds-sequence.
+func compareRedisStreamID(a, b string) int {
+	parse := func(id string) (uint64, uint64) {
+		ms, seq, _ := strings.Cut(id, "-")
+		m, _ := strconv.ParseUint(ms, 10, 64)
+		s, _ := strconv.ParseUint(seq, 10, 64)
+		return m, s
+	}
+	am, as := parse(a)
+	bm, bs := parse(b)
+	switch {
+	case am != bm:
+		if am < bm {
+			return -1
+		}
+		return 1
+	case as != bs:
+		if as < bs {
+			return -1
+		}
+		return 1
+	default:
+		return 0
+	}
+}
+
+type redisConsumer struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	rdb       redis.UniversalClient
+	topics    []string
+	groupID   string
+	consumer  string
+	claimIdle time.Duration
+}
+
+func newRedisConsumer(topics []string, groupID string) (MQConsumer, error) {
+	rdb, err := cache.NewRedis()
+	if err != nil {
+		return nil, err
+	}
+	for _, topic := range topics {
+		err := rdb.XGroupCreateMkStream(context.Background(), topic, groupID, "$").Err()
+		if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			return nil, errs.Wrap(err, topic, groupID)
+		}
+	}
+	// instances on one host must not share a name, the messages a stopped instance left unacknowledged
+	// are claimed by the other consumers of the group once they were idle for claimIdle
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	claimIdle := time.Duration(config.Config.Kafka.RedisStream.ClaimIdle) * time.Second
+	if claimIdle <= 0 {
+		claimIdle = defaultRedisStreamClaimIdle
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &redisConsumer{
+		ctx:       ctx,
+		cancel:    cancel,
+		rdb:       rdb,
+		topics:    topics,
+		groupID:   groupID,
+		consumer:  hostname + "-" + strconv.Itoa(os.Getpid()),
+		claimIdle: claimIdle,
+	}, nil
+}
+
+func (c *redisConsumer) RegisterHandleAndConsumer(ctx context.Context, handler Handler) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-c.ctx.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	sess := &redisSession{ctx: ctx, rdb: c.rdb, groupID: c.groupID, claims: make(map[string]*redisClaim)}
+	for _, topic := range c.topics {
+		sess.claims[topic] = &redisClaim{topic: topic, msgs: make(chan *Message)}
+	}
+	var wg sync.WaitGroup
+	for _, claim := range sess.claims {
+		wg.Add(2)
+		go func(claim *redisClaim) {
+			defer wg.Done()
+			c.read(ctx, claim)
+		}(claim)
+		go func(claim *redisClaim) {
+			defer wg.Done()
+			defer cancel()
+			_ = handler.ConsumeClaim(sess, claim)
+		}(claim)
+	}
+	wg.Wait()
+}
+
+func (c *redisConsumer) Close() {
+	c.cancel()
+}
+
+// read reads the messages delivered to this consumer but not acknowledged first, then the new ones,
+// together with those other consumers left unacknowledged.
+func (c *redisConsumer) read(ctx context.Context, claim *redisClaim) {
+	defer close(claim.msgs)
+	lastID := "0"
+	var lastClaim time.Time
+	for ctx.Err() == nil {
+		if lastID == ">" && time.Since(lastClaim) >= redisStreamClaimInterval {
+			lastClaim = time.Now()
+			if !c.claimIdleMsgs(ctx, claim) {
+				return
+			}
+		}
+		streams, err := c.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    c.groupID,
+			Consumer: c.consumer,
+			Streams:  []string{claim.topic, lastID},
+			Count:    redisStreamReadCount,
+			Block:    redisStreamBlock,
+		}).Result()
+		if err != nil {
+			if errors.Is(err, redis.Nil) || ctx.Err() != nil {
+				continue
+			}
+			log.ZWarn(ctx, "redis mq read failed", err, "topic", claim.topic, "groupID", c.groupID)
+			select {
+			case <-ctx.Done():
+			case <-time.After(redisStreamBlock):
+			}
+			continue
+		}
+		var n int
+		for _, stream := range streams {
+			for _, entry := range stream.Messages {
+				n++
+				if lastID != ">" {
+					lastID = entry.ID
+				}
+				msg := claim.add(entry)
+				select {
+				case claim.msgs <- msg:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+		if n == 0 {
+			lastID = ">"
+		}
+	}
+}
+
+// claimIdleMsgs takes over and delivers the messages pending on other consumers for longer than claimIdle,
+// false when ctx was canceled while delivering.
+func (c *redisConsumer) claimIdleMsgs(ctx context.Context, claim *redisClaim) bool {
+	start := "0-0"
+	for {
+		entries, next, err := c.rdb.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+			Stream:   claim.topic,
+			Group:    c.groupID,
+			Consumer: c.consumer,
+			MinIdle:  c.claimIdle,
+			Start:    start,
+			Count:    redisStreamReadCount,
+		}).Result()
+		if err != nil {
+			if ctx.Err() == nil {
+				log.ZWarn(ctx, "redis mq claim failed", err, "topic", claim.topic, "groupID", c.groupID)
+			}
+			return ctx.Err() == nil
+		}
+		for _, entry := range entries {
+			// messages this consumer is still handling can be idle as well
+			if claim.has(entry.ID) {
+				continue
+			}
+			log.ZInfo(ctx, "redis mq message claimed", "topic", claim.topic, "groupID", c.groupID, "id", entry.ID)
+			msg := claim.add(entry)
+			select {
+			case claim.msgs <- msg:
+			case <-ctx.Done():
+				return false
+			}
+		}
+		if next == "0-0" || len(entries) == 0 {
+			return true
+		}
+		start = next
+	}
+}
+
+type redisSession struct {
+	ctx     context.Context
+	rdb     redis.UniversalClient
+	groupID string
+	claims  map[string]*redisClaim
+}
+
+func (s *redisSession) Context() context.Context {
+	return s.ctx
+}
+
+// MarkMessage acknowledges msg and every message delivered before it, like a kafka offset commit.
+func (s *redisSession) MarkMessage(msg *Message) {
+	claim, ok := s.claims[msg.Topic]
+	if !ok {
+		return
+	}
+	ids := claim.done(msg.id)
+	if len(ids) == 0 {
+		return
+	}
+	if err := s.rdb.XAck(s.ctx, msg.Topic, s.groupID, ids...).Err(); err != nil {
+		log.ZWarn(s.ctx, "redis mq ack failed", err, "topic", msg.Topic, "groupID", s.groupID, "ids", ids)
+	}
+}
+
+type redisClaim struct {
+	topic   string
+	msgs    chan *Message
+	lock    sync.Mutex
+	offset  int64
+	pending []string
+}
+
+func (c *redisClaim) add(entry redis.XMessage) *Message {
+	msg := &Message{Topic: c.topic, id: entry.ID}
+	if key, ok := entry.Values["key"].(string); ok {
+		msg.Key = []byte(key)
+	}
+	if value, ok := entry.Values["value"].(string); ok {
+		msg.Value = []byte(value)
+	}
+	if headers, ok := entry.Values["headers"].(string); ok {
+		if err := json.Unmarshal([]byte(headers), &msg.Headers); err != nil {
+			log.ZWarn(context.Background(), "redis mq headers invalid", err, "topic", c.topic, "id", entry.ID)
+		}
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	msg.Offset = c.offset
+	c.offset++
+	c.pending = append(c.pending, entry.ID)
+	return msg
+}
+
+// has reports whether id was delivered and not acknowledged yet.
+func (c *redisClaim) has(id string) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, pendingID := range c.pending {
+		if pendingID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// done removes the pending ids up to id and returns them.
+func (c *redisClaim) done(id string) []string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for i, pendingID := range c.pending {
+		if pendingID == id {
+			ids := c.pending[: i+1 : i+1]
+			c.pending = c.pending[i+1:]
+			return ids
+		}
+	}
+	return nil
+}
+
+func (c *redisClaim) Topic() string    { return c.topic }
+func (c *redisClaim) Partition() int32 { return 0 }
+
+func (c *redisClaim) HighWaterMarkOffset() int64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.offset
+}
+
+func (c *redisClaim) Messages() <-chan *Message { return c.msgs }
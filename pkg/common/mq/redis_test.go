@@ -0,0 +1,100 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mq
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/mcontext"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestRedisStreamMinID(t *testing.T) {
+	if id := redisStreamMinID(nil, nil); id != "" {
+		t.Fatalf("min id without groups %q", id)
+	}
+	groups := []redis.XInfoGroup{
+		{Name: "push", LastDeliveredID: "100-0"},
+		{Name: "transfer", LastDeliveredID: "50-0", Pending: 2},
+		{Name: "search", LastDeliveredID: "99-7"},
+	}
+	if id := redisStreamMinID(groups, map[string]string{"transfer": "20-3"}); id != "20-3" {
+		t.Fatalf("min id %q, want the oldest pending entry", id)
+	}
+	if id := redisStreamMinID(groups, nil); id != "50-0" {
+		t.Fatalf("min id %q, want the slowest last delivered entry", id)
+	}
+	if compareRedisStreamID("9-1", "10-0") >= 0 || compareRedisStreamID("10-2", "10-10") >= 0 || compareRedisStreamID("3-3", "3-3") != 0 {
+		t.Fatal("stream ids compared as strings")
+	}
+}
+
+// TestRedisStreamTrimKeepsBacklog needs a redis on the default address.
+func TestRedisStreamTrimKeepsBacklog(t *testing.T) {
+	const (
+		topic  = "testRedisStreamTrim"
+		group  = "slow"
+		maxLen = 10
+	)
+	ctx := mcontext.WithMustInfoCtx([]string{"operationID", "opUserID", "1", "connID"})
+	rdb := redis.NewClient(&redis.Options{})
+	defer rdb.Close()
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		t.Skip("redis not available:", err)
+	}
+	rdb.Del(ctx, topic)
+	defer rdb.Del(ctx, topic)
+	if err := rdb.XGroupCreateMkStream(ctx, topic, group, "$").Err(); err != nil {
+		t.Fatal(err)
+	}
+	p := &redisProducer{rdb: rdb, topic: topic, maxLen: maxLen}
+	for i := 0; i < 3*maxLen; i++ {
+		if _, _, err := p.SendMessage(ctx, strconv.Itoa(i), &sdkws.MsgData{Seq: int64(i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the group read and acknowledged 5 entries, and holds 5 more unacknowledged
+	read := func(count int64) []redis.XMessage {
+		streams, err := rdb.XReadGroup(ctx, &redis.XReadGroupArgs{Group: group, Consumer: "c", Streams: []string{topic, ">"}, Count: count}).Result()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return streams[0].Messages
+	}
+	acked := read(5)
+	for _, msg := range acked {
+		rdb.XAck(ctx, topic, group, msg.ID)
+	}
+	pending := read(5)
+	if err := p.trim(ctx); err != nil {
+		t.Fatal(err)
+	}
+	n, err := rdb.XLen(ctx, topic).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n < 3*maxLen-5 {
+		t.Fatalf("stream trimmed to %d entries, unacknowledged entries lost", n)
+	}
+	claimed, err := rdb.XRange(ctx, topic, pending[0].ID, pending[0].ID).Result()
+	if err != nil || len(claimed) != 1 {
+		t.Fatalf("pending entry trimmed, %v", err)
+	}
+	if rest := read(3 * maxLen); len(rest) != 3*maxLen-10 {
+		t.Fatalf("read %d unread entries after trim", len(rest))
+	}
+}
@@ -23,4 +23,13 @@ var (
 		Name: "online_user_num",
 		Help: "The number of online user num",
 	})
+	HeartbeatRTTHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "conn_heartbeat_rtt_seconds",
+		Help:    "The round trip time of the pings sent to connections",
+		Buckets: []float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5},
+	}, []string{"platform"})
+	HeartbeatTimeoutCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "conn_heartbeat_timeout_total",
+		Help: "The number of connections evicted for staying silent past their idle timeout",
+	})
 )
@@ -63,7 +63,7 @@ func TestGetGrpcCusMetrics(t *testing.T) {
 		name     string
 		expected int // The expected number of metrics for each case.
 	}{
-		{config2.Config.RpcRegisterName.OpenImMessageGatewayName, 1},
+		{config2.Config.RpcRegisterName.OpenImMessageGatewayName, 3},
 	}
 
 	for _, tc := range testCases {
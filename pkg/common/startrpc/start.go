@@ -46,6 +46,30 @@ import (
 	"github.com/OpenIMSDK/tools/network"
 )
 
+var (
+	shutdownLock  sync.Mutex
+	shutdownFuncs []func() error
+)
+
+// AddShutdown registers fn to run once the rpc server stopped serving requests,
+// for resources that in-flight requests may still be using while the server drains.
+func AddShutdown(fn func() error) {
+	shutdownLock.Lock()
+	defer shutdownLock.Unlock()
+	shutdownFuncs = append(shutdownFuncs, fn)
+}
+
+func runShutdown() {
+	shutdownLock.Lock()
+	defer shutdownLock.Unlock()
+	for i := len(shutdownFuncs) - 1; i >= 0; i-- {
+		if err := shutdownFuncs[i](); err != nil {
+			fmt.Fprintf(os.Stderr, "shutdown failed: %v\n", err)
+		}
+	}
+	shutdownFuncs = nil
+}
+
 // Start rpc server.
 func Start(
 	rpcPort int,
@@ -90,8 +114,12 @@ func Start(
 
 	srv := grpc.NewServer(options...)
 	once := sync.Once{}
+	stop := func() {
+		srv.GracefulStop()
+		runShutdown()
+	}
 	defer func() {
-		once.Do(srv.GracefulStop)
+		once.Do(stop)
 	}()
 
 	err = rpcFn(client, srv)
@@ -137,7 +165,7 @@ func Start(
 	)
 
 	go func() {
-		once.Do(srv.GracefulStop)
+		once.Do(stop)
 		gerr = wg.Wait()
 		close(done)
 	}()
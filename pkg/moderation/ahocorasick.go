@@ -0,0 +1,97 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moderation
+
+// Match is a dictionary word found in a text, Start and End are rune offsets of the occurrence.
+type Match struct {
+	Word  int
+	Start int
+	End   int
+}
+
+type acNode struct {
+	next map[rune]int
+	fail int
+	// indexes of the words ending at this node, including those reached through fail links
+	out []int
+}
+
+// Matcher finds all occurrences of a set of words in one pass with the Aho-Corasick automaton.
+type Matcher struct {
+	nodes []acNode
+	lens  []int
+}
+
+func NewMatcher(words []string) *Matcher {
+	m := &Matcher{nodes: []acNode{{next: map[rune]int{}}}, lens: make([]int, len(words))}
+	for i, word := range words {
+		cur := 0
+		for _, r := range word {
+			next, ok := m.nodes[cur].next[r]
+			if !ok {
+				next = len(m.nodes)
+				m.nodes = append(m.nodes, acNode{next: map[rune]int{}})
+				m.nodes[cur].next[r] = next
+			}
+			cur = next
+			m.lens[i]++
+		}
+		if cur != 0 {
+			m.nodes[cur].out = append(m.nodes[cur].out, i)
+		}
+	}
+	queue := make([]int, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[cur].next {
+			fail := m.nodes[cur].fail
+			for fail != 0 {
+				if _, ok := m.nodes[fail].next[r]; ok {
+					break
+				}
+				fail = m.nodes[fail].fail
+			}
+			if next, ok := m.nodes[fail].next[r]; ok && next != child {
+				m.nodes[child].fail = next
+			}
+			m.nodes[child].out = append(m.nodes[child].out, m.nodes[m.nodes[child].fail].out...)
+			queue = append(queue, child)
+		}
+	}
+	return m
+}
+
+// FindAll returns every occurrence of the words in text, overlapping ones included, ordered by end offset.
+func (m *Matcher) FindAll(text []rune) []Match {
+	var matches []Match
+	cur := 0
+	for i, r := range text {
+		for cur != 0 {
+			if _, ok := m.nodes[cur].next[r]; ok {
+				break
+			}
+			cur = m.nodes[cur].fail
+		}
+		cur = m.nodes[cur].next[r]
+		for _, word := range m.nodes[cur].out {
+			matches = append(matches, Match{Word: word, Start: i + 1 - m.lens[word], End: i + 1})
+		}
+	}
+	return matches
+}
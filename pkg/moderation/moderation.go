@@ -0,0 +1,115 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moderation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"unicode"
+)
+
+const (
+	ActionReject = "reject" // the message is not sent
+	ActionMask   = "mask"   // the word is replaced with asterisks
+	ActionFlag   = "flag"   // the message is sent and recorded for review
+)
+
+// Result is the outcome of checking a text against the dictionary.
+type Result struct {
+	Reject  bool
+	Flagged bool
+	// Text is the checked text with the words of mask rules replaced, equal to the input when nothing is masked.
+	Text string
+	// Words are the distinct dictionary words found in the text.
+	Words []string
+}
+
+type dictionary struct {
+	words   []string
+	actions []string
+	matcher *Matcher
+}
+
+// Filter checks texts against a keyword dictionary that can be replaced while in use. Matching ignores case.
+type Filter struct {
+	dict atomic.Pointer[dictionary]
+}
+
+func NewFilter() *Filter {
+	f := &Filter{}
+	f.dict.Store(&dictionary{matcher: NewMatcher(nil)})
+	return f
+}
+
+// Load replaces the dictionary, rules maps each word to one of ActionReject, ActionMask and ActionFlag.
+func (f *Filter) Load(rules map[string]string) error {
+	dict := &dictionary{words: make([]string, 0, len(rules)), actions: make([]string, 0, len(rules))}
+	for word, action := range rules {
+		switch action {
+		case ActionReject, ActionMask, ActionFlag:
+		default:
+			return fmt.Errorf("unknown moderation action %q for word %q", action, word)
+		}
+		word = strings.ToLower(strings.TrimSpace(word))
+		if word == "" {
+			continue
+		}
+		dict.words = append(dict.words, word)
+		dict.actions = append(dict.actions, action)
+	}
+	dict.matcher = NewMatcher(dict.words)
+	f.dict.Store(dict)
+	return nil
+}
+
+func (f *Filter) Check(text string) *Result {
+	dict := f.dict.Load()
+	runes := []rune(text)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+	res := &Result{Text: text}
+	matches := dict.matcher.FindAll(lower)
+	if len(matches) == 0 {
+		return res
+	}
+	var masked bool
+	seen := make(map[int]struct{})
+	for _, match := range matches {
+		if _, ok := seen[match.Word]; !ok {
+			seen[match.Word] = struct{}{}
+			res.Words = append(res.Words, dict.words[match.Word])
+		}
+		switch dict.actions[match.Word] {
+		case ActionReject:
+			res.Reject = true
+		case ActionFlag:
+			res.Flagged = true
+		case ActionMask:
+			for i := match.Start; i < match.End; i++ {
+				runes[i] = '*'
+			}
+			masked = true
+		}
+	}
+	sort.Strings(res.Words)
+	if masked {
+		res.Text = string(runes)
+	}
+	return res
+}
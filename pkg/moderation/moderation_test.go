@@ -0,0 +1,60 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moderation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMatcherFindAll(t *testing.T) {
+	m := NewMatcher([]string{"he", "she", "his", "hers"})
+	got := m.FindAll([]rune("ushers"))
+	want := []Match{
+		{Word: 1, Start: 1, End: 4},
+		{Word: 0, Start: 2, End: 4},
+		{Word: 3, Start: 2, End: 6},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FindAll() = %v, want %v", got, want)
+	}
+}
+
+func TestFilterCheck(t *testing.T) {
+	f := NewFilter()
+	if err := f.Load(map[string]string{"bad": ActionMask, "坏蛋": ActionMask, "spam": ActionFlag, "kill": ActionReject}); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		text string
+		want Result
+	}{
+		{name: "clean", text: "hello", want: Result{Text: "hello"}},
+		{name: "mask", text: "so BAD, 你个坏蛋", want: Result{Text: "so ***, 你个**", Words: []string{"bad", "坏蛋"}}},
+		{name: "flag", text: "Spam bad", want: Result{Flagged: true, Text: "Spam ***", Words: []string{"bad", "spam"}}},
+		{name: "reject", text: "kill", want: Result{Reject: true, Text: "kill", Words: []string{"kill"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := f.Check(tt.text); !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("Check() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+	if err := f.Load(map[string]string{"bad": "drop"}); err == nil {
+		t.Error("Load() accepted an unknown action")
+	}
+}
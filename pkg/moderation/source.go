@@ -0,0 +1,107 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moderation
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/redis/go-redis/v9"
+	"gopkg.in/yaml.v3"
+)
+
+// Source provides the dictionary rules, word to action.
+type Source interface {
+	Load(ctx context.Context) (map[string]string, error)
+}
+
+// NewFileSource reads the rules from a yaml file mapping each word to its action.
+func NewFileSource(path string) Source {
+	return fileSource(path)
+}
+
+type fileSource string
+
+func (f fileSource) Load(context.Context) (map[string]string, error) {
+	data, err := os.ReadFile(string(f))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	rules := make(map[string]string)
+	if err := yaml.Unmarshal(data, &rules); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return rules, nil
+}
+
+// NewRedisSource reads the rules from a redis hash, the fields are the words and the values their actions.
+func NewRedisSource(rdb redis.UniversalClient, key string) Source {
+	return &redisSource{rdb: rdb, key: key}
+}
+
+type redisSource struct {
+	rdb redis.UniversalClient
+	key string
+}
+
+func (r *redisSource) Load(ctx context.Context) (map[string]string, error) {
+	rules, err := r.rdb.HGetAll(ctx, r.key).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return rules, nil
+}
+
+// Watch loads the rules from src into the filter, then reloads them every interval until ctx is done.
+// Only the first load returns its error, later failures are logged and keep the current dictionary.
+func (f *Filter) Watch(ctx context.Context, src Source, interval time.Duration) error {
+	rules, err := src.Load(ctx)
+	if err != nil {
+		return err
+	}
+	if err := f.Load(rules); err != nil {
+		return err
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			newRules, err := src.Load(ctx)
+			if err != nil {
+				log.ZWarn(ctx, "load moderation dictionary failed", err)
+				continue
+			}
+			if reflect.DeepEqual(rules, newRules) {
+				continue
+			}
+			if err := f.Load(newRules); err != nil {
+				log.ZWarn(ctx, "invalid moderation dictionary", err)
+				continue
+			}
+			rules = newRules
+			log.ZInfo(ctx, "moderation dictionary reloaded", "words", len(rules))
+		}
+	}()
+	return nil
+}
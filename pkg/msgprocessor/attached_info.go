@@ -0,0 +1,64 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgprocessor
+
+import "encoding/json"
+
+// GetAttachedInfo decodes the value of key in the MsgData.AttachedInfo json object into v.
+func GetAttachedInfo(attachedInfo string, key string, v any) bool {
+	if attachedInfo == "" {
+		return false
+	}
+	var info map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(attachedInfo), &info); err != nil {
+		return false
+	}
+	data, ok := info[key]
+	if !ok {
+		return false
+	}
+	return json.Unmarshal(data, v) == nil
+}
+
+// SetAttachedInfo sets key in the MsgData.AttachedInfo json object and keeps the other keys, a nil v removes key.
+// attachedInfo that is not a json object is returned unchanged.
+func SetAttachedInfo(attachedInfo string, key string, v any) string {
+	info := make(map[string]json.RawMessage)
+	if attachedInfo != "" {
+		if err := json.Unmarshal([]byte(attachedInfo), &info); err != nil {
+			return attachedInfo
+		}
+	}
+	if v == nil {
+		if _, ok := info[key]; !ok {
+			return attachedInfo
+		}
+		delete(info, key)
+	} else {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return attachedInfo
+		}
+		info[key] = data
+	}
+	if len(info) == 0 {
+		return ""
+	}
+	data, err := json.Marshal(info)
+	if err != nil {
+		return attachedInfo
+	}
+	return string(data)
+}
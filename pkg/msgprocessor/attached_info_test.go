@@ -0,0 +1,52 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgprocessor
+
+import "testing"
+
+func TestSetReactions(t *testing.T) {
+	reactions := []*ReactionSummary{
+		{Emoji: "a", Count: 1, UserIDs: []string{"u1"}},
+		{Emoji: "b", Count: 2, UserIDs: []string{"u1", "u2"}},
+		{Emoji: "c", Count: 0},
+	}
+	info := SetReactions(`{"isPrivateChat":true}`, reactions)
+	got := GetReactions(info)
+	if len(got) != 2 || got[0].Emoji != "b" || got[1].Emoji != "a" {
+		t.Fatalf("GetReactions() = %s", info)
+	}
+	if info = SetReactions(info, nil); info != `{"isPrivateChat":true}` {
+		t.Fatalf("SetReactions(nil) = %s", info)
+	}
+	if info = SetReactions("", nil); info != "" {
+		t.Fatalf("SetReactions(empty) = %s", info)
+	}
+	if info = SetReactions("not json", reactions); info != "not json" {
+		t.Fatalf("SetReactions(invalid) = %s", info)
+	}
+}
+
+func TestParseThreadConversationID(t *testing.T) {
+	threadConversationID := GetThreadConversationID("sg_group_1", 42)
+	conversationID, rootSeq, ok := ParseThreadConversationID(threadConversationID)
+	if !ok || conversationID != "sg_group_1" || rootSeq != 42 {
+		t.Fatalf("ParseThreadConversationID(%s) = %s %d %v", threadConversationID, conversationID, rootSeq, ok)
+	}
+	for _, id := range []string{"sg_group_1", "th_sg_group", "th__1", "th_sg_group_0"} {
+		if _, _, ok := ParseThreadConversationID(id); ok {
+			t.Fatalf("ParseThreadConversationID(%s) should fail", id)
+		}
+	}
+}
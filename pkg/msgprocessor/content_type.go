@@ -0,0 +1,38 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgprocessor
+
+// Content types of messages in addition to the ones defined by the protocol.
+const (
+	// Poll is a poll whose votes are counted by the server, the content is a PollContent.
+	Poll = 150
+)
+
+// Content types of notifications sent by this server in addition to the ones defined by the protocol.
+const (
+	MsgEditNotification        = 2103
+	MsgReactionNotification    = 2104
+	MsgThreadReplyNotification = 2105
+	MsgPinNotification         = 2106
+	MsgPollVoteNotification    = 2107
+
+	NotificationPreferenceSetNotification = 2108
+)
+
+// Option keys set on MsgData.Options in addition to the ones defined by the protocol.
+const (
+	// IsEdited marks a pulled message whose content was replaced by an edit.
+	IsEdited = "edited"
+)
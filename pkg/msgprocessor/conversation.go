@@ -48,6 +48,12 @@ func GetChatConversationIDByMsg(msg *sdkws.MsgData) string {
 	case constant.GroupChatType:
 		return "g_" + msg.GroupID
 	case constant.SuperGroupChatType:
+		// a reply is only routed to a thread of its own group
+		if reply := GetThreadReply(msg.AttachedInfo); reply != nil {
+			if conversationID, _, ok := ParseThreadConversationID(reply.ThreadConversationID); ok && conversationID == "sg_"+msg.GroupID {
+				return reply.ThreadConversationID
+			}
+		}
 		return "sg_" + msg.GroupID
 	case constant.NotificationChatType:
 		return "sn_" + msg.SendID + "_" + msg.RecvID
@@ -17,6 +17,7 @@ package msgprocessor
 import (
 	"testing"
 
+	"github.com/OpenIMSDK/protocol/constant"
 	"github.com/OpenIMSDK/protocol/sdkws"
 	"google.golang.org/protobuf/proto"
 )
@@ -50,7 +51,23 @@ func TestGetChatConversationIDByMsg(t *testing.T) {
 		args args
 		want string
 	}{
-		// TODO: Add test cases.
+		{
+			name: "super group",
+			args: args{msg: &sdkws.MsgData{SessionType: constant.SuperGroupChatType, GroupID: "g1"}},
+			want: "sg_g1",
+		},
+		{
+			name: "thread reply",
+			args: args{msg: &sdkws.MsgData{SessionType: constant.SuperGroupChatType, GroupID: "g1",
+				AttachedInfo: SetThreadReply("", &ThreadReply{ThreadConversationID: GetThreadConversationID("sg_g1", 7)})}},
+			want: "th_sg_g1_7",
+		},
+		{
+			name: "thread reply of another group",
+			args: args{msg: &sdkws.MsgData{SessionType: constant.SuperGroupChatType, GroupID: "g1",
+				AttachedInfo: SetThreadReply("", &ThreadReply{ThreadConversationID: GetThreadConversationID("sg_g2", 7)})}},
+			want: "sg_g1",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,28 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgprocessor
+
+// Delivery statuses of a msg to one recipient, recorded by the push service.
+const (
+	// MsgDeliveryOnline is a msg pushed to at least one online connection of the recipient.
+	MsgDeliveryOnline = 1
+	// MsgDeliveryOffline is a msg the recipient was not online for and got no offline push for,
+	// it is delivered when the recipient syncs.
+	MsgDeliveryOffline = 2
+	// MsgDeliveryOfflinePushed is a msg handed to the offline push provider.
+	MsgDeliveryOfflinePushed = 3
+	// MsgDeliveryOfflinePushFailed is a msg whose offline push failed, it may still be retried.
+	MsgDeliveryOfflinePushFailed = 4
+)
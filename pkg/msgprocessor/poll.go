@@ -0,0 +1,109 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgprocessor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const maxPollOptions = 20
+
+// AttachedPollKey is the key of the poll result in the MsgData.AttachedInfo json object of a poll message.
+const AttachedPollKey = "poll"
+
+type PollOption struct {
+	Text string `json:"text"`
+}
+
+// PollContent is the content of a Poll message, it is validated when the poll is sent and never changes after.
+// Deadline is a unix timestamp in milliseconds, 0 means the poll never closes.
+type PollContent struct {
+	Question  string        `json:"question"`
+	Options   []*PollOption `json:"options"`
+	Multiple  bool          `json:"multiple"`
+	Anonymous bool          `json:"anonymous"`
+	Deadline  int64         `json:"deadline"`
+}
+
+// PollResult is the tally of a poll, Counts is indexed by option.
+type PollResult struct {
+	Counts     []int64 `json:"counts"`
+	VoterCount int64   `json:"voterCount"`
+}
+
+func ParsePollContent(content []byte) (*PollContent, error) {
+	var poll PollContent
+	if err := json.Unmarshal(content, &poll); err != nil {
+		return nil, errors.New("poll content is not valid json")
+	}
+	if strings.TrimSpace(poll.Question) == "" {
+		return nil, errors.New("poll question is empty")
+	}
+	if len(poll.Options) < 2 || len(poll.Options) > maxPollOptions {
+		return nil, fmt.Errorf("poll must have between 2 and %d options", maxPollOptions)
+	}
+	for _, option := range poll.Options {
+		if option == nil || strings.TrimSpace(option.Text) == "" {
+			return nil, errors.New("poll option text is empty")
+		}
+	}
+	if poll.Deadline < 0 {
+		return nil, errors.New("poll deadline is invalid")
+	}
+	return &poll, nil
+}
+
+// IsClosed reports whether the poll no longer accepts votes at now (unix milliseconds).
+func (p *PollContent) IsClosed(now int64) bool {
+	return p.Deadline > 0 && now >= p.Deadline
+}
+
+// CheckVote checks that optionIndexes is a valid ballot for the poll.
+func (p *PollContent) CheckVote(optionIndexes []int32) error {
+	if len(optionIndexes) == 0 {
+		return errors.New("no option is chosen")
+	}
+	if !p.Multiple && len(optionIndexes) > 1 {
+		return errors.New("only one option can be chosen")
+	}
+	chosen := make(map[int32]struct{}, len(optionIndexes))
+	for _, index := range optionIndexes {
+		if index < 0 || int(index) >= len(p.Options) {
+			return errors.New("option index is out of range")
+		}
+		if _, ok := chosen[index]; ok {
+			return errors.New("option is chosen more than once")
+		}
+		chosen[index] = struct{}{}
+	}
+	return nil
+}
+
+// GetPollResult reads the poll result from attachedInfo.
+func GetPollResult(attachedInfo string) *PollResult {
+	var result PollResult
+	if !GetAttachedInfo(attachedInfo, AttachedPollKey, &result) {
+		return nil
+	}
+	return &result
+}
+
+// SetPollResult writes the poll result into attachedInfo.
+func SetPollResult(attachedInfo string, result *PollResult) string {
+	return SetAttachedInfo(attachedInfo, AttachedPollKey, result)
+}
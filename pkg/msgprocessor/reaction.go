@@ -0,0 +1,60 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgprocessor
+
+import "sort"
+
+// AttachedReactionsKey is the key of the reaction summary in the MsgData.AttachedInfo json object.
+const AttachedReactionsKey = "reactions"
+
+// ReactionSummary is the aggregated state of one emoji on a message.
+// UserIDs holds the first reacting users only, the full list is paged through the reaction api.
+type ReactionSummary struct {
+	Emoji   string   `json:"emoji"`
+	Count   int64    `json:"count"`
+	UserIDs []string `json:"userIDs"`
+}
+
+// SortReactions orders reactions by count desc, then by emoji.
+func SortReactions(reactions []*ReactionSummary) {
+	sort.SliceStable(reactions, func(i, j int) bool {
+		if reactions[i].Count != reactions[j].Count {
+			return reactions[i].Count > reactions[j].Count
+		}
+		return reactions[i].Emoji < reactions[j].Emoji
+	})
+}
+
+// GetReactions reads the reaction summary from attachedInfo.
+func GetReactions(attachedInfo string) []*ReactionSummary {
+	var reactions []*ReactionSummary
+	GetAttachedInfo(attachedInfo, AttachedReactionsKey, &reactions)
+	return reactions
+}
+
+// SetReactions writes the reaction summary into attachedInfo, reactions without users are dropped.
+func SetReactions(attachedInfo string, reactions []*ReactionSummary) string {
+	list := make([]*ReactionSummary, 0, len(reactions))
+	for _, reaction := range reactions {
+		if reaction.Count > 0 {
+			list = append(list, reaction)
+		}
+	}
+	if len(list) == 0 {
+		return SetAttachedInfo(attachedInfo, AttachedReactionsKey, nil)
+	}
+	SortReactions(list)
+	return SetAttachedInfo(attachedInfo, AttachedReactionsKey, list)
+}
@@ -0,0 +1,102 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgprocessor
+
+import (
+	"strconv"
+	"strings"
+)
+
+const threadConversationPrefix = "th_"
+
+// AttachedThreadKey is the key of the thread summary in the MsgData.AttachedInfo json object of a root message.
+const AttachedThreadKey = "thread"
+
+// AttachedThreadReplyKey is the key of the ThreadReply in the MsgData.AttachedInfo json object of a reply,
+// it routes the reply to the thread conversation in msgtransfer.
+const AttachedThreadReplyKey = "threadReply"
+
+// ThreadReply marks a message as a reply sent to a thread.
+type ThreadReply struct {
+	ThreadConversationID string `json:"threadConversationID"`
+	RootSendID           string `json:"rootSendID"`
+}
+
+// ThreadSummary is the state of the thread started from a root message.
+// ParticipantUserIDs holds the first participants only.
+type ThreadSummary struct {
+	ThreadConversationID string   `json:"threadConversationID"`
+	ReplyCount           int64    `json:"replyCount"`
+	LastReplySendID      string   `json:"lastReplySendID"`
+	LastReplyTime        int64    `json:"lastReplyTime"`
+	ParticipantUserIDs   []string `json:"participantUserIDs"`
+}
+
+// GetThreadConversationID returns the conversation holding the replies to rootSeq, it has its own seq space.
+func GetThreadConversationID(conversationID string, rootSeq int64) string {
+	return threadConversationPrefix + conversationID + "_" + strconv.FormatInt(rootSeq, 10)
+}
+
+func IsThread(conversationID string) bool {
+	return strings.HasPrefix(conversationID, threadConversationPrefix)
+}
+
+// ParseThreadConversationID returns the conversation and the root seq of a thread conversation.
+func ParseThreadConversationID(threadConversationID string) (conversationID string, rootSeq int64, ok bool) {
+	if !IsThread(threadConversationID) {
+		return "", 0, false
+	}
+	s := strings.TrimPrefix(threadConversationID, threadConversationPrefix)
+	i := strings.LastIndexByte(s, '_')
+	if i <= 0 {
+		return "", 0, false
+	}
+	rootSeq, err := strconv.ParseInt(s[i+1:], 10, 64)
+	if err != nil || rootSeq <= 0 {
+		return "", 0, false
+	}
+	return s[:i], rootSeq, true
+}
+
+// GetThread reads the thread summary from attachedInfo.
+func GetThread(attachedInfo string) *ThreadSummary {
+	var thread ThreadSummary
+	if !GetAttachedInfo(attachedInfo, AttachedThreadKey, &thread) {
+		return nil
+	}
+	return &thread
+}
+
+// SetThread writes the thread summary into attachedInfo.
+func SetThread(attachedInfo string, thread *ThreadSummary) string {
+	return SetAttachedInfo(attachedInfo, AttachedThreadKey, thread)
+}
+
+// GetThreadReply reads the thread reply mark from attachedInfo.
+func GetThreadReply(attachedInfo string) *ThreadReply {
+	var reply ThreadReply
+	if !GetAttachedInfo(attachedInfo, AttachedThreadReplyKey, &reply) || reply.ThreadConversationID == "" {
+		return nil
+	}
+	return &reply
+}
+
+// SetThreadReply writes the thread reply mark into attachedInfo, a nil reply removes it.
+func SetThreadReply(attachedInfo string, reply *ThreadReply) string {
+	if reply == nil {
+		return SetAttachedInfo(attachedInfo, AttachedThreadReplyKey, nil)
+	}
+	return SetAttachedInfo(attachedInfo, AttachedThreadReplyKey, reply)
+}
@@ -0,0 +1,23 @@
+# Copyright © 2023 OpenIM. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# protos in this directory import sdkws/msg from github.com/OpenIMSDK/protocol
+PROTOCOL_DIR=$(go list -m -f '{{.Dir}}' github.com/OpenIMSDK/protocol)
+
+protoc -I . -I "${PROTOCOL_DIR}" --go_out=plugins=grpc:./msgext --go_opt=module=github.com/openimsdk/open-im-server/v3/pkg/proto/msgext msgext/msgext.proto
+protoc -I . --go_out=./gateway --go_opt=module=github.com/openimsdk/open-im-server/v3/pkg/proto/gateway gateway/gateway.proto
+protoc -I . --go_out=plugins=grpc:./thirdext --go_opt=module=github.com/openimsdk/open-im-server/v3/pkg/proto/thirdext thirdext/thirdext.proto
+protoc -I . -I "${PROTOCOL_DIR}" --go_out=plugins=grpc:./pushext --go_opt=module=github.com/openimsdk/open-im-server/v3/pkg/proto/pushext pushext/pushext.proto
+protoc -I . --go_out=plugins=grpc:./userext --go_opt=module=github.com/openimsdk/open-im-server/v3/pkg/proto/userext userext/userext.proto
+protoc -I . --go_out=plugins=grpc:./groupext --go_opt=module=github.com/openimsdk/open-im-server/v3/pkg/proto/groupext groupext/groupext.proto
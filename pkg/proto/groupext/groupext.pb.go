@@ -0,0 +1,309 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.22.0
+// source: groupext/groupext.proto
+
+package groupext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VerifyGroupAdminReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID string `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+}
+
+func (x *VerifyGroupAdminReq) Reset() {
+	*x = VerifyGroupAdminReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyGroupAdminReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyGroupAdminReq) ProtoMessage() {}
+
+func (x *VerifyGroupAdminReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyGroupAdminReq.ProtoReflect.Descriptor instead.
+func (*VerifyGroupAdminReq) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VerifyGroupAdminReq) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+type VerifyGroupAdminResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *VerifyGroupAdminResp) Reset() {
+	*x = VerifyGroupAdminResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_groupext_groupext_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyGroupAdminResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyGroupAdminResp) ProtoMessage() {}
+
+func (x *VerifyGroupAdminResp) ProtoReflect() protoreflect.Message {
+	mi := &file_groupext_groupext_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyGroupAdminResp.ProtoReflect.Descriptor instead.
+func (*VerifyGroupAdminResp) Descriptor() ([]byte, []int) {
+	return file_groupext_groupext_proto_rawDescGZIP(), []int{1}
+}
+
+var File_groupext_groupext_proto protoreflect.FileDescriptor
+
+var file_groupext_groupext_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74,
+	0x22, 0x2f, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x22, 0x16, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x32, 0x77, 0x0a, 0x08, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x45, 0x78, 0x74, 0x12, 0x6b, 0x0a, 0x10, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x2a, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78,
+	0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x2e, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d,
+	0x69, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x78, 0x74, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_groupext_groupext_proto_rawDescOnce sync.Once
+	file_groupext_groupext_proto_rawDescData = file_groupext_groupext_proto_rawDesc
+)
+
+func file_groupext_groupext_proto_rawDescGZIP() []byte {
+	file_groupext_groupext_proto_rawDescOnce.Do(func() {
+		file_groupext_groupext_proto_rawDescData = protoimpl.X.CompressGZIP(file_groupext_groupext_proto_rawDescData)
+	})
+	return file_groupext_groupext_proto_rawDescData
+}
+
+var file_groupext_groupext_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_groupext_groupext_proto_goTypes = []interface{}{
+	(*VerifyGroupAdminReq)(nil),  // 0: OpenIMServer.groupext.VerifyGroupAdminReq
+	(*VerifyGroupAdminResp)(nil), // 1: OpenIMServer.groupext.VerifyGroupAdminResp
+}
+var file_groupext_groupext_proto_depIdxs = []int32{
+	0, // 0: OpenIMServer.groupext.groupExt.VerifyGroupAdmin:input_type -> OpenIMServer.groupext.VerifyGroupAdminReq
+	1, // 1: OpenIMServer.groupext.groupExt.VerifyGroupAdmin:output_type -> OpenIMServer.groupext.VerifyGroupAdminResp
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_groupext_groupext_proto_init() }
+func file_groupext_groupext_proto_init() {
+	if File_groupext_groupext_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_groupext_groupext_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyGroupAdminReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_groupext_groupext_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyGroupAdminResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_groupext_groupext_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_groupext_groupext_proto_goTypes,
+		DependencyIndexes: file_groupext_groupext_proto_depIdxs,
+		MessageInfos:      file_groupext_groupext_proto_msgTypes,
+	}.Build()
+	File_groupext_groupext_proto = out.File
+	file_groupext_groupext_proto_rawDesc = nil
+	file_groupext_groupext_proto_goTypes = nil
+	file_groupext_groupext_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// GroupExtClient is the client API for GroupExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GroupExtClient interface {
+	// 校验操作者是否为群主或管理员, 应用管理员直接通过
+	VerifyGroupAdmin(ctx context.Context, in *VerifyGroupAdminReq, opts ...grpc.CallOption) (*VerifyGroupAdminResp, error)
+}
+
+type groupExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGroupExtClient(cc grpc.ClientConnInterface) GroupExtClient {
+	return &groupExtClient{cc}
+}
+
+func (c *groupExtClient) VerifyGroupAdmin(ctx context.Context, in *VerifyGroupAdminReq, opts ...grpc.CallOption) (*VerifyGroupAdminResp, error) {
+	out := new(VerifyGroupAdminResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.groupext.groupExt/VerifyGroupAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GroupExtServer is the server API for GroupExt service.
+type GroupExtServer interface {
+	// 校验操作者是否为群主或管理员, 应用管理员直接通过
+	VerifyGroupAdmin(context.Context, *VerifyGroupAdminReq) (*VerifyGroupAdminResp, error)
+}
+
+// UnimplementedGroupExtServer can be embedded to have forward compatible implementations.
+type UnimplementedGroupExtServer struct {
+}
+
+func (*UnimplementedGroupExtServer) VerifyGroupAdmin(context.Context, *VerifyGroupAdminReq) (*VerifyGroupAdminResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyGroupAdmin not implemented")
+}
+
+func RegisterGroupExtServer(s *grpc.Server, srv GroupExtServer) {
+	s.RegisterService(&_GroupExt_serviceDesc, srv)
+}
+
+func _GroupExt_VerifyGroupAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyGroupAdminReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupExtServer).VerifyGroupAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.groupext.groupExt/VerifyGroupAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupExtServer).VerifyGroupAdmin(ctx, req.(*VerifyGroupAdminReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _GroupExt_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "OpenIMServer.groupext.groupExt",
+	HandlerType: (*GroupExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VerifyGroupAdmin",
+			Handler:    _GroupExt_VerifyGroupAdmin_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "groupext/groupext.proto",
+}
@@ -0,0 +1,365 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgext
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxEmojiLen is the longest emoji in bytes, enough for any emoji sequence and short custom emoji names.
+const maxEmojiLen = 64
+
+func (x *SearchMsgReq) Check() error {
+	if x.Pagination == nil && x.Count <= 0 {
+		return errors.New("pagination or count is required")
+	}
+	if x.Pagination != nil && (x.Pagination.PageNumber < 1 || x.Pagination.ShowNumber < 1) {
+		return errors.New("pagination is invalid")
+	}
+	if x.EndTime != 0 && x.EndTime <= x.StartTime {
+		return errors.New("endTime must be greater than startTime")
+	}
+	return nil
+}
+
+func (x *EditMsgReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq < 1 {
+		return errors.New("seq is invalid")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Content == "" {
+		return errors.New("content is empty")
+	}
+	return nil
+}
+
+func (x *GetMsgEditHistoryReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq < 1 {
+		return errors.New("seq is invalid")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+// checkEmoji rejects emojis that can not be used as a mongo field name and emojis with spaces or control characters.
+func checkEmoji(emoji string) error {
+	if emoji == "" {
+		return errors.New("emoji is empty")
+	}
+	if len(emoji) > maxEmojiLen {
+		return errors.New("emoji is too long")
+	}
+	if !utf8.ValidString(emoji) || strings.Contains(emoji, ".") || strings.HasPrefix(emoji, "$") {
+		return errors.New("emoji is invalid")
+	}
+	if strings.IndexFunc(emoji, func(r rune) bool { return unicode.IsSpace(r) || unicode.IsControl(r) }) >= 0 {
+		return errors.New("emoji is invalid")
+	}
+	return nil
+}
+
+func (x *AddMsgReactionReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq < 1 {
+		return errors.New("seq is invalid")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return checkEmoji(x.Emoji)
+}
+
+func (x *RemoveMsgReactionReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq < 1 {
+		return errors.New("seq is invalid")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return checkEmoji(x.Emoji)
+}
+
+func (x *GetMsgReactionUsersReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq < 1 {
+		return errors.New("seq is invalid")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Pagination == nil || x.Pagination.PageNumber < 1 || x.Pagination.ShowNumber < 1 {
+		return errors.New("pagination is invalid")
+	}
+	return checkEmoji(x.Emoji)
+}
+
+func (x *SendThreadMsgReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.RootSeq < 1 {
+		return errors.New("rootSeq is invalid")
+	}
+	if x.MsgData == nil {
+		return errors.New("msgData is empty")
+	}
+	return nil
+}
+
+func (x *PullThreadMsgsReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.RootSeq < 1 {
+		return errors.New("rootSeq is invalid")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Num < 1 {
+		return errors.New("num is invalid")
+	}
+	return nil
+}
+
+func (x *ScheduleMsgReq) Check() error {
+	if x.SendMsgReq == nil || x.SendMsgReq.MsgData == nil {
+		return errors.New("msgData is empty")
+	}
+	if x.SendAt < 1 {
+		return errors.New("sendAt is invalid")
+	}
+	return nil
+}
+
+func (x *CancelScheduledMsgReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ScheduledMsgID == "" {
+		return errors.New("scheduledMsgID is empty")
+	}
+	return nil
+}
+
+func (x *RescheduleMsgReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ScheduledMsgID == "" {
+		return errors.New("scheduledMsgID is empty")
+	}
+	if x.SendAt < 1 {
+		return errors.New("sendAt is invalid")
+	}
+	return nil
+}
+
+func (x *GetScheduledMsgsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Pagination == nil || x.Pagination.PageNumber < 1 || x.Pagination.ShowNumber < 1 {
+		return errors.New("pagination is invalid")
+	}
+	return nil
+}
+
+func (x *PinMsgReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq < 1 {
+		return errors.New("seq is invalid")
+	}
+	return nil
+}
+
+func (x *UnpinMsgReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq < 1 {
+		return errors.New("seq is invalid")
+	}
+	return nil
+}
+
+func (x *GetPinnedMsgsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if len(x.ConversationIDs) == 0 {
+		return errors.New("conversationIDs is empty")
+	}
+	return nil
+}
+
+func (x *VotePollReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq < 1 {
+		return errors.New("seq is invalid")
+	}
+	if len(x.OptionIndexes) == 0 {
+		return errors.New("optionIndexes is empty")
+	}
+	return nil
+}
+
+func (x *GetPollResultReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq < 1 {
+		return errors.New("seq is invalid")
+	}
+	return nil
+}
+
+func (x *GetModerationRecordsReq) Check() error {
+	if x.Pagination == nil || x.Pagination.PageNumber < 1 || x.Pagination.ShowNumber < 1 {
+		return errors.New("pagination is invalid")
+	}
+	if x.EndTime > 0 && x.StartTime > x.EndTime {
+		return errors.New("startTime is after endTime")
+	}
+	return nil
+}
+
+func (x *GetMsgDeliveryStatusReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Seq < 1 {
+		return errors.New("seq is invalid")
+	}
+	return nil
+}
+
+func checkRetentionScope(scope int32, key string) error {
+	switch scope {
+	case 1, 2:
+		if key == "" {
+			return errors.New("key is empty")
+		}
+	case 3:
+		if key != "" {
+			return errors.New("key must be empty for the default scope")
+		}
+	default:
+		return errors.New("scope is invalid")
+	}
+	return nil
+}
+
+func (x *SetRetentionPolicyReq) Check() error {
+	if x.RetainDays < 1 {
+		return errors.New("retainDays is invalid")
+	}
+	return checkRetentionScope(x.Scope, x.Key)
+}
+
+func (x *DeleteRetentionPolicyReq) Check() error {
+	return checkRetentionScope(x.Scope, x.Key)
+}
+
+func (x *GetRetentionPoliciesReq) Check() error {
+	if x.Pagination == nil || x.Pagination.PageNumber < 1 || x.Pagination.ShowNumber < 1 {
+		return errors.New("pagination is invalid")
+	}
+	return nil
+}
+
+func (x *RetentionDryRunReq) Check() error {
+	if len(x.ConversationIDs) == 0 {
+		return errors.New("conversationIDs is empty")
+	}
+	if len(x.ConversationIDs) > 100 {
+		return errors.New("conversationIDs is too many, max 100")
+	}
+	return nil
+}
+
+func checkLegalHoldTarget(targetType int32, targetID string) error {
+	if targetType != 1 && targetType != 2 {
+		return errors.New("targetType is invalid")
+	}
+	if targetID == "" {
+		return errors.New("targetID is empty")
+	}
+	return nil
+}
+
+func (x *PlaceLegalHoldReq) Check() error {
+	if x.Reason == "" {
+		return errors.New("reason is empty")
+	}
+	return checkLegalHoldTarget(x.TargetType, x.TargetID)
+}
+
+func (x *ReleaseLegalHoldReq) Check() error {
+	if x.Reason == "" {
+		return errors.New("reason is empty")
+	}
+	return checkLegalHoldTarget(x.TargetType, x.TargetID)
+}
+
+func (x *GetLegalHoldsReq) Check() error {
+	if x.Pagination == nil || x.Pagination.PageNumber < 1 || x.Pagination.ShowNumber < 1 {
+		return errors.New("pagination is invalid")
+	}
+	return nil
+}
+
+func (x *GetLegalHoldLogsReq) Check() error {
+	if x.Pagination == nil || x.Pagination.PageNumber < 1 || x.Pagination.ShowNumber < 1 {
+		return errors.New("pagination is invalid")
+	}
+	return nil
+}
@@ -0,0 +1,7389 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.22.0
+// source: msgext/msgext.proto
+
+package msgext
+
+import (
+	context "context"
+	msg "github.com/OpenIMSDK/protocol/msg"
+	sdkws "github.com/OpenIMSDK/protocol/sdkws"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// fields 1-6 mirror msg.SearchMessageReq so old clients keep working
+type SearchMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendID          string                   `protobuf:"bytes,1,opt,name=sendID,proto3" json:"sendID"`
+	RecvID          string                   `protobuf:"bytes,2,opt,name=recvID,proto3" json:"recvID"`
+	MsgType         int32                    `protobuf:"varint,3,opt,name=msgType,proto3" json:"msgType"`
+	SendTime        string                   `protobuf:"bytes,4,opt,name=sendTime,proto3" json:"sendTime"` // yyyy-mm-dd, UTC
+	SessionType     int32                    `protobuf:"varint,5,opt,name=sessionType,proto3" json:"sessionType"`
+	Pagination      *sdkws.RequestPagination `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination"`
+	Keyword         string                   `protobuf:"bytes,7,opt,name=keyword,proto3" json:"keyword"`
+	ConversationIDs []string                 `protobuf:"bytes,8,rep,name=conversationIDs,proto3" json:"conversationIDs"`
+	StartTime       int64                    `protobuf:"varint,9,opt,name=startTime,proto3" json:"startTime"` // ms, inclusive
+	EndTime         int64                    `protobuf:"varint,10,opt,name=endTime,proto3" json:"endTime"`    // ms, exclusive
+	Cursor          string                   `protobuf:"bytes,11,opt,name=cursor,proto3" json:"cursor"`
+	Count           int32                    `protobuf:"varint,12,opt,name=count,proto3" json:"count"`
+}
+
+func (x *SearchMsgReq) Reset() {
+	*x = SearchMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMsgReq) ProtoMessage() {}
+
+func (x *SearchMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMsgReq.ProtoReflect.Descriptor instead.
+func (*SearchMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchMsgReq) GetSendID() string {
+	if x != nil {
+		return x.SendID
+	}
+	return ""
+}
+
+func (x *SearchMsgReq) GetRecvID() string {
+	if x != nil {
+		return x.RecvID
+	}
+	return ""
+}
+
+func (x *SearchMsgReq) GetMsgType() int32 {
+	if x != nil {
+		return x.MsgType
+	}
+	return 0
+}
+
+func (x *SearchMsgReq) GetSendTime() string {
+	if x != nil {
+		return x.SendTime
+	}
+	return ""
+}
+
+func (x *SearchMsgReq) GetSessionType() int32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *SearchMsgReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *SearchMsgReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchMsgReq) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+func (x *SearchMsgReq) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *SearchMsgReq) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *SearchMsgReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchMsgReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SearchMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatLogs    []*msg.ChatLog `protobuf:"bytes,1,rep,name=chatLogs,proto3" json:"chatLogs"`
+	ChatLogsNum int32          `protobuf:"varint,2,opt,name=chatLogsNum,proto3" json:"chatLogsNum"`
+	NextCursor  string         `protobuf:"bytes,3,opt,name=nextCursor,proto3" json:"nextCursor"`
+}
+
+func (x *SearchMsgResp) Reset() {
+	*x = SearchMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMsgResp) ProtoMessage() {}
+
+func (x *SearchMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMsgResp.ProtoReflect.Descriptor instead.
+func (*SearchMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchMsgResp) GetChatLogs() []*msg.ChatLog {
+	if x != nil {
+		return x.ChatLogs
+	}
+	return nil
+}
+
+func (x *SearchMsgResp) GetChatLogsNum() int32 {
+	if x != nil {
+		return x.ChatLogsNum
+	}
+	return 0
+}
+
+func (x *SearchMsgResp) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type EditMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+	Content        string `protobuf:"bytes,4,opt,name=content,proto3" json:"content"`
+}
+
+func (x *EditMsgReq) Reset() {
+	*x = EditMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMsgReq) ProtoMessage() {}
+
+func (x *EditMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditMsgReq.ProtoReflect.Descriptor instead.
+func (*EditMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EditMsgReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *EditMsgReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *EditMsgReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *EditMsgReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type EditMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EditTime int64 `protobuf:"varint,1,opt,name=editTime,proto3" json:"editTime"`
+}
+
+func (x *EditMsgResp) Reset() {
+	*x = EditMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMsgResp) ProtoMessage() {}
+
+func (x *EditMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditMsgResp.ProtoReflect.Descriptor instead.
+func (*EditMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EditMsgResp) GetEditTime() int64 {
+	if x != nil {
+		return x.EditTime
+	}
+	return 0
+}
+
+type MsgEditedTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EditorUserID   string `protobuf:"bytes,1,opt,name=editorUserID,proto3" json:"editorUserID"`
+	ClientMsgID    string `protobuf:"bytes,2,opt,name=clientMsgID,proto3" json:"clientMsgID"`
+	Seq            int64  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq"`
+	ConversationID string `protobuf:"bytes,4,opt,name=conversationID,proto3" json:"conversationID"`
+	SessionType    int32  `protobuf:"varint,5,opt,name=sessionType,proto3" json:"sessionType"`
+	ContentType    int32  `protobuf:"varint,6,opt,name=contentType,proto3" json:"contentType"`
+	Content        string `protobuf:"bytes,7,opt,name=content,proto3" json:"content"`
+	EditTime       int64  `protobuf:"varint,8,opt,name=editTime,proto3" json:"editTime"`
+}
+
+func (x *MsgEditedTips) Reset() {
+	*x = MsgEditedTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgEditedTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgEditedTips) ProtoMessage() {}
+
+func (x *MsgEditedTips) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgEditedTips.ProtoReflect.Descriptor instead.
+func (*MsgEditedTips) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgEditedTips) GetEditorUserID() string {
+	if x != nil {
+		return x.EditorUserID
+	}
+	return ""
+}
+
+func (x *MsgEditedTips) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *MsgEditedTips) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *MsgEditedTips) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *MsgEditedTips) GetSessionType() int32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *MsgEditedTips) GetContentType() int32 {
+	if x != nil {
+		return x.ContentType
+	}
+	return 0
+}
+
+func (x *MsgEditedTips) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MsgEditedTips) GetEditTime() int64 {
+	if x != nil {
+		return x.EditTime
+	}
+	return 0
+}
+
+type MsgEditVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version        int32  `protobuf:"varint,1,opt,name=version,proto3" json:"version"` // 0 is the content as sent
+	EditorUserID   string `protobuf:"bytes,2,opt,name=editorUserID,proto3" json:"editorUserID"`
+	EditorNickname string `protobuf:"bytes,3,opt,name=editorNickname,proto3" json:"editorNickname"`
+	Content        string `protobuf:"bytes,4,opt,name=content,proto3" json:"content"`
+	EditTime       int64  `protobuf:"varint,5,opt,name=editTime,proto3" json:"editTime"`
+}
+
+func (x *MsgEditVersion) Reset() {
+	*x = MsgEditVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgEditVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgEditVersion) ProtoMessage() {}
+
+func (x *MsgEditVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgEditVersion.ProtoReflect.Descriptor instead.
+func (*MsgEditVersion) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MsgEditVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *MsgEditVersion) GetEditorUserID() string {
+	if x != nil {
+		return x.EditorUserID
+	}
+	return ""
+}
+
+func (x *MsgEditVersion) GetEditorNickname() string {
+	if x != nil {
+		return x.EditorNickname
+	}
+	return ""
+}
+
+func (x *MsgEditVersion) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MsgEditVersion) GetEditTime() int64 {
+	if x != nil {
+		return x.EditTime
+	}
+	return 0
+}
+
+type GetMsgEditHistoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *GetMsgEditHistoryReq) Reset() {
+	*x = GetMsgEditHistoryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMsgEditHistoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMsgEditHistoryReq) ProtoMessage() {}
+
+func (x *GetMsgEditHistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMsgEditHistoryReq.ProtoReflect.Descriptor instead.
+func (*GetMsgEditHistoryReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetMsgEditHistoryReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetMsgEditHistoryReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *GetMsgEditHistoryReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetMsgEditHistoryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Versions []*MsgEditVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions"`
+}
+
+func (x *GetMsgEditHistoryResp) Reset() {
+	*x = GetMsgEditHistoryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMsgEditHistoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMsgEditHistoryResp) ProtoMessage() {}
+
+func (x *GetMsgEditHistoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMsgEditHistoryResp.ProtoReflect.Descriptor instead.
+func (*GetMsgEditHistoryResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetMsgEditHistoryResp) GetVersions() []*MsgEditVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type AddMsgReactionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+	Emoji          string `protobuf:"bytes,4,opt,name=emoji,proto3" json:"emoji"`
+}
+
+func (x *AddMsgReactionReq) Reset() {
+	*x = AddMsgReactionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddMsgReactionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMsgReactionReq) ProtoMessage() {}
+
+func (x *AddMsgReactionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMsgReactionReq.ProtoReflect.Descriptor instead.
+func (*AddMsgReactionReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AddMsgReactionReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *AddMsgReactionReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *AddMsgReactionReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *AddMsgReactionReq) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type AddMsgReactionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddMsgReactionResp) Reset() {
+	*x = AddMsgReactionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddMsgReactionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMsgReactionResp) ProtoMessage() {}
+
+func (x *AddMsgReactionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMsgReactionResp.ProtoReflect.Descriptor instead.
+func (*AddMsgReactionResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{9}
+}
+
+type RemoveMsgReactionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+	Emoji          string `protobuf:"bytes,4,opt,name=emoji,proto3" json:"emoji"`
+}
+
+func (x *RemoveMsgReactionReq) Reset() {
+	*x = RemoveMsgReactionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveMsgReactionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMsgReactionReq) ProtoMessage() {}
+
+func (x *RemoveMsgReactionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMsgReactionReq.ProtoReflect.Descriptor instead.
+func (*RemoveMsgReactionReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RemoveMsgReactionReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *RemoveMsgReactionReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *RemoveMsgReactionReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RemoveMsgReactionReq) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type RemoveMsgReactionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveMsgReactionResp) Reset() {
+	*x = RemoveMsgReactionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveMsgReactionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMsgReactionResp) ProtoMessage() {}
+
+func (x *RemoveMsgReactionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMsgReactionResp.ProtoReflect.Descriptor instead.
+func (*RemoveMsgReactionResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{11}
+}
+
+type MsgReactionTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	ClientMsgID    string `protobuf:"bytes,2,opt,name=clientMsgID,proto3" json:"clientMsgID"`
+	Seq            int64  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq"`
+	ConversationID string `protobuf:"bytes,4,opt,name=conversationID,proto3" json:"conversationID"`
+	SessionType    int32  `protobuf:"varint,5,opt,name=sessionType,proto3" json:"sessionType"`
+	Emoji          string `protobuf:"bytes,6,opt,name=emoji,proto3" json:"emoji"`
+	IsAdd          bool   `protobuf:"varint,7,opt,name=isAdd,proto3" json:"isAdd"`
+	ReactTime      int64  `protobuf:"varint,8,opt,name=reactTime,proto3" json:"reactTime"`
+}
+
+func (x *MsgReactionTips) Reset() {
+	*x = MsgReactionTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgReactionTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgReactionTips) ProtoMessage() {}
+
+func (x *MsgReactionTips) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgReactionTips.ProtoReflect.Descriptor instead.
+func (*MsgReactionTips) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MsgReactionTips) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *MsgReactionTips) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *MsgReactionTips) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *MsgReactionTips) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *MsgReactionTips) GetSessionType() int32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *MsgReactionTips) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *MsgReactionTips) GetIsAdd() bool {
+	if x != nil {
+		return x.IsAdd
+	}
+	return false
+}
+
+func (x *MsgReactionTips) GetReactTime() int64 {
+	if x != nil {
+		return x.ReactTime
+	}
+	return 0
+}
+
+type GetMsgReactionUsersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string                   `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64                    `protobuf:"varint,2,opt,name=seq,proto3" json:"seq"`
+	UserID         string                   `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+	Emoji          string                   `protobuf:"bytes,4,opt,name=emoji,proto3" json:"emoji"`
+	Pagination     *sdkws.RequestPagination `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *GetMsgReactionUsersReq) Reset() {
+	*x = GetMsgReactionUsersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMsgReactionUsersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMsgReactionUsersReq) ProtoMessage() {}
+
+func (x *GetMsgReactionUsersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMsgReactionUsersReq.ProtoReflect.Descriptor instead.
+func (*GetMsgReactionUsersReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetMsgReactionUsersReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetMsgReactionUsersReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *GetMsgReactionUsersReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetMsgReactionUsersReq) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *GetMsgReactionUsersReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetMsgReactionUsersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total   int32    `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	UserIDs []string `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *GetMsgReactionUsersResp) Reset() {
+	*x = GetMsgReactionUsersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMsgReactionUsersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMsgReactionUsersResp) ProtoMessage() {}
+
+func (x *GetMsgReactionUsersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMsgReactionUsersResp.ProtoReflect.Descriptor instead.
+func (*GetMsgReactionUsersResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetMsgReactionUsersResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetMsgReactionUsersResp) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type SendThreadMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string         `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	RootSeq        int64          `protobuf:"varint,2,opt,name=rootSeq,proto3" json:"rootSeq"`
+	MsgData        *sdkws.MsgData `protobuf:"bytes,3,opt,name=msgData,proto3" json:"msgData"`
+}
+
+func (x *SendThreadMsgReq) Reset() {
+	*x = SendThreadMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendThreadMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendThreadMsgReq) ProtoMessage() {}
+
+func (x *SendThreadMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendThreadMsgReq.ProtoReflect.Descriptor instead.
+func (*SendThreadMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SendThreadMsgReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *SendThreadMsgReq) GetRootSeq() int64 {
+	if x != nil {
+		return x.RootSeq
+	}
+	return 0
+}
+
+func (x *SendThreadMsgReq) GetMsgData() *sdkws.MsgData {
+	if x != nil {
+		return x.MsgData
+	}
+	return nil
+}
+
+type SendThreadMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThreadConversationID string `protobuf:"bytes,1,opt,name=threadConversationID,proto3" json:"threadConversationID"`
+	ServerMsgID          string `protobuf:"bytes,3,opt,name=serverMsgID,proto3" json:"serverMsgID"`
+	ClientMsgID          string `protobuf:"bytes,4,opt,name=clientMsgID,proto3" json:"clientMsgID"`
+	SendTime             int64  `protobuf:"varint,5,opt,name=sendTime,proto3" json:"sendTime"`
+}
+
+func (x *SendThreadMsgResp) Reset() {
+	*x = SendThreadMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendThreadMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendThreadMsgResp) ProtoMessage() {}
+
+func (x *SendThreadMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendThreadMsgResp.ProtoReflect.Descriptor instead.
+func (*SendThreadMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SendThreadMsgResp) GetThreadConversationID() string {
+	if x != nil {
+		return x.ThreadConversationID
+	}
+	return ""
+}
+
+func (x *SendThreadMsgResp) GetServerMsgID() string {
+	if x != nil {
+		return x.ServerMsgID
+	}
+	return ""
+}
+
+func (x *SendThreadMsgResp) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *SendThreadMsgResp) GetSendTime() int64 {
+	if x != nil {
+		return x.SendTime
+	}
+	return 0
+}
+
+type MsgThreadReplyTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID       string         `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	RootSeq              int64          `protobuf:"varint,2,opt,name=rootSeq,proto3" json:"rootSeq"`
+	ThreadConversationID string         `protobuf:"bytes,3,opt,name=threadConversationID,proto3" json:"threadConversationID"`
+	ReplyCount           int64          `protobuf:"varint,4,opt,name=replyCount,proto3" json:"replyCount"`
+	Reply                *sdkws.MsgData `protobuf:"bytes,5,opt,name=reply,proto3" json:"reply"`
+}
+
+func (x *MsgThreadReplyTips) Reset() {
+	*x = MsgThreadReplyTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgThreadReplyTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgThreadReplyTips) ProtoMessage() {}
+
+func (x *MsgThreadReplyTips) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgThreadReplyTips.ProtoReflect.Descriptor instead.
+func (*MsgThreadReplyTips) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MsgThreadReplyTips) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *MsgThreadReplyTips) GetRootSeq() int64 {
+	if x != nil {
+		return x.RootSeq
+	}
+	return 0
+}
+
+func (x *MsgThreadReplyTips) GetThreadConversationID() string {
+	if x != nil {
+		return x.ThreadConversationID
+	}
+	return ""
+}
+
+func (x *MsgThreadReplyTips) GetReplyCount() int64 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+func (x *MsgThreadReplyTips) GetReply() *sdkws.MsgData {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+type PullThreadMsgsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	RootSeq        int64  `protobuf:"varint,2,opt,name=rootSeq,proto3" json:"rootSeq"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+	Begin          int64  `protobuf:"varint,4,opt,name=begin,proto3" json:"begin"`
+	End            int64  `protobuf:"varint,5,opt,name=end,proto3" json:"end"`
+	Num            int64  `protobuf:"varint,6,opt,name=num,proto3" json:"num"`
+}
+
+func (x *PullThreadMsgsReq) Reset() {
+	*x = PullThreadMsgsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PullThreadMsgsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullThreadMsgsReq) ProtoMessage() {}
+
+func (x *PullThreadMsgsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullThreadMsgsReq.ProtoReflect.Descriptor instead.
+func (*PullThreadMsgsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PullThreadMsgsReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *PullThreadMsgsReq) GetRootSeq() int64 {
+	if x != nil {
+		return x.RootSeq
+	}
+	return 0
+}
+
+func (x *PullThreadMsgsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *PullThreadMsgsReq) GetBegin() int64 {
+	if x != nil {
+		return x.Begin
+	}
+	return 0
+}
+
+func (x *PullThreadMsgsReq) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *PullThreadMsgsReq) GetNum() int64 {
+	if x != nil {
+		return x.Num
+	}
+	return 0
+}
+
+type PullThreadMsgsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThreadConversationID string           `protobuf:"bytes,1,opt,name=threadConversationID,proto3" json:"threadConversationID"`
+	Msgs                 []*sdkws.MsgData `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs"`
+	MinSeq               int64            `protobuf:"varint,3,opt,name=minSeq,proto3" json:"minSeq"`
+	MaxSeq               int64            `protobuf:"varint,4,opt,name=maxSeq,proto3" json:"maxSeq"`
+	HasReadSeq           int64            `protobuf:"varint,5,opt,name=hasReadSeq,proto3" json:"hasReadSeq"`
+}
+
+func (x *PullThreadMsgsResp) Reset() {
+	*x = PullThreadMsgsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PullThreadMsgsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullThreadMsgsResp) ProtoMessage() {}
+
+func (x *PullThreadMsgsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullThreadMsgsResp.ProtoReflect.Descriptor instead.
+func (*PullThreadMsgsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PullThreadMsgsResp) GetThreadConversationID() string {
+	if x != nil {
+		return x.ThreadConversationID
+	}
+	return ""
+}
+
+func (x *PullThreadMsgsResp) GetMsgs() []*sdkws.MsgData {
+	if x != nil {
+		return x.Msgs
+	}
+	return nil
+}
+
+func (x *PullThreadMsgsResp) GetMinSeq() int64 {
+	if x != nil {
+		return x.MinSeq
+	}
+	return 0
+}
+
+func (x *PullThreadMsgsResp) GetMaxSeq() int64 {
+	if x != nil {
+		return x.MaxSeq
+	}
+	return 0
+}
+
+func (x *PullThreadMsgsResp) GetHasReadSeq() int64 {
+	if x != nil {
+		return x.HasReadSeq
+	}
+	return 0
+}
+
+type ScheduledMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduledMsgID string         `protobuf:"bytes,1,opt,name=scheduledMsgID,proto3" json:"scheduledMsgID"`
+	SendAt         int64          `protobuf:"varint,2,opt,name=sendAt,proto3" json:"sendAt"`
+	Status         int32          `protobuf:"varint,3,opt,name=status,proto3" json:"status"`
+	MsgData        *sdkws.MsgData `protobuf:"bytes,4,opt,name=msgData,proto3" json:"msgData"`
+	ServerMsgID    string         `protobuf:"bytes,5,opt,name=serverMsgID,proto3" json:"serverMsgID"`
+	ErrMsg         string         `protobuf:"bytes,6,opt,name=errMsg,proto3" json:"errMsg"`
+	CreateTime     int64          `protobuf:"varint,7,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *ScheduledMsg) Reset() {
+	*x = ScheduledMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledMsg) ProtoMessage() {}
+
+func (x *ScheduledMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledMsg.ProtoReflect.Descriptor instead.
+func (*ScheduledMsg) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ScheduledMsg) GetScheduledMsgID() string {
+	if x != nil {
+		return x.ScheduledMsgID
+	}
+	return ""
+}
+
+func (x *ScheduledMsg) GetSendAt() int64 {
+	if x != nil {
+		return x.SendAt
+	}
+	return 0
+}
+
+func (x *ScheduledMsg) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ScheduledMsg) GetMsgData() *sdkws.MsgData {
+	if x != nil {
+		return x.MsgData
+	}
+	return nil
+}
+
+func (x *ScheduledMsg) GetServerMsgID() string {
+	if x != nil {
+		return x.ServerMsgID
+	}
+	return ""
+}
+
+func (x *ScheduledMsg) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *ScheduledMsg) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type ScheduleMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendMsgReq *msg.SendMsgReq `protobuf:"bytes,1,opt,name=sendMsgReq,proto3" json:"sendMsgReq"`
+	SendAt     int64           `protobuf:"varint,2,opt,name=sendAt,proto3" json:"sendAt"`
+}
+
+func (x *ScheduleMsgReq) Reset() {
+	*x = ScheduleMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMsgReq) ProtoMessage() {}
+
+func (x *ScheduleMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMsgReq.ProtoReflect.Descriptor instead.
+func (*ScheduleMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ScheduleMsgReq) GetSendMsgReq() *msg.SendMsgReq {
+	if x != nil {
+		return x.SendMsgReq
+	}
+	return nil
+}
+
+func (x *ScheduleMsgReq) GetSendAt() int64 {
+	if x != nil {
+		return x.SendAt
+	}
+	return 0
+}
+
+type ScheduleMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduledMsgID string `protobuf:"bytes,1,opt,name=scheduledMsgID,proto3" json:"scheduledMsgID"`
+}
+
+func (x *ScheduleMsgResp) Reset() {
+	*x = ScheduleMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMsgResp) ProtoMessage() {}
+
+func (x *ScheduleMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMsgResp.ProtoReflect.Descriptor instead.
+func (*ScheduleMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ScheduleMsgResp) GetScheduledMsgID() string {
+	if x != nil {
+		return x.ScheduledMsgID
+	}
+	return ""
+}
+
+type CancelScheduledMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	ScheduledMsgID string `protobuf:"bytes,2,opt,name=scheduledMsgID,proto3" json:"scheduledMsgID"`
+}
+
+func (x *CancelScheduledMsgReq) Reset() {
+	*x = CancelScheduledMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledMsgReq) ProtoMessage() {}
+
+func (x *CancelScheduledMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledMsgReq.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CancelScheduledMsgReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *CancelScheduledMsgReq) GetScheduledMsgID() string {
+	if x != nil {
+		return x.ScheduledMsgID
+	}
+	return ""
+}
+
+type CancelScheduledMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelScheduledMsgResp) Reset() {
+	*x = CancelScheduledMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledMsgResp) ProtoMessage() {}
+
+func (x *CancelScheduledMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledMsgResp.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{24}
+}
+
+type RescheduleMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	ScheduledMsgID string `protobuf:"bytes,2,opt,name=scheduledMsgID,proto3" json:"scheduledMsgID"`
+	SendAt         int64  `protobuf:"varint,3,opt,name=sendAt,proto3" json:"sendAt"`
+}
+
+func (x *RescheduleMsgReq) Reset() {
+	*x = RescheduleMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescheduleMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleMsgReq) ProtoMessage() {}
+
+func (x *RescheduleMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleMsgReq.ProtoReflect.Descriptor instead.
+func (*RescheduleMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RescheduleMsgReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RescheduleMsgReq) GetScheduledMsgID() string {
+	if x != nil {
+		return x.ScheduledMsgID
+	}
+	return ""
+}
+
+func (x *RescheduleMsgReq) GetSendAt() int64 {
+	if x != nil {
+		return x.SendAt
+	}
+	return 0
+}
+
+type RescheduleMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RescheduleMsgResp) Reset() {
+	*x = RescheduleMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescheduleMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleMsgResp) ProtoMessage() {}
+
+func (x *RescheduleMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleMsgResp.ProtoReflect.Descriptor instead.
+func (*RescheduleMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{26}
+}
+
+type GetScheduledMsgsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string                   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Status     []int32                  `protobuf:"varint,2,rep,packed,name=status,proto3" json:"status"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *GetScheduledMsgsReq) Reset() {
+	*x = GetScheduledMsgsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScheduledMsgsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledMsgsReq) ProtoMessage() {}
+
+func (x *GetScheduledMsgsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledMsgsReq.ProtoReflect.Descriptor instead.
+func (*GetScheduledMsgsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetScheduledMsgsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetScheduledMsgsReq) GetStatus() []int32 {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetScheduledMsgsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetScheduledMsgsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total         int64           `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	ScheduledMsgs []*ScheduledMsg `protobuf:"bytes,2,rep,name=scheduledMsgs,proto3" json:"scheduledMsgs"`
+}
+
+func (x *GetScheduledMsgsResp) Reset() {
+	*x = GetScheduledMsgsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScheduledMsgsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledMsgsResp) ProtoMessage() {}
+
+func (x *GetScheduledMsgsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledMsgsResp.ProtoReflect.Descriptor instead.
+func (*GetScheduledMsgsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetScheduledMsgsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetScheduledMsgsResp) GetScheduledMsgs() []*ScheduledMsg {
+	if x != nil {
+		return x.ScheduledMsgs
+	}
+	return nil
+}
+
+type PinnedMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq       int64          `protobuf:"varint,1,opt,name=seq,proto3" json:"seq"`
+	PinUserID string         `protobuf:"bytes,2,opt,name=pinUserID,proto3" json:"pinUserID"`
+	PinTime   int64          `protobuf:"varint,3,opt,name=pinTime,proto3" json:"pinTime"`
+	MsgData   *sdkws.MsgData `protobuf:"bytes,4,opt,name=msgData,proto3" json:"msgData"`
+}
+
+func (x *PinnedMsg) Reset() {
+	*x = PinnedMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinnedMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinnedMsg) ProtoMessage() {}
+
+func (x *PinnedMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinnedMsg.ProtoReflect.Descriptor instead.
+func (*PinnedMsg) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PinnedMsg) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *PinnedMsg) GetPinUserID() string {
+	if x != nil {
+		return x.PinUserID
+	}
+	return ""
+}
+
+func (x *PinnedMsg) GetPinTime() int64 {
+	if x != nil {
+		return x.PinTime
+	}
+	return 0
+}
+
+func (x *PinnedMsg) GetMsgData() *sdkws.MsgData {
+	if x != nil {
+		return x.MsgData
+	}
+	return nil
+}
+
+type PinnedMsgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PinnedMsgs []*PinnedMsg `protobuf:"bytes,1,rep,name=pinnedMsgs,proto3" json:"pinnedMsgs"`
+}
+
+func (x *PinnedMsgs) Reset() {
+	*x = PinnedMsgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinnedMsgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinnedMsgs) ProtoMessage() {}
+
+func (x *PinnedMsgs) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinnedMsgs.ProtoReflect.Descriptor instead.
+func (*PinnedMsgs) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PinnedMsgs) GetPinnedMsgs() []*PinnedMsg {
+	if x != nil {
+		return x.PinnedMsgs
+	}
+	return nil
+}
+
+type PinMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq"`
+}
+
+func (x *PinMsgReq) Reset() {
+	*x = PinMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinMsgReq) ProtoMessage() {}
+
+func (x *PinMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinMsgReq.ProtoReflect.Descriptor instead.
+func (*PinMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PinMsgReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *PinMsgReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *PinMsgReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+type PinMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PinMsgResp) Reset() {
+	*x = PinMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinMsgResp) ProtoMessage() {}
+
+func (x *PinMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinMsgResp.ProtoReflect.Descriptor instead.
+func (*PinMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{32}
+}
+
+type UnpinMsgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq"`
+}
+
+func (x *UnpinMsgReq) Reset() {
+	*x = UnpinMsgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnpinMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinMsgReq) ProtoMessage() {}
+
+func (x *UnpinMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinMsgReq.ProtoReflect.Descriptor instead.
+func (*UnpinMsgReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UnpinMsgReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UnpinMsgReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *UnpinMsgReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+type UnpinMsgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnpinMsgResp) Reset() {
+	*x = UnpinMsgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnpinMsgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinMsgResp) ProtoMessage() {}
+
+func (x *UnpinMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinMsgResp.ProtoReflect.Descriptor instead.
+func (*UnpinMsgResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{34}
+}
+
+type GetPinnedMsgsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID          string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	ConversationIDs []string `protobuf:"bytes,2,rep,name=conversationIDs,proto3" json:"conversationIDs"`
+	WithMsgData     bool     `protobuf:"varint,3,opt,name=withMsgData,proto3" json:"withMsgData"`
+}
+
+func (x *GetPinnedMsgsReq) Reset() {
+	*x = GetPinnedMsgsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPinnedMsgsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPinnedMsgsReq) ProtoMessage() {}
+
+func (x *GetPinnedMsgsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPinnedMsgsReq.ProtoReflect.Descriptor instead.
+func (*GetPinnedMsgsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetPinnedMsgsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetPinnedMsgsReq) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+func (x *GetPinnedMsgsReq) GetWithMsgData() bool {
+	if x != nil {
+		return x.WithMsgData
+	}
+	return false
+}
+
+type GetPinnedMsgsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PinnedMsgs map[string]*PinnedMsgs `protobuf:"bytes,1,rep,name=pinnedMsgs,proto3" json:"pinnedMsgs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetPinnedMsgsResp) Reset() {
+	*x = GetPinnedMsgsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPinnedMsgsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPinnedMsgsResp) ProtoMessage() {}
+
+func (x *GetPinnedMsgsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPinnedMsgsResp.ProtoReflect.Descriptor instead.
+func (*GetPinnedMsgsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetPinnedMsgsResp) GetPinnedMsgs() map[string]*PinnedMsgs {
+	if x != nil {
+		return x.PinnedMsgs
+	}
+	return nil
+}
+
+type MsgPinnedTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq"`
+	ClientMsgID    string `protobuf:"bytes,3,opt,name=clientMsgID,proto3" json:"clientMsgID"`
+	SessionType    int32  `protobuf:"varint,4,opt,name=sessionType,proto3" json:"sessionType"`
+	OpUserID       string `protobuf:"bytes,5,opt,name=opUserID,proto3" json:"opUserID"`
+	IsPin          bool   `protobuf:"varint,6,opt,name=isPin,proto3" json:"isPin"`
+	PinTime        int64  `protobuf:"varint,7,opt,name=pinTime,proto3" json:"pinTime"`
+}
+
+func (x *MsgPinnedTips) Reset() {
+	*x = MsgPinnedTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgPinnedTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgPinnedTips) ProtoMessage() {}
+
+func (x *MsgPinnedTips) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgPinnedTips.ProtoReflect.Descriptor instead.
+func (*MsgPinnedTips) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MsgPinnedTips) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *MsgPinnedTips) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *MsgPinnedTips) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *MsgPinnedTips) GetSessionType() int32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *MsgPinnedTips) GetOpUserID() string {
+	if x != nil {
+		return x.OpUserID
+	}
+	return ""
+}
+
+func (x *MsgPinnedTips) GetIsPin() bool {
+	if x != nil {
+		return x.IsPin
+	}
+	return false
+}
+
+func (x *MsgPinnedTips) GetPinTime() int64 {
+	if x != nil {
+		return x.PinTime
+	}
+	return 0
+}
+
+type VotePollReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string  `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	ConversationID string  `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64   `protobuf:"varint,3,opt,name=seq,proto3" json:"seq"`
+	OptionIndexes  []int32 `protobuf:"varint,4,rep,packed,name=optionIndexes,proto3" json:"optionIndexes"`
+}
+
+func (x *VotePollReq) Reset() {
+	*x = VotePollReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VotePollReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePollReq) ProtoMessage() {}
+
+func (x *VotePollReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePollReq.ProtoReflect.Descriptor instead.
+func (*VotePollReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *VotePollReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *VotePollReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *VotePollReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *VotePollReq) GetOptionIndexes() []int32 {
+	if x != nil {
+		return x.OptionIndexes
+	}
+	return nil
+}
+
+type VotePollResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Counts     []int64 `protobuf:"varint,1,rep,packed,name=counts,proto3" json:"counts"`
+	VoterCount int64   `protobuf:"varint,2,opt,name=voterCount,proto3" json:"voterCount"`
+}
+
+func (x *VotePollResp) Reset() {
+	*x = VotePollResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VotePollResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePollResp) ProtoMessage() {}
+
+func (x *VotePollResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePollResp.ProtoReflect.Descriptor instead.
+func (*VotePollResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *VotePollResp) GetCounts() []int64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *VotePollResp) GetVoterCount() int64 {
+	if x != nil {
+		return x.VoterCount
+	}
+	return 0
+}
+
+type PollOptionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index   int32    `protobuf:"varint,1,opt,name=index,proto3" json:"index"`
+	Count   int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	UserIDs []string `protobuf:"bytes,3,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *PollOptionResult) Reset() {
+	*x = PollOptionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PollOptionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollOptionResult) ProtoMessage() {}
+
+func (x *PollOptionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollOptionResult.ProtoReflect.Descriptor instead.
+func (*PollOptionResult) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PollOptionResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *PollOptionResult) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PollOptionResult) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type GetPollResultReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq"`
+}
+
+func (x *GetPollResultReq) Reset() {
+	*x = GetPollResultReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPollResultReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPollResultReq) ProtoMessage() {}
+
+func (x *GetPollResultReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPollResultReq.ProtoReflect.Descriptor instead.
+func (*GetPollResultReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetPollResultReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetPollResultReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetPollResultReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+type GetPollResultResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Options         []*PollOptionResult `protobuf:"bytes,1,rep,name=options,proto3" json:"options"`
+	VoterCount      int64               `protobuf:"varint,2,opt,name=voterCount,proto3" json:"voterCount"`
+	MyOptionIndexes []int32             `protobuf:"varint,3,rep,packed,name=myOptionIndexes,proto3" json:"myOptionIndexes"`
+	Closed          bool                `protobuf:"varint,4,opt,name=closed,proto3" json:"closed"`
+}
+
+func (x *GetPollResultResp) Reset() {
+	*x = GetPollResultResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPollResultResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPollResultResp) ProtoMessage() {}
+
+func (x *GetPollResultResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPollResultResp.ProtoReflect.Descriptor instead.
+func (*GetPollResultResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetPollResultResp) GetOptions() []*PollOptionResult {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *GetPollResultResp) GetVoterCount() int64 {
+	if x != nil {
+		return x.VoterCount
+	}
+	return 0
+}
+
+func (x *GetPollResultResp) GetMyOptionIndexes() []int32 {
+	if x != nil {
+		return x.MyOptionIndexes
+	}
+	return nil
+}
+
+func (x *GetPollResultResp) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+type MsgPollVoteTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string  `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64   `protobuf:"varint,2,opt,name=seq,proto3" json:"seq"`
+	ClientMsgID    string  `protobuf:"bytes,3,opt,name=clientMsgID,proto3" json:"clientMsgID"`
+	VoterUserID    string  `protobuf:"bytes,4,opt,name=voterUserID,proto3" json:"voterUserID"`
+	OptionIndexes  []int32 `protobuf:"varint,5,rep,packed,name=optionIndexes,proto3" json:"optionIndexes"`
+	Counts         []int64 `protobuf:"varint,6,rep,packed,name=counts,proto3" json:"counts"`
+	VoterCount     int64   `protobuf:"varint,7,opt,name=voterCount,proto3" json:"voterCount"`
+}
+
+func (x *MsgPollVoteTips) Reset() {
+	*x = MsgPollVoteTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgPollVoteTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgPollVoteTips) ProtoMessage() {}
+
+func (x *MsgPollVoteTips) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgPollVoteTips.ProtoReflect.Descriptor instead.
+func (*MsgPollVoteTips) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *MsgPollVoteTips) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *MsgPollVoteTips) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *MsgPollVoteTips) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *MsgPollVoteTips) GetVoterUserID() string {
+	if x != nil {
+		return x.VoterUserID
+	}
+	return ""
+}
+
+func (x *MsgPollVoteTips) GetOptionIndexes() []int32 {
+	if x != nil {
+		return x.OptionIndexes
+	}
+	return nil
+}
+
+func (x *MsgPollVoteTips) GetCounts() []int64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *MsgPollVoteTips) GetVoterCount() int64 {
+	if x != nil {
+		return x.VoterCount
+	}
+	return 0
+}
+
+type ModerationRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerMsgID    string   `protobuf:"bytes,1,opt,name=serverMsgID,proto3" json:"serverMsgID"`
+	ClientMsgID    string   `protobuf:"bytes,2,opt,name=clientMsgID,proto3" json:"clientMsgID"`
+	ConversationID string   `protobuf:"bytes,3,opt,name=conversationID,proto3" json:"conversationID"`
+	SendID         string   `protobuf:"bytes,4,opt,name=sendID,proto3" json:"sendID"`
+	SessionType    int32    `protobuf:"varint,5,opt,name=sessionType,proto3" json:"sessionType"`
+	ContentType    int32    `protobuf:"varint,6,opt,name=contentType,proto3" json:"contentType"`
+	Content        string   `protobuf:"bytes,7,opt,name=content,proto3" json:"content"`
+	Words          []string `protobuf:"bytes,8,rep,name=words,proto3" json:"words"`
+	SendTime       int64    `protobuf:"varint,9,opt,name=sendTime,proto3" json:"sendTime"`
+	CreateTime     int64    `protobuf:"varint,10,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *ModerationRecord) Reset() {
+	*x = ModerationRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModerationRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationRecord) ProtoMessage() {}
+
+func (x *ModerationRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationRecord.ProtoReflect.Descriptor instead.
+func (*ModerationRecord) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ModerationRecord) GetServerMsgID() string {
+	if x != nil {
+		return x.ServerMsgID
+	}
+	return ""
+}
+
+func (x *ModerationRecord) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *ModerationRecord) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *ModerationRecord) GetSendID() string {
+	if x != nil {
+		return x.SendID
+	}
+	return ""
+}
+
+func (x *ModerationRecord) GetSessionType() int32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *ModerationRecord) GetContentType() int32 {
+	if x != nil {
+		return x.ContentType
+	}
+	return 0
+}
+
+func (x *ModerationRecord) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ModerationRecord) GetWords() []string {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *ModerationRecord) GetSendTime() int64 {
+	if x != nil {
+		return x.SendTime
+	}
+	return 0
+}
+
+func (x *ModerationRecord) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type GetModerationRecordsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendID         string                   `protobuf:"bytes,1,opt,name=sendID,proto3" json:"sendID"`
+	ConversationID string                   `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
+	StartTime      int64                    `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime"`
+	EndTime        int64                    `protobuf:"varint,4,opt,name=endTime,proto3" json:"endTime"`
+	Pagination     *sdkws.RequestPagination `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *GetModerationRecordsReq) Reset() {
+	*x = GetModerationRecordsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetModerationRecordsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModerationRecordsReq) ProtoMessage() {}
+
+func (x *GetModerationRecordsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModerationRecordsReq.ProtoReflect.Descriptor instead.
+func (*GetModerationRecordsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetModerationRecordsReq) GetSendID() string {
+	if x != nil {
+		return x.SendID
+	}
+	return ""
+}
+
+func (x *GetModerationRecordsReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetModerationRecordsReq) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *GetModerationRecordsReq) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *GetModerationRecordsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetModerationRecordsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total   int64               `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Records []*ModerationRecord `protobuf:"bytes,2,rep,name=records,proto3" json:"records"`
+}
+
+func (x *GetModerationRecordsResp) Reset() {
+	*x = GetModerationRecordsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetModerationRecordsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModerationRecordsResp) ProtoMessage() {}
+
+func (x *GetModerationRecordsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModerationRecordsResp.ProtoReflect.Descriptor instead.
+func (*GetModerationRecordsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetModerationRecordsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetModerationRecordsResp) GetRecords() []*ModerationRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type MsgDeliveryStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	// 1 online, 2 offline and not pushed, 3 pushed offline, 4 offline push failed
+	Status      int32   `protobuf:"varint,2,opt,name=status,proto3" json:"status"`
+	PlatformIDs []int32 `protobuf:"varint,3,rep,packed,name=platformIDs,proto3" json:"platformIDs"`
+	UpdateTime  int64   `protobuf:"varint,4,opt,name=updateTime,proto3" json:"updateTime"`
+}
+
+func (x *MsgDeliveryStatus) Reset() {
+	*x = MsgDeliveryStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgDeliveryStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgDeliveryStatus) ProtoMessage() {}
+
+func (x *MsgDeliveryStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgDeliveryStatus.ProtoReflect.Descriptor instead.
+func (*MsgDeliveryStatus) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *MsgDeliveryStatus) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *MsgDeliveryStatus) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *MsgDeliveryStatus) GetPlatformIDs() []int32 {
+	if x != nil {
+		return x.PlatformIDs
+	}
+	return nil
+}
+
+func (x *MsgDeliveryStatus) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+type GetMsgDeliveryStatusReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq"`
+}
+
+func (x *GetMsgDeliveryStatusReq) Reset() {
+	*x = GetMsgDeliveryStatusReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMsgDeliveryStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMsgDeliveryStatusReq) ProtoMessage() {}
+
+func (x *GetMsgDeliveryStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMsgDeliveryStatusReq.ProtoReflect.Descriptor instead.
+func (*GetMsgDeliveryStatusReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetMsgDeliveryStatusReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetMsgDeliveryStatusReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+type GetMsgDeliveryStatusResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Statuses []*MsgDeliveryStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses"`
+}
+
+func (x *GetMsgDeliveryStatusResp) Reset() {
+	*x = GetMsgDeliveryStatusResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMsgDeliveryStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMsgDeliveryStatusResp) ProtoMessage() {}
+
+func (x *GetMsgDeliveryStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMsgDeliveryStatusResp.ProtoReflect.Descriptor instead.
+func (*GetMsgDeliveryStatusResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetMsgDeliveryStatusResp) GetStatuses() []*MsgDeliveryStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type RetentionPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 1 conversation, key is the conversationID
+	// 2 prefix, key is matched against the conversationID without its si_/sg_/n_ type prefix
+	// 3 default, key is empty
+	Scope      int32  `protobuf:"varint,1,opt,name=scope,proto3" json:"scope"`
+	Key        string `protobuf:"bytes,2,opt,name=key,proto3" json:"key"`
+	RetainDays int32  `protobuf:"varint,3,opt,name=retainDays,proto3" json:"retainDays"`
+	OpUserID   string `protobuf:"bytes,4,opt,name=opUserID,proto3" json:"opUserID"`
+	CreateTime int64  `protobuf:"varint,5,opt,name=createTime,proto3" json:"createTime"`
+	UpdateTime int64  `protobuf:"varint,6,opt,name=updateTime,proto3" json:"updateTime"`
+}
+
+func (x *RetentionPolicy) Reset() {
+	*x = RetentionPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetentionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionPolicy) ProtoMessage() {}
+
+func (x *RetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionPolicy.ProtoReflect.Descriptor instead.
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RetentionPolicy) GetScope() int32 {
+	if x != nil {
+		return x.Scope
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RetentionPolicy) GetRetainDays() int32 {
+	if x != nil {
+		return x.RetainDays
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetOpUserID() string {
+	if x != nil {
+		return x.OpUserID
+	}
+	return ""
+}
+
+func (x *RetentionPolicy) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+type SetRetentionPolicyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope      int32  `protobuf:"varint,1,opt,name=scope,proto3" json:"scope"`
+	Key        string `protobuf:"bytes,2,opt,name=key,proto3" json:"key"`
+	RetainDays int32  `protobuf:"varint,3,opt,name=retainDays,proto3" json:"retainDays"`
+}
+
+func (x *SetRetentionPolicyReq) Reset() {
+	*x = SetRetentionPolicyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRetentionPolicyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRetentionPolicyReq) ProtoMessage() {}
+
+func (x *SetRetentionPolicyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRetentionPolicyReq.ProtoReflect.Descriptor instead.
+func (*SetRetentionPolicyReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SetRetentionPolicyReq) GetScope() int32 {
+	if x != nil {
+		return x.Scope
+	}
+	return 0
+}
+
+func (x *SetRetentionPolicyReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetRetentionPolicyReq) GetRetainDays() int32 {
+	if x != nil {
+		return x.RetainDays
+	}
+	return 0
+}
+
+type SetRetentionPolicyResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetRetentionPolicyResp) Reset() {
+	*x = SetRetentionPolicyResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRetentionPolicyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRetentionPolicyResp) ProtoMessage() {}
+
+func (x *SetRetentionPolicyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRetentionPolicyResp.ProtoReflect.Descriptor instead.
+func (*SetRetentionPolicyResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{52}
+}
+
+type DeleteRetentionPolicyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope int32  `protobuf:"varint,1,opt,name=scope,proto3" json:"scope"`
+	Key   string `protobuf:"bytes,2,opt,name=key,proto3" json:"key"`
+}
+
+func (x *DeleteRetentionPolicyReq) Reset() {
+	*x = DeleteRetentionPolicyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRetentionPolicyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRetentionPolicyReq) ProtoMessage() {}
+
+func (x *DeleteRetentionPolicyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRetentionPolicyReq.ProtoReflect.Descriptor instead.
+func (*DeleteRetentionPolicyReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteRetentionPolicyReq) GetScope() int32 {
+	if x != nil {
+		return x.Scope
+	}
+	return 0
+}
+
+func (x *DeleteRetentionPolicyReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type DeleteRetentionPolicyResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteRetentionPolicyResp) Reset() {
+	*x = DeleteRetentionPolicyResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRetentionPolicyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRetentionPolicyResp) ProtoMessage() {}
+
+func (x *DeleteRetentionPolicyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRetentionPolicyResp.ProtoReflect.Descriptor instead.
+func (*DeleteRetentionPolicyResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{54}
+}
+
+type GetRetentionPoliciesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 for all scopes
+	Scope      int32                    `protobuf:"varint,1,opt,name=scope,proto3" json:"scope"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *GetRetentionPoliciesReq) Reset() {
+	*x = GetRetentionPoliciesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRetentionPoliciesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRetentionPoliciesReq) ProtoMessage() {}
+
+func (x *GetRetentionPoliciesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRetentionPoliciesReq.ProtoReflect.Descriptor instead.
+func (*GetRetentionPoliciesReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetRetentionPoliciesReq) GetScope() int32 {
+	if x != nil {
+		return x.Scope
+	}
+	return 0
+}
+
+func (x *GetRetentionPoliciesReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetRetentionPoliciesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total    int64              `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Policies []*RetentionPolicy `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies"`
+}
+
+func (x *GetRetentionPoliciesResp) Reset() {
+	*x = GetRetentionPoliciesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRetentionPoliciesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRetentionPoliciesResp) ProtoMessage() {}
+
+func (x *GetRetentionPoliciesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRetentionPoliciesResp.ProtoReflect.Descriptor instead.
+func (*GetRetentionPoliciesResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetRetentionPoliciesResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetRetentionPoliciesResp) GetPolicies() []*RetentionPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type RetentionDryRunReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationIDs []string `protobuf:"bytes,1,rep,name=conversationIDs,proto3" json:"conversationIDs"`
+}
+
+func (x *RetentionDryRunReq) Reset() {
+	*x = RetentionDryRunReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetentionDryRunReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionDryRunReq) ProtoMessage() {}
+
+func (x *RetentionDryRunReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionDryRunReq.ProtoReflect.Descriptor instead.
+func (*RetentionDryRunReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RetentionDryRunReq) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+type RetentionDryRunResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	// the policy the cron task applies to the conversation
+	Policy *RetentionPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+	// msgs sent before this time (ms) are deleted
+	ExpireTime  int64 `protobuf:"varint,3,opt,name=expireTime,proto3" json:"expireTime"`
+	DeleteCount int64 `protobuf:"varint,4,opt,name=deleteCount,proto3" json:"deleteCount"`
+	// minSeq of the conversation after the deletion, 0 when nothing is deleted
+	MinSeq int64 `protobuf:"varint,5,opt,name=minSeq,proto3" json:"minSeq"`
+}
+
+func (x *RetentionDryRunResult) Reset() {
+	*x = RetentionDryRunResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetentionDryRunResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionDryRunResult) ProtoMessage() {}
+
+func (x *RetentionDryRunResult) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionDryRunResult.ProtoReflect.Descriptor instead.
+func (*RetentionDryRunResult) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RetentionDryRunResult) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *RetentionDryRunResult) GetPolicy() *RetentionPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *RetentionDryRunResult) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *RetentionDryRunResult) GetDeleteCount() int64 {
+	if x != nil {
+		return x.DeleteCount
+	}
+	return 0
+}
+
+func (x *RetentionDryRunResult) GetMinSeq() int64 {
+	if x != nil {
+		return x.MinSeq
+	}
+	return 0
+}
+
+type RetentionDryRunResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*RetentionDryRunResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (x *RetentionDryRunResp) Reset() {
+	*x = RetentionDryRunResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetentionDryRunResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionDryRunResp) ProtoMessage() {}
+
+func (x *RetentionDryRunResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionDryRunResp.ProtoReflect.Descriptor instead.
+func (*RetentionDryRunResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RetentionDryRunResp) GetResults() []*RetentionDryRunResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type LegalHold struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 1 conversation, targetID is the conversationID
+	// 2 user, every conversation of targetID is held
+	TargetType int32  `protobuf:"varint,1,opt,name=targetType,proto3" json:"targetType"`
+	TargetID   string `protobuf:"bytes,2,opt,name=targetID,proto3" json:"targetID"`
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason"`
+	OpUserID   string `protobuf:"bytes,4,opt,name=opUserID,proto3" json:"opUserID"`
+	CreateTime int64  `protobuf:"varint,5,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *LegalHold) Reset() {
+	*x = LegalHold{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LegalHold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegalHold) ProtoMessage() {}
+
+func (x *LegalHold) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegalHold.ProtoReflect.Descriptor instead.
+func (*LegalHold) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *LegalHold) GetTargetType() int32 {
+	if x != nil {
+		return x.TargetType
+	}
+	return 0
+}
+
+func (x *LegalHold) GetTargetID() string {
+	if x != nil {
+		return x.TargetID
+	}
+	return ""
+}
+
+func (x *LegalHold) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LegalHold) GetOpUserID() string {
+	if x != nil {
+		return x.OpUserID
+	}
+	return ""
+}
+
+func (x *LegalHold) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type LegalHoldLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetType int32  `protobuf:"varint,1,opt,name=targetType,proto3" json:"targetType"`
+	TargetID   string `protobuf:"bytes,2,opt,name=targetID,proto3" json:"targetID"`
+	// 1 place, 2 release
+	Action     int32  `protobuf:"varint,3,opt,name=action,proto3" json:"action"`
+	Reason     string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason"`
+	OpUserID   string `protobuf:"bytes,5,opt,name=opUserID,proto3" json:"opUserID"`
+	CreateTime int64  `protobuf:"varint,6,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *LegalHoldLog) Reset() {
+	*x = LegalHoldLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LegalHoldLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegalHoldLog) ProtoMessage() {}
+
+func (x *LegalHoldLog) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegalHoldLog.ProtoReflect.Descriptor instead.
+func (*LegalHoldLog) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *LegalHoldLog) GetTargetType() int32 {
+	if x != nil {
+		return x.TargetType
+	}
+	return 0
+}
+
+func (x *LegalHoldLog) GetTargetID() string {
+	if x != nil {
+		return x.TargetID
+	}
+	return ""
+}
+
+func (x *LegalHoldLog) GetAction() int32 {
+	if x != nil {
+		return x.Action
+	}
+	return 0
+}
+
+func (x *LegalHoldLog) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LegalHoldLog) GetOpUserID() string {
+	if x != nil {
+		return x.OpUserID
+	}
+	return ""
+}
+
+func (x *LegalHoldLog) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type PlaceLegalHoldReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetType int32  `protobuf:"varint,1,opt,name=targetType,proto3" json:"targetType"`
+	TargetID   string `protobuf:"bytes,2,opt,name=targetID,proto3" json:"targetID"`
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason"`
+}
+
+func (x *PlaceLegalHoldReq) Reset() {
+	*x = PlaceLegalHoldReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaceLegalHoldReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceLegalHoldReq) ProtoMessage() {}
+
+func (x *PlaceLegalHoldReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceLegalHoldReq.ProtoReflect.Descriptor instead.
+func (*PlaceLegalHoldReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PlaceLegalHoldReq) GetTargetType() int32 {
+	if x != nil {
+		return x.TargetType
+	}
+	return 0
+}
+
+func (x *PlaceLegalHoldReq) GetTargetID() string {
+	if x != nil {
+		return x.TargetID
+	}
+	return ""
+}
+
+func (x *PlaceLegalHoldReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PlaceLegalHoldResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PlaceLegalHoldResp) Reset() {
+	*x = PlaceLegalHoldResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaceLegalHoldResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceLegalHoldResp) ProtoMessage() {}
+
+func (x *PlaceLegalHoldResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceLegalHoldResp.ProtoReflect.Descriptor instead.
+func (*PlaceLegalHoldResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{63}
+}
+
+type ReleaseLegalHoldReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetType int32  `protobuf:"varint,1,opt,name=targetType,proto3" json:"targetType"`
+	TargetID   string `protobuf:"bytes,2,opt,name=targetID,proto3" json:"targetID"`
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason"`
+}
+
+func (x *ReleaseLegalHoldReq) Reset() {
+	*x = ReleaseLegalHoldReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseLegalHoldReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseLegalHoldReq) ProtoMessage() {}
+
+func (x *ReleaseLegalHoldReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseLegalHoldReq.ProtoReflect.Descriptor instead.
+func (*ReleaseLegalHoldReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ReleaseLegalHoldReq) GetTargetType() int32 {
+	if x != nil {
+		return x.TargetType
+	}
+	return 0
+}
+
+func (x *ReleaseLegalHoldReq) GetTargetID() string {
+	if x != nil {
+		return x.TargetID
+	}
+	return ""
+}
+
+func (x *ReleaseLegalHoldReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReleaseLegalHoldResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReleaseLegalHoldResp) Reset() {
+	*x = ReleaseLegalHoldResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseLegalHoldResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseLegalHoldResp) ProtoMessage() {}
+
+func (x *ReleaseLegalHoldResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseLegalHoldResp.ProtoReflect.Descriptor instead.
+func (*ReleaseLegalHoldResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{65}
+}
+
+type GetLegalHoldsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 for all target types
+	TargetType int32                    `protobuf:"varint,1,opt,name=targetType,proto3" json:"targetType"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *GetLegalHoldsReq) Reset() {
+	*x = GetLegalHoldsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLegalHoldsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLegalHoldsReq) ProtoMessage() {}
+
+func (x *GetLegalHoldsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLegalHoldsReq.ProtoReflect.Descriptor instead.
+func (*GetLegalHoldsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetLegalHoldsReq) GetTargetType() int32 {
+	if x != nil {
+		return x.TargetType
+	}
+	return 0
+}
+
+func (x *GetLegalHoldsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetLegalHoldsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total int64        `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Holds []*LegalHold `protobuf:"bytes,2,rep,name=holds,proto3" json:"holds"`
+}
+
+func (x *GetLegalHoldsResp) Reset() {
+	*x = GetLegalHoldsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLegalHoldsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLegalHoldsResp) ProtoMessage() {}
+
+func (x *GetLegalHoldsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLegalHoldsResp.ProtoReflect.Descriptor instead.
+func (*GetLegalHoldsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetLegalHoldsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetLegalHoldsResp) GetHolds() []*LegalHold {
+	if x != nil {
+		return x.Holds
+	}
+	return nil
+}
+
+type GetLegalHoldLogsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetType int32                    `protobuf:"varint,1,opt,name=targetType,proto3" json:"targetType"`
+	TargetID   string                   `protobuf:"bytes,2,opt,name=targetID,proto3" json:"targetID"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *GetLegalHoldLogsReq) Reset() {
+	*x = GetLegalHoldLogsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLegalHoldLogsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLegalHoldLogsReq) ProtoMessage() {}
+
+func (x *GetLegalHoldLogsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLegalHoldLogsReq.ProtoReflect.Descriptor instead.
+func (*GetLegalHoldLogsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetLegalHoldLogsReq) GetTargetType() int32 {
+	if x != nil {
+		return x.TargetType
+	}
+	return 0
+}
+
+func (x *GetLegalHoldLogsReq) GetTargetID() string {
+	if x != nil {
+		return x.TargetID
+	}
+	return ""
+}
+
+func (x *GetLegalHoldLogsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetLegalHoldLogsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total int64           `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Logs  []*LegalHoldLog `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs"`
+}
+
+func (x *GetLegalHoldLogsResp) Reset() {
+	*x = GetLegalHoldLogsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msgext_msgext_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLegalHoldLogsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLegalHoldLogsResp) ProtoMessage() {}
+
+func (x *GetLegalHoldLogsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLegalHoldLogsResp.ProtoReflect.Descriptor instead.
+func (*GetLegalHoldLogsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetLegalHoldLogsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetLegalHoldLogsResp) GetLogs() []*LegalHoldLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+var File_msgext_msgext_proto protoreflect.FileDescriptor
+
+var file_msgext_msgext_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2f, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x1a, 0x11, 0x73, 0x64, 0x6b, 0x77,
+	0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x6d,
+	0x73, 0x67, 0x2f, 0x6d, 0x73, 0x67, 0x76, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x87,
+	0x03, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x68,
+	0x61, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
+	0x43, 0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x4e, 0x75, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67, 0x73,
+	0x4e, 0x75, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x22, 0x78, 0x0a, 0x0a, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x29, 0x0a,
+	0x0b, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08,
+	0x65, 0x64, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x65, 0x64, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x89, 0x02, 0x0a, 0x0d, 0x4d, 0x73, 0x67,
+	0x45, 0x64, 0x69, 0x74, 0x65, 0x64, 0x54, 0x69, 0x70, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x64,
+	0x69, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73,
+	0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x64, 0x69, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x4e,
+	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65,
+	0x64, 0x69, 0x74, 0x6f, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x64, 0x69, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x68, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69,
+	0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x58, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d,
+	0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7b, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x6d, 0x6f, 0x6a, 0x69, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x7e, 0x0a, 0x14, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65,
+	0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0xf1, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49,
+	0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x73, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x6f, 0x6a, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73, 0x41, 0x64, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x41, 0x64, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61,
+	0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65,
+	0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64,
+	0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x49, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x8b, 0x01, 0x0a,
+	0x10, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6f,
+	0x74, 0x53, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x6f, 0x6f, 0x74,
+	0x53, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x22, 0xa7, 0x01, 0x0a, 0x11, 0x53,
+	0x65, 0x6e, 0x64, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x32, 0x0a, 0x14, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14,
+	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73,
+	0x67, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0xdd, 0x01, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x54, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x69, 0x70, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x65, 0x71, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x65, 0x71, 0x12, 0x32, 0x0a,
+	0x14, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x31, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x72,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0xa7, 0x01, 0x0a, 0x11, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x65, 0x71, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x6e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x22, 0xc9,
+	0x01, 0x0a, 0x12, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x32, 0x0a, 0x14, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x14, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x2f, 0x0a, 0x04, 0x6d, 0x73, 0x67,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69,
+	0x6e, 0x53, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x53,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x61,
+	0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x22, 0xf7, 0x01, 0x0a, 0x0c, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73,
+	0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72,
+	0x72, 0x4d, 0x73, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x66, 0x0a, 0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x3c, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x22, 0x39, 0x0a, 0x0f,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x26, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x22, 0x57, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x49, 0x44,
+	0x22, 0x18, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x6a, 0x0a, 0x10, 0x52, 0x65,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x8c, 0x01, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x75, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x47, 0x0a, 0x0d, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d,
+	0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d,
+	0x73, 0x67, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x73, 0x22, 0x8c, 0x01, 0x0a, 0x09, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65,
+	0x71, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x70, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x73, 0x67,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e,
+	0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61,
+	0x22, 0x4c, 0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x3e,
+	0x0a, 0x0a, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d,
+	0x73, 0x67, 0x52, 0x0a, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x22, 0x5d,
+	0x0a, 0x09, 0x50, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x22, 0x0c, 0x0a,
+	0x0a, 0x50, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x5f, 0x0a, 0x0b, 0x55,
+	0x6e, 0x70, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65,
+	0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x22, 0x0e, 0x0a, 0x0c,
+	0x55, 0x6e, 0x70, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x76, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x4d, 0x73, 0x67,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0xcb, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e,
+	0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0a, 0x70, 0x69,
+	0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73,
+	0x67, 0x73, 0x1a, 0x5e, 0x0a, 0x0f, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x69, 0x6e,
+	0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xd9, 0x01, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64,
+	0x54, 0x69, 0x70, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44,
+	0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x73, 0x50, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69,
+	0x73, 0x50, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x85,
+	0x01, 0x0a, 0x0b, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71,
+	0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x22, 0x46, 0x0a, 0x0c, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f,
+	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x58,
+	0x0a, 0x10, 0x50, 0x6f, 0x6c, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x64, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x22, 0xb6,
+	0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x6f, 0x6c, 0x6c,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x72,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0f,
+	0x6d, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x22, 0xed, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x50,
+	0x6f, 0x6c, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x69, 0x70, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d,
+	0x73, 0x67, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x6f,
+	0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x72,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x6f, 0x74,
+	0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc6, 0x02, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44,
+	0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44,
+	0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0xd8, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x71, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3f, 0x0a,
+	0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x85,
+	0x01, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x49, 0x44, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x53, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x22, 0x5e, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x42, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x0f,
+	0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x61, 0x69,
+	0x6e, 0x44, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x74,
+	0x61, 0x69, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x5f, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x44, 0x61,
+	0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6e,
+	0x44, 0x61, 0x79, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x42,
+	0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x22, 0x1b, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x76, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x72, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x40, 0x0a, 0x08, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78,
+	0x74, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0x3e, 0x0a, 0x12, 0x52,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x65,
+	0x71, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x15,
+	0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x3c, 0x0a,
+	0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67,
+	0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d,
+	0x69, 0x6e, 0x53, 0x65, 0x71, 0x22, 0x5b, 0x0a, 0x13, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67,
+	0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x09, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0xb6, 0x01, 0x0a, 0x0c, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x4c, 0x6f,
+	0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x67, 0x0a, 0x11, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1e,
+	0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4c, 0x65, 0x67, 0x61, 0x6c,
+	0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x69, 0x0a, 0x13, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x12,
+	0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x65,
+	0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x79, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x67,
+	0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x34, 0x0a, 0x05, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64,
+	0x52, 0x05, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c,
+	0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x12, 0x45, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73,
+	0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x63, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f,
+	0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x35, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x4c, 0x6f,
+	0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x32, 0x9a, 0x15, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x45,
+	0x78, 0x74, 0x12, 0x52, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x12,
+	0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d,
+	0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x71, 0x1a, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4c, 0x0a, 0x07, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x73,
+	0x67, 0x12, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x71, 0x1a, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x6a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x45, 0x64,
+	0x69, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x29, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x45, 0x64, 0x69, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x73,
+	0x67, 0x45, 0x64, 0x69, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x61, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74,
+	0x2e, 0x41, 0x64, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x6a, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x70, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x5e, 0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4d,
+	0x73, 0x67, 0x12, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x61, 0x0a, 0x0e, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4d,
+	0x73, 0x67, 0x73, 0x12, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78,
+	0x74, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x58, 0x0a, 0x0b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x4d, 0x73, 0x67, 0x12, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6d,
+	0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x4d, 0x73, 0x67, 0x12, 0x2a, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71,
+	0x1a, 0x2b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a,
+	0x0d, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x25,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x67, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x73, 0x12, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x49, 0x0a, 0x06, 0x50, 0x69, 0x6e, 0x4d, 0x73, 0x67,
+	0x12, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71,
+	0x1a, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x4f, 0x0a, 0x08, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x20, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67,
+	0x65, 0x78, 0x74, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a,
+	0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d,
+	0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x5e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d,
+	0x73, 0x67, 0x73, 0x12, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e,
+	0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x4f, 0x0a, 0x08, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x20,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x1a, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x73, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2c, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x73, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d,
+	0x73, 0x67, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x2c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2d,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6d, 0x0a,
+	0x12, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x2a, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x1a,
+	0x2b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d,
+	0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x76, 0x0a, 0x15,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x73, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x2c, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65,
+	0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x64, 0x0a, 0x0f, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x27, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65,
+	0x78, 0x74, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x61, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c,
+	0x64, 0x12, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4c, 0x65, 0x67,
+	0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x67, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x65, 0x67,
+	0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71,
+	0x1a, 0x29, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x65,
+	0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x25, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65,
+	0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x67,
+	0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x67, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x12,
+	0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d,
+	0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f,
+	0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65,
+	0x6e, 0x2d, 0x69, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_msgext_msgext_proto_rawDescOnce sync.Once
+	file_msgext_msgext_proto_rawDescData = file_msgext_msgext_proto_rawDesc
+)
+
+func file_msgext_msgext_proto_rawDescGZIP() []byte {
+	file_msgext_msgext_proto_rawDescOnce.Do(func() {
+		file_msgext_msgext_proto_rawDescData = protoimpl.X.CompressGZIP(file_msgext_msgext_proto_rawDescData)
+	})
+	return file_msgext_msgext_proto_rawDescData
+}
+
+var file_msgext_msgext_proto_msgTypes = make([]protoimpl.MessageInfo, 71)
+var file_msgext_msgext_proto_goTypes = []interface{}{
+	(*SearchMsgReq)(nil),              // 0: OpenIMServer.msgext.SearchMsgReq
+	(*SearchMsgResp)(nil),             // 1: OpenIMServer.msgext.SearchMsgResp
+	(*EditMsgReq)(nil),                // 2: OpenIMServer.msgext.EditMsgReq
+	(*EditMsgResp)(nil),               // 3: OpenIMServer.msgext.EditMsgResp
+	(*MsgEditedTips)(nil),             // 4: OpenIMServer.msgext.MsgEditedTips
+	(*MsgEditVersion)(nil),            // 5: OpenIMServer.msgext.MsgEditVersion
+	(*GetMsgEditHistoryReq)(nil),      // 6: OpenIMServer.msgext.GetMsgEditHistoryReq
+	(*GetMsgEditHistoryResp)(nil),     // 7: OpenIMServer.msgext.GetMsgEditHistoryResp
+	(*AddMsgReactionReq)(nil),         // 8: OpenIMServer.msgext.AddMsgReactionReq
+	(*AddMsgReactionResp)(nil),        // 9: OpenIMServer.msgext.AddMsgReactionResp
+	(*RemoveMsgReactionReq)(nil),      // 10: OpenIMServer.msgext.RemoveMsgReactionReq
+	(*RemoveMsgReactionResp)(nil),     // 11: OpenIMServer.msgext.RemoveMsgReactionResp
+	(*MsgReactionTips)(nil),           // 12: OpenIMServer.msgext.MsgReactionTips
+	(*GetMsgReactionUsersReq)(nil),    // 13: OpenIMServer.msgext.GetMsgReactionUsersReq
+	(*GetMsgReactionUsersResp)(nil),   // 14: OpenIMServer.msgext.GetMsgReactionUsersResp
+	(*SendThreadMsgReq)(nil),          // 15: OpenIMServer.msgext.SendThreadMsgReq
+	(*SendThreadMsgResp)(nil),         // 16: OpenIMServer.msgext.SendThreadMsgResp
+	(*MsgThreadReplyTips)(nil),        // 17: OpenIMServer.msgext.MsgThreadReplyTips
+	(*PullThreadMsgsReq)(nil),         // 18: OpenIMServer.msgext.PullThreadMsgsReq
+	(*PullThreadMsgsResp)(nil),        // 19: OpenIMServer.msgext.PullThreadMsgsResp
+	(*ScheduledMsg)(nil),              // 20: OpenIMServer.msgext.ScheduledMsg
+	(*ScheduleMsgReq)(nil),            // 21: OpenIMServer.msgext.ScheduleMsgReq
+	(*ScheduleMsgResp)(nil),           // 22: OpenIMServer.msgext.ScheduleMsgResp
+	(*CancelScheduledMsgReq)(nil),     // 23: OpenIMServer.msgext.CancelScheduledMsgReq
+	(*CancelScheduledMsgResp)(nil),    // 24: OpenIMServer.msgext.CancelScheduledMsgResp
+	(*RescheduleMsgReq)(nil),          // 25: OpenIMServer.msgext.RescheduleMsgReq
+	(*RescheduleMsgResp)(nil),         // 26: OpenIMServer.msgext.RescheduleMsgResp
+	(*GetScheduledMsgsReq)(nil),       // 27: OpenIMServer.msgext.GetScheduledMsgsReq
+	(*GetScheduledMsgsResp)(nil),      // 28: OpenIMServer.msgext.GetScheduledMsgsResp
+	(*PinnedMsg)(nil),                 // 29: OpenIMServer.msgext.PinnedMsg
+	(*PinnedMsgs)(nil),                // 30: OpenIMServer.msgext.PinnedMsgs
+	(*PinMsgReq)(nil),                 // 31: OpenIMServer.msgext.PinMsgReq
+	(*PinMsgResp)(nil),                // 32: OpenIMServer.msgext.PinMsgResp
+	(*UnpinMsgReq)(nil),               // 33: OpenIMServer.msgext.UnpinMsgReq
+	(*UnpinMsgResp)(nil),              // 34: OpenIMServer.msgext.UnpinMsgResp
+	(*GetPinnedMsgsReq)(nil),          // 35: OpenIMServer.msgext.GetPinnedMsgsReq
+	(*GetPinnedMsgsResp)(nil),         // 36: OpenIMServer.msgext.GetPinnedMsgsResp
+	(*MsgPinnedTips)(nil),             // 37: OpenIMServer.msgext.MsgPinnedTips
+	(*VotePollReq)(nil),               // 38: OpenIMServer.msgext.VotePollReq
+	(*VotePollResp)(nil),              // 39: OpenIMServer.msgext.VotePollResp
+	(*PollOptionResult)(nil),          // 40: OpenIMServer.msgext.PollOptionResult
+	(*GetPollResultReq)(nil),          // 41: OpenIMServer.msgext.GetPollResultReq
+	(*GetPollResultResp)(nil),         // 42: OpenIMServer.msgext.GetPollResultResp
+	(*MsgPollVoteTips)(nil),           // 43: OpenIMServer.msgext.MsgPollVoteTips
+	(*ModerationRecord)(nil),          // 44: OpenIMServer.msgext.ModerationRecord
+	(*GetModerationRecordsReq)(nil),   // 45: OpenIMServer.msgext.GetModerationRecordsReq
+	(*GetModerationRecordsResp)(nil),  // 46: OpenIMServer.msgext.GetModerationRecordsResp
+	(*MsgDeliveryStatus)(nil),         // 47: OpenIMServer.msgext.MsgDeliveryStatus
+	(*GetMsgDeliveryStatusReq)(nil),   // 48: OpenIMServer.msgext.GetMsgDeliveryStatusReq
+	(*GetMsgDeliveryStatusResp)(nil),  // 49: OpenIMServer.msgext.GetMsgDeliveryStatusResp
+	(*RetentionPolicy)(nil),           // 50: OpenIMServer.msgext.RetentionPolicy
+	(*SetRetentionPolicyReq)(nil),     // 51: OpenIMServer.msgext.SetRetentionPolicyReq
+	(*SetRetentionPolicyResp)(nil),    // 52: OpenIMServer.msgext.SetRetentionPolicyResp
+	(*DeleteRetentionPolicyReq)(nil),  // 53: OpenIMServer.msgext.DeleteRetentionPolicyReq
+	(*DeleteRetentionPolicyResp)(nil), // 54: OpenIMServer.msgext.DeleteRetentionPolicyResp
+	(*GetRetentionPoliciesReq)(nil),   // 55: OpenIMServer.msgext.GetRetentionPoliciesReq
+	(*GetRetentionPoliciesResp)(nil),  // 56: OpenIMServer.msgext.GetRetentionPoliciesResp
+	(*RetentionDryRunReq)(nil),        // 57: OpenIMServer.msgext.RetentionDryRunReq
+	(*RetentionDryRunResult)(nil),     // 58: OpenIMServer.msgext.RetentionDryRunResult
+	(*RetentionDryRunResp)(nil),       // 59: OpenIMServer.msgext.RetentionDryRunResp
+	(*LegalHold)(nil),                 // 60: OpenIMServer.msgext.LegalHold
+	(*LegalHoldLog)(nil),              // 61: OpenIMServer.msgext.LegalHoldLog
+	(*PlaceLegalHoldReq)(nil),         // 62: OpenIMServer.msgext.PlaceLegalHoldReq
+	(*PlaceLegalHoldResp)(nil),        // 63: OpenIMServer.msgext.PlaceLegalHoldResp
+	(*ReleaseLegalHoldReq)(nil),       // 64: OpenIMServer.msgext.ReleaseLegalHoldReq
+	(*ReleaseLegalHoldResp)(nil),      // 65: OpenIMServer.msgext.ReleaseLegalHoldResp
+	(*GetLegalHoldsReq)(nil),          // 66: OpenIMServer.msgext.GetLegalHoldsReq
+	(*GetLegalHoldsResp)(nil),         // 67: OpenIMServer.msgext.GetLegalHoldsResp
+	(*GetLegalHoldLogsReq)(nil),       // 68: OpenIMServer.msgext.GetLegalHoldLogsReq
+	(*GetLegalHoldLogsResp)(nil),      // 69: OpenIMServer.msgext.GetLegalHoldLogsResp
+	nil,                               // 70: OpenIMServer.msgext.GetPinnedMsgsResp.PinnedMsgsEntry
+	(*sdkws.RequestPagination)(nil),   // 71: OpenIMServer.sdkws.RequestPagination
+	(*msg.ChatLog)(nil),               // 72: OpenIMServer.msg.ChatLog
+	(*sdkws.MsgData)(nil),             // 73: OpenIMServer.sdkws.MsgData
+	(*msg.SendMsgReq)(nil),            // 74: OpenIMServer.msg.SendMsgReq
+}
+var file_msgext_msgext_proto_depIdxs = []int32{
+	71, // 0: OpenIMServer.msgext.SearchMsgReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	72, // 1: OpenIMServer.msgext.SearchMsgResp.chatLogs:type_name -> OpenIMServer.msg.ChatLog
+	5,  // 2: OpenIMServer.msgext.GetMsgEditHistoryResp.versions:type_name -> OpenIMServer.msgext.MsgEditVersion
+	71, // 3: OpenIMServer.msgext.GetMsgReactionUsersReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	73, // 4: OpenIMServer.msgext.SendThreadMsgReq.msgData:type_name -> OpenIMServer.sdkws.MsgData
+	73, // 5: OpenIMServer.msgext.MsgThreadReplyTips.reply:type_name -> OpenIMServer.sdkws.MsgData
+	73, // 6: OpenIMServer.msgext.PullThreadMsgsResp.msgs:type_name -> OpenIMServer.sdkws.MsgData
+	73, // 7: OpenIMServer.msgext.ScheduledMsg.msgData:type_name -> OpenIMServer.sdkws.MsgData
+	74, // 8: OpenIMServer.msgext.ScheduleMsgReq.sendMsgReq:type_name -> OpenIMServer.msg.SendMsgReq
+	71, // 9: OpenIMServer.msgext.GetScheduledMsgsReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	20, // 10: OpenIMServer.msgext.GetScheduledMsgsResp.scheduledMsgs:type_name -> OpenIMServer.msgext.ScheduledMsg
+	73, // 11: OpenIMServer.msgext.PinnedMsg.msgData:type_name -> OpenIMServer.sdkws.MsgData
+	29, // 12: OpenIMServer.msgext.PinnedMsgs.pinnedMsgs:type_name -> OpenIMServer.msgext.PinnedMsg
+	70, // 13: OpenIMServer.msgext.GetPinnedMsgsResp.pinnedMsgs:type_name -> OpenIMServer.msgext.GetPinnedMsgsResp.PinnedMsgsEntry
+	40, // 14: OpenIMServer.msgext.GetPollResultResp.options:type_name -> OpenIMServer.msgext.PollOptionResult
+	71, // 15: OpenIMServer.msgext.GetModerationRecordsReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	44, // 16: OpenIMServer.msgext.GetModerationRecordsResp.records:type_name -> OpenIMServer.msgext.ModerationRecord
+	47, // 17: OpenIMServer.msgext.GetMsgDeliveryStatusResp.statuses:type_name -> OpenIMServer.msgext.MsgDeliveryStatus
+	71, // 18: OpenIMServer.msgext.GetRetentionPoliciesReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	50, // 19: OpenIMServer.msgext.GetRetentionPoliciesResp.policies:type_name -> OpenIMServer.msgext.RetentionPolicy
+	50, // 20: OpenIMServer.msgext.RetentionDryRunResult.policy:type_name -> OpenIMServer.msgext.RetentionPolicy
+	58, // 21: OpenIMServer.msgext.RetentionDryRunResp.results:type_name -> OpenIMServer.msgext.RetentionDryRunResult
+	71, // 22: OpenIMServer.msgext.GetLegalHoldsReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	60, // 23: OpenIMServer.msgext.GetLegalHoldsResp.holds:type_name -> OpenIMServer.msgext.LegalHold
+	71, // 24: OpenIMServer.msgext.GetLegalHoldLogsReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	61, // 25: OpenIMServer.msgext.GetLegalHoldLogsResp.logs:type_name -> OpenIMServer.msgext.LegalHoldLog
+	30, // 26: OpenIMServer.msgext.GetPinnedMsgsResp.PinnedMsgsEntry.value:type_name -> OpenIMServer.msgext.PinnedMsgs
+	0,  // 27: OpenIMServer.msgext.msgExt.SearchMsg:input_type -> OpenIMServer.msgext.SearchMsgReq
+	2,  // 28: OpenIMServer.msgext.msgExt.EditMsg:input_type -> OpenIMServer.msgext.EditMsgReq
+	6,  // 29: OpenIMServer.msgext.msgExt.GetMsgEditHistory:input_type -> OpenIMServer.msgext.GetMsgEditHistoryReq
+	8,  // 30: OpenIMServer.msgext.msgExt.AddMsgReaction:input_type -> OpenIMServer.msgext.AddMsgReactionReq
+	10, // 31: OpenIMServer.msgext.msgExt.RemoveMsgReaction:input_type -> OpenIMServer.msgext.RemoveMsgReactionReq
+	13, // 32: OpenIMServer.msgext.msgExt.GetMsgReactionUsers:input_type -> OpenIMServer.msgext.GetMsgReactionUsersReq
+	15, // 33: OpenIMServer.msgext.msgExt.SendThreadMsg:input_type -> OpenIMServer.msgext.SendThreadMsgReq
+	18, // 34: OpenIMServer.msgext.msgExt.PullThreadMsgs:input_type -> OpenIMServer.msgext.PullThreadMsgsReq
+	21, // 35: OpenIMServer.msgext.msgExt.ScheduleMsg:input_type -> OpenIMServer.msgext.ScheduleMsgReq
+	23, // 36: OpenIMServer.msgext.msgExt.CancelScheduledMsg:input_type -> OpenIMServer.msgext.CancelScheduledMsgReq
+	25, // 37: OpenIMServer.msgext.msgExt.RescheduleMsg:input_type -> OpenIMServer.msgext.RescheduleMsgReq
+	27, // 38: OpenIMServer.msgext.msgExt.GetScheduledMsgs:input_type -> OpenIMServer.msgext.GetScheduledMsgsReq
+	31, // 39: OpenIMServer.msgext.msgExt.PinMsg:input_type -> OpenIMServer.msgext.PinMsgReq
+	33, // 40: OpenIMServer.msgext.msgExt.UnpinMsg:input_type -> OpenIMServer.msgext.UnpinMsgReq
+	35, // 41: OpenIMServer.msgext.msgExt.GetPinnedMsgs:input_type -> OpenIMServer.msgext.GetPinnedMsgsReq
+	38, // 42: OpenIMServer.msgext.msgExt.VotePoll:input_type -> OpenIMServer.msgext.VotePollReq
+	41, // 43: OpenIMServer.msgext.msgExt.GetPollResult:input_type -> OpenIMServer.msgext.GetPollResultReq
+	45, // 44: OpenIMServer.msgext.msgExt.GetModerationRecords:input_type -> OpenIMServer.msgext.GetModerationRecordsReq
+	48, // 45: OpenIMServer.msgext.msgExt.GetMsgDeliveryStatus:input_type -> OpenIMServer.msgext.GetMsgDeliveryStatusReq
+	51, // 46: OpenIMServer.msgext.msgExt.SetRetentionPolicy:input_type -> OpenIMServer.msgext.SetRetentionPolicyReq
+	53, // 47: OpenIMServer.msgext.msgExt.DeleteRetentionPolicy:input_type -> OpenIMServer.msgext.DeleteRetentionPolicyReq
+	55, // 48: OpenIMServer.msgext.msgExt.GetRetentionPolicies:input_type -> OpenIMServer.msgext.GetRetentionPoliciesReq
+	57, // 49: OpenIMServer.msgext.msgExt.RetentionDryRun:input_type -> OpenIMServer.msgext.RetentionDryRunReq
+	62, // 50: OpenIMServer.msgext.msgExt.PlaceLegalHold:input_type -> OpenIMServer.msgext.PlaceLegalHoldReq
+	64, // 51: OpenIMServer.msgext.msgExt.ReleaseLegalHold:input_type -> OpenIMServer.msgext.ReleaseLegalHoldReq
+	66, // 52: OpenIMServer.msgext.msgExt.GetLegalHolds:input_type -> OpenIMServer.msgext.GetLegalHoldsReq
+	68, // 53: OpenIMServer.msgext.msgExt.GetLegalHoldLogs:input_type -> OpenIMServer.msgext.GetLegalHoldLogsReq
+	1,  // 54: OpenIMServer.msgext.msgExt.SearchMsg:output_type -> OpenIMServer.msgext.SearchMsgResp
+	3,  // 55: OpenIMServer.msgext.msgExt.EditMsg:output_type -> OpenIMServer.msgext.EditMsgResp
+	7,  // 56: OpenIMServer.msgext.msgExt.GetMsgEditHistory:output_type -> OpenIMServer.msgext.GetMsgEditHistoryResp
+	9,  // 57: OpenIMServer.msgext.msgExt.AddMsgReaction:output_type -> OpenIMServer.msgext.AddMsgReactionResp
+	11, // 58: OpenIMServer.msgext.msgExt.RemoveMsgReaction:output_type -> OpenIMServer.msgext.RemoveMsgReactionResp
+	14, // 59: OpenIMServer.msgext.msgExt.GetMsgReactionUsers:output_type -> OpenIMServer.msgext.GetMsgReactionUsersResp
+	16, // 60: OpenIMServer.msgext.msgExt.SendThreadMsg:output_type -> OpenIMServer.msgext.SendThreadMsgResp
+	19, // 61: OpenIMServer.msgext.msgExt.PullThreadMsgs:output_type -> OpenIMServer.msgext.PullThreadMsgsResp
+	22, // 62: OpenIMServer.msgext.msgExt.ScheduleMsg:output_type -> OpenIMServer.msgext.ScheduleMsgResp
+	24, // 63: OpenIMServer.msgext.msgExt.CancelScheduledMsg:output_type -> OpenIMServer.msgext.CancelScheduledMsgResp
+	26, // 64: OpenIMServer.msgext.msgExt.RescheduleMsg:output_type -> OpenIMServer.msgext.RescheduleMsgResp
+	28, // 65: OpenIMServer.msgext.msgExt.GetScheduledMsgs:output_type -> OpenIMServer.msgext.GetScheduledMsgsResp
+	32, // 66: OpenIMServer.msgext.msgExt.PinMsg:output_type -> OpenIMServer.msgext.PinMsgResp
+	34, // 67: OpenIMServer.msgext.msgExt.UnpinMsg:output_type -> OpenIMServer.msgext.UnpinMsgResp
+	36, // 68: OpenIMServer.msgext.msgExt.GetPinnedMsgs:output_type -> OpenIMServer.msgext.GetPinnedMsgsResp
+	39, // 69: OpenIMServer.msgext.msgExt.VotePoll:output_type -> OpenIMServer.msgext.VotePollResp
+	42, // 70: OpenIMServer.msgext.msgExt.GetPollResult:output_type -> OpenIMServer.msgext.GetPollResultResp
+	46, // 71: OpenIMServer.msgext.msgExt.GetModerationRecords:output_type -> OpenIMServer.msgext.GetModerationRecordsResp
+	49, // 72: OpenIMServer.msgext.msgExt.GetMsgDeliveryStatus:output_type -> OpenIMServer.msgext.GetMsgDeliveryStatusResp
+	52, // 73: OpenIMServer.msgext.msgExt.SetRetentionPolicy:output_type -> OpenIMServer.msgext.SetRetentionPolicyResp
+	54, // 74: OpenIMServer.msgext.msgExt.DeleteRetentionPolicy:output_type -> OpenIMServer.msgext.DeleteRetentionPolicyResp
+	56, // 75: OpenIMServer.msgext.msgExt.GetRetentionPolicies:output_type -> OpenIMServer.msgext.GetRetentionPoliciesResp
+	59, // 76: OpenIMServer.msgext.msgExt.RetentionDryRun:output_type -> OpenIMServer.msgext.RetentionDryRunResp
+	63, // 77: OpenIMServer.msgext.msgExt.PlaceLegalHold:output_type -> OpenIMServer.msgext.PlaceLegalHoldResp
+	65, // 78: OpenIMServer.msgext.msgExt.ReleaseLegalHold:output_type -> OpenIMServer.msgext.ReleaseLegalHoldResp
+	67, // 79: OpenIMServer.msgext.msgExt.GetLegalHolds:output_type -> OpenIMServer.msgext.GetLegalHoldsResp
+	69, // 80: OpenIMServer.msgext.msgExt.GetLegalHoldLogs:output_type -> OpenIMServer.msgext.GetLegalHoldLogsResp
+	54, // [54:81] is the sub-list for method output_type
+	27, // [27:54] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
+}
+
+func init() { file_msgext_msgext_proto_init() }
+func file_msgext_msgext_proto_init() {
+	if File_msgext_msgext_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_msgext_msgext_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgEditedTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgEditVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMsgEditHistoryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMsgEditHistoryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddMsgReactionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddMsgReactionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveMsgReactionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveMsgReactionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgReactionTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMsgReactionUsersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMsgReactionUsersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendThreadMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendThreadMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgThreadReplyTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullThreadMsgsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullThreadMsgsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescheduleMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescheduleMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduledMsgsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduledMsgsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinnedMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinnedMsgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpinMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpinMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPinnedMsgsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPinnedMsgsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgPinnedTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VotePollReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VotePollResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollOptionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPollResultReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPollResultResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgPollVoteTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModerationRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetModerationRecordsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetModerationRecordsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDeliveryStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMsgDeliveryStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMsgDeliveryStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetentionPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRetentionPolicyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRetentionPolicyResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRetentionPolicyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRetentionPolicyResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRetentionPoliciesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRetentionPoliciesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetentionDryRunReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetentionDryRunResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetentionDryRunResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LegalHold); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LegalHoldLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaceLegalHoldReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaceLegalHoldResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseLegalHoldReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseLegalHoldResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLegalHoldsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLegalHoldsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLegalHoldLogsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msgext_msgext_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLegalHoldLogsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_msgext_msgext_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   71,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_msgext_msgext_proto_goTypes,
+		DependencyIndexes: file_msgext_msgext_proto_depIdxs,
+		MessageInfos:      file_msgext_msgext_proto_msgTypes,
+	}.Build()
+	File_msgext_msgext_proto = out.File
+	file_msgext_msgext_proto_rawDesc = nil
+	file_msgext_msgext_proto_goTypes = nil
+	file_msgext_msgext_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// MsgExtClient is the client API for MsgExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgExtClient interface {
+	// 全文检索消息
+	SearchMsg(ctx context.Context, in *SearchMsgReq, opts ...grpc.CallOption) (*SearchMsgResp, error)
+	// 编辑消息
+	EditMsg(ctx context.Context, in *EditMsgReq, opts ...grpc.CallOption) (*EditMsgResp, error)
+	// 获取消息编辑历史
+	GetMsgEditHistory(ctx context.Context, in *GetMsgEditHistoryReq, opts ...grpc.CallOption) (*GetMsgEditHistoryResp, error)
+	// 添加表情回应
+	AddMsgReaction(ctx context.Context, in *AddMsgReactionReq, opts ...grpc.CallOption) (*AddMsgReactionResp, error)
+	// 取消表情回应
+	RemoveMsgReaction(ctx context.Context, in *RemoveMsgReactionReq, opts ...grpc.CallOption) (*RemoveMsgReactionResp, error)
+	// 分页获取回应某个表情的用户
+	GetMsgReactionUsers(ctx context.Context, in *GetMsgReactionUsersReq, opts ...grpc.CallOption) (*GetMsgReactionUsersResp, error)
+	// 发送话题回复
+	SendThreadMsg(ctx context.Context, in *SendThreadMsgReq, opts ...grpc.CallOption) (*SendThreadMsgResp, error)
+	// 拉取话题回复
+	PullThreadMsgs(ctx context.Context, in *PullThreadMsgsReq, opts ...grpc.CallOption) (*PullThreadMsgsResp, error)
+	// 提交定时消息
+	ScheduleMsg(ctx context.Context, in *ScheduleMsgReq, opts ...grpc.CallOption) (*ScheduleMsgResp, error)
+	// 取消定时消息
+	CancelScheduledMsg(ctx context.Context, in *CancelScheduledMsgReq, opts ...grpc.CallOption) (*CancelScheduledMsgResp, error)
+	// 修改定时消息的发送时间
+	RescheduleMsg(ctx context.Context, in *RescheduleMsgReq, opts ...grpc.CallOption) (*RescheduleMsgResp, error)
+	// 分页获取用户的定时消息
+	GetScheduledMsgs(ctx context.Context, in *GetScheduledMsgsReq, opts ...grpc.CallOption) (*GetScheduledMsgsResp, error)
+	// 置顶消息
+	PinMsg(ctx context.Context, in *PinMsgReq, opts ...grpc.CallOption) (*PinMsgResp, error)
+	// 取消置顶消息
+	UnpinMsg(ctx context.Context, in *UnpinMsgReq, opts ...grpc.CallOption) (*UnpinMsgResp, error)
+	// 批量获取会话的置顶消息
+	GetPinnedMsgs(ctx context.Context, in *GetPinnedMsgsReq, opts ...grpc.CallOption) (*GetPinnedMsgsResp, error)
+	// 投票
+	VotePoll(ctx context.Context, in *VotePollReq, opts ...grpc.CallOption) (*VotePollResp, error)
+	// 获取投票结果
+	GetPollResult(ctx context.Context, in *GetPollResultReq, opts ...grpc.CallOption) (*GetPollResultResp, error)
+	// 查询被标记待审核的消息
+	GetModerationRecords(ctx context.Context, in *GetModerationRecordsReq, opts ...grpc.CallOption) (*GetModerationRecordsResp, error)
+	// 获取消息对每个接收者的投递状态
+	GetMsgDeliveryStatus(ctx context.Context, in *GetMsgDeliveryStatusReq, opts ...grpc.CallOption) (*GetMsgDeliveryStatusResp, error)
+	// 设置消息保留策略
+	SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyReq, opts ...grpc.CallOption) (*SetRetentionPolicyResp, error)
+	// 删除消息保留策略
+	DeleteRetentionPolicy(ctx context.Context, in *DeleteRetentionPolicyReq, opts ...grpc.CallOption) (*DeleteRetentionPolicyResp, error)
+	// 分页获取消息保留策略
+	GetRetentionPolicies(ctx context.Context, in *GetRetentionPoliciesReq, opts ...grpc.CallOption) (*GetRetentionPoliciesResp, error)
+	// 预览按保留策略清理会话时将删除的消息
+	RetentionDryRun(ctx context.Context, in *RetentionDryRunReq, opts ...grpc.CallOption) (*RetentionDryRunResp, error)
+	// 设置法律保留
+	PlaceLegalHold(ctx context.Context, in *PlaceLegalHoldReq, opts ...grpc.CallOption) (*PlaceLegalHoldResp, error)
+	// 解除法律保留
+	ReleaseLegalHold(ctx context.Context, in *ReleaseLegalHoldReq, opts ...grpc.CallOption) (*ReleaseLegalHoldResp, error)
+	// 分页获取法律保留
+	GetLegalHolds(ctx context.Context, in *GetLegalHoldsReq, opts ...grpc.CallOption) (*GetLegalHoldsResp, error)
+	// 查询法律保留审计日志
+	GetLegalHoldLogs(ctx context.Context, in *GetLegalHoldLogsReq, opts ...grpc.CallOption) (*GetLegalHoldLogsResp, error)
+}
+
+type msgExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgExtClient(cc grpc.ClientConnInterface) MsgExtClient {
+	return &msgExtClient{cc}
+}
+
+func (c *msgExtClient) SearchMsg(ctx context.Context, in *SearchMsgReq, opts ...grpc.CallOption) (*SearchMsgResp, error) {
+	out := new(SearchMsgResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/SearchMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) EditMsg(ctx context.Context, in *EditMsgReq, opts ...grpc.CallOption) (*EditMsgResp, error) {
+	out := new(EditMsgResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/EditMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetMsgEditHistory(ctx context.Context, in *GetMsgEditHistoryReq, opts ...grpc.CallOption) (*GetMsgEditHistoryResp, error) {
+	out := new(GetMsgEditHistoryResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/GetMsgEditHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) AddMsgReaction(ctx context.Context, in *AddMsgReactionReq, opts ...grpc.CallOption) (*AddMsgReactionResp, error) {
+	out := new(AddMsgReactionResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/AddMsgReaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) RemoveMsgReaction(ctx context.Context, in *RemoveMsgReactionReq, opts ...grpc.CallOption) (*RemoveMsgReactionResp, error) {
+	out := new(RemoveMsgReactionResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/RemoveMsgReaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetMsgReactionUsers(ctx context.Context, in *GetMsgReactionUsersReq, opts ...grpc.CallOption) (*GetMsgReactionUsersResp, error) {
+	out := new(GetMsgReactionUsersResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/GetMsgReactionUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) SendThreadMsg(ctx context.Context, in *SendThreadMsgReq, opts ...grpc.CallOption) (*SendThreadMsgResp, error) {
+	out := new(SendThreadMsgResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/SendThreadMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) PullThreadMsgs(ctx context.Context, in *PullThreadMsgsReq, opts ...grpc.CallOption) (*PullThreadMsgsResp, error) {
+	out := new(PullThreadMsgsResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/PullThreadMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) ScheduleMsg(ctx context.Context, in *ScheduleMsgReq, opts ...grpc.CallOption) (*ScheduleMsgResp, error) {
+	out := new(ScheduleMsgResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/ScheduleMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) CancelScheduledMsg(ctx context.Context, in *CancelScheduledMsgReq, opts ...grpc.CallOption) (*CancelScheduledMsgResp, error) {
+	out := new(CancelScheduledMsgResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/CancelScheduledMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) RescheduleMsg(ctx context.Context, in *RescheduleMsgReq, opts ...grpc.CallOption) (*RescheduleMsgResp, error) {
+	out := new(RescheduleMsgResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/RescheduleMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetScheduledMsgs(ctx context.Context, in *GetScheduledMsgsReq, opts ...grpc.CallOption) (*GetScheduledMsgsResp, error) {
+	out := new(GetScheduledMsgsResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/GetScheduledMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) PinMsg(ctx context.Context, in *PinMsgReq, opts ...grpc.CallOption) (*PinMsgResp, error) {
+	out := new(PinMsgResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/PinMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) UnpinMsg(ctx context.Context, in *UnpinMsgReq, opts ...grpc.CallOption) (*UnpinMsgResp, error) {
+	out := new(UnpinMsgResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/UnpinMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetPinnedMsgs(ctx context.Context, in *GetPinnedMsgsReq, opts ...grpc.CallOption) (*GetPinnedMsgsResp, error) {
+	out := new(GetPinnedMsgsResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/GetPinnedMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) VotePoll(ctx context.Context, in *VotePollReq, opts ...grpc.CallOption) (*VotePollResp, error) {
+	out := new(VotePollResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/VotePoll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetPollResult(ctx context.Context, in *GetPollResultReq, opts ...grpc.CallOption) (*GetPollResultResp, error) {
+	out := new(GetPollResultResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/GetPollResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetModerationRecords(ctx context.Context, in *GetModerationRecordsReq, opts ...grpc.CallOption) (*GetModerationRecordsResp, error) {
+	out := new(GetModerationRecordsResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/GetModerationRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetMsgDeliveryStatus(ctx context.Context, in *GetMsgDeliveryStatusReq, opts ...grpc.CallOption) (*GetMsgDeliveryStatusResp, error) {
+	out := new(GetMsgDeliveryStatusResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/GetMsgDeliveryStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyReq, opts ...grpc.CallOption) (*SetRetentionPolicyResp, error) {
+	out := new(SetRetentionPolicyResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/SetRetentionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) DeleteRetentionPolicy(ctx context.Context, in *DeleteRetentionPolicyReq, opts ...grpc.CallOption) (*DeleteRetentionPolicyResp, error) {
+	out := new(DeleteRetentionPolicyResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/DeleteRetentionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetRetentionPolicies(ctx context.Context, in *GetRetentionPoliciesReq, opts ...grpc.CallOption) (*GetRetentionPoliciesResp, error) {
+	out := new(GetRetentionPoliciesResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/GetRetentionPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) RetentionDryRun(ctx context.Context, in *RetentionDryRunReq, opts ...grpc.CallOption) (*RetentionDryRunResp, error) {
+	out := new(RetentionDryRunResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/RetentionDryRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) PlaceLegalHold(ctx context.Context, in *PlaceLegalHoldReq, opts ...grpc.CallOption) (*PlaceLegalHoldResp, error) {
+	out := new(PlaceLegalHoldResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/PlaceLegalHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) ReleaseLegalHold(ctx context.Context, in *ReleaseLegalHoldReq, opts ...grpc.CallOption) (*ReleaseLegalHoldResp, error) {
+	out := new(ReleaseLegalHoldResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/ReleaseLegalHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetLegalHolds(ctx context.Context, in *GetLegalHoldsReq, opts ...grpc.CallOption) (*GetLegalHoldsResp, error) {
+	out := new(GetLegalHoldsResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/GetLegalHolds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetLegalHoldLogs(ctx context.Context, in *GetLegalHoldLogsReq, opts ...grpc.CallOption) (*GetLegalHoldLogsResp, error) {
+	out := new(GetLegalHoldLogsResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msgext.msgExt/GetLegalHoldLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgExtServer is the server API for MsgExt service.
+type MsgExtServer interface {
+	// 全文检索消息
+	SearchMsg(context.Context, *SearchMsgReq) (*SearchMsgResp, error)
+	// 编辑消息
+	EditMsg(context.Context, *EditMsgReq) (*EditMsgResp, error)
+	// 获取消息编辑历史
+	GetMsgEditHistory(context.Context, *GetMsgEditHistoryReq) (*GetMsgEditHistoryResp, error)
+	// 添加表情回应
+	AddMsgReaction(context.Context, *AddMsgReactionReq) (*AddMsgReactionResp, error)
+	// 取消表情回应
+	RemoveMsgReaction(context.Context, *RemoveMsgReactionReq) (*RemoveMsgReactionResp, error)
+	// 分页获取回应某个表情的用户
+	GetMsgReactionUsers(context.Context, *GetMsgReactionUsersReq) (*GetMsgReactionUsersResp, error)
+	// 发送话题回复
+	SendThreadMsg(context.Context, *SendThreadMsgReq) (*SendThreadMsgResp, error)
+	// 拉取话题回复
+	PullThreadMsgs(context.Context, *PullThreadMsgsReq) (*PullThreadMsgsResp, error)
+	// 提交定时消息
+	ScheduleMsg(context.Context, *ScheduleMsgReq) (*ScheduleMsgResp, error)
+	// 取消定时消息
+	CancelScheduledMsg(context.Context, *CancelScheduledMsgReq) (*CancelScheduledMsgResp, error)
+	// 修改定时消息的发送时间
+	RescheduleMsg(context.Context, *RescheduleMsgReq) (*RescheduleMsgResp, error)
+	// 分页获取用户的定时消息
+	GetScheduledMsgs(context.Context, *GetScheduledMsgsReq) (*GetScheduledMsgsResp, error)
+	// 置顶消息
+	PinMsg(context.Context, *PinMsgReq) (*PinMsgResp, error)
+	// 取消置顶消息
+	UnpinMsg(context.Context, *UnpinMsgReq) (*UnpinMsgResp, error)
+	// 批量获取会话的置顶消息
+	GetPinnedMsgs(context.Context, *GetPinnedMsgsReq) (*GetPinnedMsgsResp, error)
+	// 投票
+	VotePoll(context.Context, *VotePollReq) (*VotePollResp, error)
+	// 获取投票结果
+	GetPollResult(context.Context, *GetPollResultReq) (*GetPollResultResp, error)
+	// 查询被标记待审核的消息
+	GetModerationRecords(context.Context, *GetModerationRecordsReq) (*GetModerationRecordsResp, error)
+	// 获取消息对每个接收者的投递状态
+	GetMsgDeliveryStatus(context.Context, *GetMsgDeliveryStatusReq) (*GetMsgDeliveryStatusResp, error)
+	// 设置消息保留策略
+	SetRetentionPolicy(context.Context, *SetRetentionPolicyReq) (*SetRetentionPolicyResp, error)
+	// 删除消息保留策略
+	DeleteRetentionPolicy(context.Context, *DeleteRetentionPolicyReq) (*DeleteRetentionPolicyResp, error)
+	// 分页获取消息保留策略
+	GetRetentionPolicies(context.Context, *GetRetentionPoliciesReq) (*GetRetentionPoliciesResp, error)
+	// 预览按保留策略清理会话时将删除的消息
+	RetentionDryRun(context.Context, *RetentionDryRunReq) (*RetentionDryRunResp, error)
+	// 设置法律保留
+	PlaceLegalHold(context.Context, *PlaceLegalHoldReq) (*PlaceLegalHoldResp, error)
+	// 解除法律保留
+	ReleaseLegalHold(context.Context, *ReleaseLegalHoldReq) (*ReleaseLegalHoldResp, error)
+	// 分页获取法律保留
+	GetLegalHolds(context.Context, *GetLegalHoldsReq) (*GetLegalHoldsResp, error)
+	// 查询法律保留审计日志
+	GetLegalHoldLogs(context.Context, *GetLegalHoldLogsReq) (*GetLegalHoldLogsResp, error)
+}
+
+// UnimplementedMsgExtServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgExtServer struct {
+}
+
+func (*UnimplementedMsgExtServer) SearchMsg(context.Context, *SearchMsgReq) (*SearchMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMsg not implemented")
+}
+func (*UnimplementedMsgExtServer) EditMsg(context.Context, *EditMsgReq) (*EditMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditMsg not implemented")
+}
+func (*UnimplementedMsgExtServer) GetMsgEditHistory(context.Context, *GetMsgEditHistoryReq) (*GetMsgEditHistoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMsgEditHistory not implemented")
+}
+func (*UnimplementedMsgExtServer) AddMsgReaction(context.Context, *AddMsgReactionReq) (*AddMsgReactionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMsgReaction not implemented")
+}
+func (*UnimplementedMsgExtServer) RemoveMsgReaction(context.Context, *RemoveMsgReactionReq) (*RemoveMsgReactionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMsgReaction not implemented")
+}
+func (*UnimplementedMsgExtServer) GetMsgReactionUsers(context.Context, *GetMsgReactionUsersReq) (*GetMsgReactionUsersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMsgReactionUsers not implemented")
+}
+func (*UnimplementedMsgExtServer) SendThreadMsg(context.Context, *SendThreadMsgReq) (*SendThreadMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendThreadMsg not implemented")
+}
+func (*UnimplementedMsgExtServer) PullThreadMsgs(context.Context, *PullThreadMsgsReq) (*PullThreadMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullThreadMsgs not implemented")
+}
+func (*UnimplementedMsgExtServer) ScheduleMsg(context.Context, *ScheduleMsgReq) (*ScheduleMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMsg not implemented")
+}
+func (*UnimplementedMsgExtServer) CancelScheduledMsg(context.Context, *CancelScheduledMsgReq) (*CancelScheduledMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMsg not implemented")
+}
+func (*UnimplementedMsgExtServer) RescheduleMsg(context.Context, *RescheduleMsgReq) (*RescheduleMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleMsg not implemented")
+}
+func (*UnimplementedMsgExtServer) GetScheduledMsgs(context.Context, *GetScheduledMsgsReq) (*GetScheduledMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledMsgs not implemented")
+}
+func (*UnimplementedMsgExtServer) PinMsg(context.Context, *PinMsgReq) (*PinMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinMsg not implemented")
+}
+func (*UnimplementedMsgExtServer) UnpinMsg(context.Context, *UnpinMsgReq) (*UnpinMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinMsg not implemented")
+}
+func (*UnimplementedMsgExtServer) GetPinnedMsgs(context.Context, *GetPinnedMsgsReq) (*GetPinnedMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPinnedMsgs not implemented")
+}
+func (*UnimplementedMsgExtServer) VotePoll(context.Context, *VotePollReq) (*VotePollResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotePoll not implemented")
+}
+func (*UnimplementedMsgExtServer) GetPollResult(context.Context, *GetPollResultReq) (*GetPollResultResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPollResult not implemented")
+}
+func (*UnimplementedMsgExtServer) GetModerationRecords(context.Context, *GetModerationRecordsReq) (*GetModerationRecordsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModerationRecords not implemented")
+}
+func (*UnimplementedMsgExtServer) GetMsgDeliveryStatus(context.Context, *GetMsgDeliveryStatusReq) (*GetMsgDeliveryStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMsgDeliveryStatus not implemented")
+}
+func (*UnimplementedMsgExtServer) SetRetentionPolicy(context.Context, *SetRetentionPolicyReq) (*SetRetentionPolicyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRetentionPolicy not implemented")
+}
+func (*UnimplementedMsgExtServer) DeleteRetentionPolicy(context.Context, *DeleteRetentionPolicyReq) (*DeleteRetentionPolicyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRetentionPolicy not implemented")
+}
+func (*UnimplementedMsgExtServer) GetRetentionPolicies(context.Context, *GetRetentionPoliciesReq) (*GetRetentionPoliciesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRetentionPolicies not implemented")
+}
+func (*UnimplementedMsgExtServer) RetentionDryRun(context.Context, *RetentionDryRunReq) (*RetentionDryRunResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetentionDryRun not implemented")
+}
+func (*UnimplementedMsgExtServer) PlaceLegalHold(context.Context, *PlaceLegalHoldReq) (*PlaceLegalHoldResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceLegalHold not implemented")
+}
+func (*UnimplementedMsgExtServer) ReleaseLegalHold(context.Context, *ReleaseLegalHoldReq) (*ReleaseLegalHoldResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseLegalHold not implemented")
+}
+func (*UnimplementedMsgExtServer) GetLegalHolds(context.Context, *GetLegalHoldsReq) (*GetLegalHoldsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLegalHolds not implemented")
+}
+func (*UnimplementedMsgExtServer) GetLegalHoldLogs(context.Context, *GetLegalHoldLogsReq) (*GetLegalHoldLogsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLegalHoldLogs not implemented")
+}
+
+func RegisterMsgExtServer(s *grpc.Server, srv MsgExtServer) {
+	s.RegisterService(&_MsgExt_serviceDesc, srv)
+}
+
+func _MsgExt_SearchMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).SearchMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/SearchMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).SearchMsg(ctx, req.(*SearchMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_EditMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).EditMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/EditMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).EditMsg(ctx, req.(*EditMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetMsgEditHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMsgEditHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetMsgEditHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/GetMsgEditHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetMsgEditHistory(ctx, req.(*GetMsgEditHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_AddMsgReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMsgReactionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).AddMsgReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/AddMsgReaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).AddMsgReaction(ctx, req.(*AddMsgReactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_RemoveMsgReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMsgReactionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).RemoveMsgReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/RemoveMsgReaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).RemoveMsgReaction(ctx, req.(*RemoveMsgReactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetMsgReactionUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMsgReactionUsersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetMsgReactionUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/GetMsgReactionUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetMsgReactionUsers(ctx, req.(*GetMsgReactionUsersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_SendThreadMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendThreadMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).SendThreadMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/SendThreadMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).SendThreadMsg(ctx, req.(*SendThreadMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_PullThreadMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullThreadMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).PullThreadMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/PullThreadMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).PullThreadMsgs(ctx, req.(*PullThreadMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_ScheduleMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).ScheduleMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/ScheduleMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).ScheduleMsg(ctx, req.(*ScheduleMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_CancelScheduledMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).CancelScheduledMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/CancelScheduledMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).CancelScheduledMsg(ctx, req.(*CancelScheduledMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_RescheduleMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).RescheduleMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/RescheduleMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).RescheduleMsg(ctx, req.(*RescheduleMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetScheduledMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetScheduledMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/GetScheduledMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetScheduledMsgs(ctx, req.(*GetScheduledMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_PinMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).PinMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/PinMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).PinMsg(ctx, req.(*PinMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_UnpinMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).UnpinMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/UnpinMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).UnpinMsg(ctx, req.(*UnpinMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetPinnedMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPinnedMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetPinnedMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/GetPinnedMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetPinnedMsgs(ctx, req.(*GetPinnedMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_VotePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VotePollReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).VotePoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/VotePoll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).VotePoll(ctx, req.(*VotePollReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetPollResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPollResultReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetPollResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/GetPollResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetPollResult(ctx, req.(*GetPollResultReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetModerationRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModerationRecordsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetModerationRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/GetModerationRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetModerationRecords(ctx, req.(*GetModerationRecordsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetMsgDeliveryStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMsgDeliveryStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetMsgDeliveryStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/GetMsgDeliveryStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetMsgDeliveryStatus(ctx, req.(*GetMsgDeliveryStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_SetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRetentionPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).SetRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/SetRetentionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).SetRetentionPolicy(ctx, req.(*SetRetentionPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_DeleteRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRetentionPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).DeleteRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/DeleteRetentionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).DeleteRetentionPolicy(ctx, req.(*DeleteRetentionPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetRetentionPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRetentionPoliciesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetRetentionPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/GetRetentionPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetRetentionPolicies(ctx, req.(*GetRetentionPoliciesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_RetentionDryRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetentionDryRunReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).RetentionDryRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/RetentionDryRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).RetentionDryRun(ctx, req.(*RetentionDryRunReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_PlaceLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceLegalHoldReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).PlaceLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/PlaceLegalHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).PlaceLegalHold(ctx, req.(*PlaceLegalHoldReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_ReleaseLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseLegalHoldReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).ReleaseLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/ReleaseLegalHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).ReleaseLegalHold(ctx, req.(*ReleaseLegalHoldReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetLegalHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLegalHoldsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetLegalHolds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/GetLegalHolds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetLegalHolds(ctx, req.(*GetLegalHoldsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetLegalHoldLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLegalHoldLogsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetLegalHoldLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msgext.msgExt/GetLegalHoldLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetLegalHoldLogs(ctx, req.(*GetLegalHoldLogsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MsgExt_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "OpenIMServer.msgext.msgExt",
+	HandlerType: (*MsgExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SearchMsg",
+			Handler:    _MsgExt_SearchMsg_Handler,
+		},
+		{
+			MethodName: "EditMsg",
+			Handler:    _MsgExt_EditMsg_Handler,
+		},
+		{
+			MethodName: "GetMsgEditHistory",
+			Handler:    _MsgExt_GetMsgEditHistory_Handler,
+		},
+		{
+			MethodName: "AddMsgReaction",
+			Handler:    _MsgExt_AddMsgReaction_Handler,
+		},
+		{
+			MethodName: "RemoveMsgReaction",
+			Handler:    _MsgExt_RemoveMsgReaction_Handler,
+		},
+		{
+			MethodName: "GetMsgReactionUsers",
+			Handler:    _MsgExt_GetMsgReactionUsers_Handler,
+		},
+		{
+			MethodName: "SendThreadMsg",
+			Handler:    _MsgExt_SendThreadMsg_Handler,
+		},
+		{
+			MethodName: "PullThreadMsgs",
+			Handler:    _MsgExt_PullThreadMsgs_Handler,
+		},
+		{
+			MethodName: "ScheduleMsg",
+			Handler:    _MsgExt_ScheduleMsg_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMsg",
+			Handler:    _MsgExt_CancelScheduledMsg_Handler,
+		},
+		{
+			MethodName: "RescheduleMsg",
+			Handler:    _MsgExt_RescheduleMsg_Handler,
+		},
+		{
+			MethodName: "GetScheduledMsgs",
+			Handler:    _MsgExt_GetScheduledMsgs_Handler,
+		},
+		{
+			MethodName: "PinMsg",
+			Handler:    _MsgExt_PinMsg_Handler,
+		},
+		{
+			MethodName: "UnpinMsg",
+			Handler:    _MsgExt_UnpinMsg_Handler,
+		},
+		{
+			MethodName: "GetPinnedMsgs",
+			Handler:    _MsgExt_GetPinnedMsgs_Handler,
+		},
+		{
+			MethodName: "VotePoll",
+			Handler:    _MsgExt_VotePoll_Handler,
+		},
+		{
+			MethodName: "GetPollResult",
+			Handler:    _MsgExt_GetPollResult_Handler,
+		},
+		{
+			MethodName: "GetModerationRecords",
+			Handler:    _MsgExt_GetModerationRecords_Handler,
+		},
+		{
+			MethodName: "GetMsgDeliveryStatus",
+			Handler:    _MsgExt_GetMsgDeliveryStatus_Handler,
+		},
+		{
+			MethodName: "SetRetentionPolicy",
+			Handler:    _MsgExt_SetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteRetentionPolicy",
+			Handler:    _MsgExt_DeleteRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "GetRetentionPolicies",
+			Handler:    _MsgExt_GetRetentionPolicies_Handler,
+		},
+		{
+			MethodName: "RetentionDryRun",
+			Handler:    _MsgExt_RetentionDryRun_Handler,
+		},
+		{
+			MethodName: "PlaceLegalHold",
+			Handler:    _MsgExt_PlaceLegalHold_Handler,
+		},
+		{
+			MethodName: "ReleaseLegalHold",
+			Handler:    _MsgExt_ReleaseLegalHold_Handler,
+		},
+		{
+			MethodName: "GetLegalHolds",
+			Handler:    _MsgExt_GetLegalHolds_Handler,
+		},
+		{
+			MethodName: "GetLegalHoldLogs",
+			Handler:    _MsgExt_GetLegalHoldLogs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "msgext/msgext.proto",
+}
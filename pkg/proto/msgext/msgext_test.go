@@ -0,0 +1,31 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgext
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckEmoji(t *testing.T) {
+	for _, emoji := range []string{"👍", "👨‍👩‍👧", "❤️", ":party_parrot:"} {
+		assert.NoError(t, checkEmoji(emoji), emoji)
+	}
+	for _, emoji := range []string{"", "a.b", "$set", "a b", "a\x00", "\xff", strings.Repeat("a", maxEmojiLen+1)} {
+		assert.Error(t, checkEmoji(emoji), emoji)
+	}
+}
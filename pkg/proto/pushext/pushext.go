@@ -0,0 +1,31 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pushext
+
+import "errors"
+
+func (x *GetOfflinePushDeadLettersReq) Check() error {
+	if x.Pagination == nil || x.Pagination.PageNumber < 1 || x.Pagination.ShowNumber < 1 {
+		return errors.New("pagination is invalid")
+	}
+	return nil
+}
+
+func (x *ReplayOfflinePushDeadLettersReq) Check() error {
+	if len(x.Ids) == 0 {
+		return errors.New("ids is empty")
+	}
+	return nil
+}
@@ -0,0 +1,680 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.22.0
+// source: pushext/pushext.proto
+
+package pushext
+
+import (
+	context "context"
+	sdkws "github.com/OpenIMSDK/protocol/sdkws"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OfflinePushDeadLetter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	ConversationID string   `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
+	UserIDs        []string `protobuf:"bytes,3,rep,name=userIDs,proto3" json:"userIDs"`
+	ClientMsgID    string   `protobuf:"bytes,4,opt,name=clientMsgID,proto3" json:"clientMsgID"`
+	SendID         string   `protobuf:"bytes,5,opt,name=sendID,proto3" json:"sendID"`
+	ContentType    int32    `protobuf:"varint,6,opt,name=contentType,proto3" json:"contentType"`
+	Attempts       int32    `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts"`
+	LastError      string   `protobuf:"bytes,8,opt,name=lastError,proto3" json:"lastError"`
+	CreateTime     int64    `protobuf:"varint,9,opt,name=createTime,proto3" json:"createTime"`
+	DeadTime       int64    `protobuf:"varint,10,opt,name=deadTime,proto3" json:"deadTime"`
+}
+
+func (x *OfflinePushDeadLetter) Reset() {
+	*x = OfflinePushDeadLetter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pushext_pushext_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfflinePushDeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfflinePushDeadLetter) ProtoMessage() {}
+
+func (x *OfflinePushDeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_pushext_pushext_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfflinePushDeadLetter.ProtoReflect.Descriptor instead.
+func (*OfflinePushDeadLetter) Descriptor() ([]byte, []int) {
+	return file_pushext_pushext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OfflinePushDeadLetter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OfflinePushDeadLetter) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *OfflinePushDeadLetter) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+func (x *OfflinePushDeadLetter) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *OfflinePushDeadLetter) GetSendID() string {
+	if x != nil {
+		return x.SendID
+	}
+	return ""
+}
+
+func (x *OfflinePushDeadLetter) GetContentType() int32 {
+	if x != nil {
+		return x.ContentType
+	}
+	return 0
+}
+
+func (x *OfflinePushDeadLetter) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *OfflinePushDeadLetter) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *OfflinePushDeadLetter) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *OfflinePushDeadLetter) GetDeadTime() int64 {
+	if x != nil {
+		return x.DeadTime
+	}
+	return 0
+}
+
+type GetOfflinePushDeadLettersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendID         string                   `protobuf:"bytes,1,opt,name=sendID,proto3" json:"sendID"`
+	ConversationID string                   `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
+	Pagination     *sdkws.RequestPagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *GetOfflinePushDeadLettersReq) Reset() {
+	*x = GetOfflinePushDeadLettersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pushext_pushext_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOfflinePushDeadLettersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOfflinePushDeadLettersReq) ProtoMessage() {}
+
+func (x *GetOfflinePushDeadLettersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_pushext_pushext_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOfflinePushDeadLettersReq.ProtoReflect.Descriptor instead.
+func (*GetOfflinePushDeadLettersReq) Descriptor() ([]byte, []int) {
+	return file_pushext_pushext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetOfflinePushDeadLettersReq) GetSendID() string {
+	if x != nil {
+		return x.SendID
+	}
+	return ""
+}
+
+func (x *GetOfflinePushDeadLettersReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetOfflinePushDeadLettersReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetOfflinePushDeadLettersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total       int64                    `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	DeadLetters []*OfflinePushDeadLetter `protobuf:"bytes,2,rep,name=deadLetters,proto3" json:"deadLetters"`
+}
+
+func (x *GetOfflinePushDeadLettersResp) Reset() {
+	*x = GetOfflinePushDeadLettersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pushext_pushext_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOfflinePushDeadLettersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOfflinePushDeadLettersResp) ProtoMessage() {}
+
+func (x *GetOfflinePushDeadLettersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_pushext_pushext_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOfflinePushDeadLettersResp.ProtoReflect.Descriptor instead.
+func (*GetOfflinePushDeadLettersResp) Descriptor() ([]byte, []int) {
+	return file_pushext_pushext_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetOfflinePushDeadLettersResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetOfflinePushDeadLettersResp) GetDeadLetters() []*OfflinePushDeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+type ReplayOfflinePushDeadLettersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids"`
+}
+
+func (x *ReplayOfflinePushDeadLettersReq) Reset() {
+	*x = ReplayOfflinePushDeadLettersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pushext_pushext_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayOfflinePushDeadLettersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayOfflinePushDeadLettersReq) ProtoMessage() {}
+
+func (x *ReplayOfflinePushDeadLettersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_pushext_pushext_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayOfflinePushDeadLettersReq.ProtoReflect.Descriptor instead.
+func (*ReplayOfflinePushDeadLettersReq) Descriptor() ([]byte, []int) {
+	return file_pushext_pushext_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReplayOfflinePushDeadLettersReq) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ReplayOfflinePushDeadLettersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReplayOfflinePushDeadLettersResp) Reset() {
+	*x = ReplayOfflinePushDeadLettersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pushext_pushext_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayOfflinePushDeadLettersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayOfflinePushDeadLettersResp) ProtoMessage() {}
+
+func (x *ReplayOfflinePushDeadLettersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_pushext_pushext_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayOfflinePushDeadLettersResp.ProtoReflect.Descriptor instead.
+func (*ReplayOfflinePushDeadLettersResp) Descriptor() ([]byte, []int) {
+	return file_pushext_pushext_proto_rawDescGZIP(), []int{4}
+}
+
+var File_pushext_pushext_proto protoreflect.FileDescriptor
+
+var file_pushext_pushext_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x70, 0x75, 0x73, 0x68, 0x65, 0x78, 0x74, 0x2f, 0x70, 0x75, 0x73, 0x68, 0x65, 0x78,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x65, 0x78, 0x74, 0x1a, 0x11, 0x73,
+	0x64, 0x6b, 0x77, 0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xbb, 0x02, 0x0a, 0x15, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68,
+	0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xa5,
+	0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73,
+	0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4f, 0x66,
+	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x4d,
+	0x0a, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x65, 0x78, 0x74, 0x2e, 0x4f, 0x66, 0x66, 0x6c, 0x69,
+	0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x52, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x22, 0x33, 0x0a,
+	0x1f, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75,
+	0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69,
+	0x64, 0x73, 0x22, 0x22, 0x0a, 0x20, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4f, 0x66, 0x66, 0x6c,
+	0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x32, 0xa0, 0x02, 0x0a, 0x07, 0x70, 0x75, 0x73, 0x68, 0x45,
+	0x78, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e,
+	0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x32, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x70, 0x75, 0x73, 0x68, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69,
+	0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x33, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4f,
+	0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x8d, 0x01, 0x0a, 0x1c, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x35, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x65, 0x78,
+	0x74, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50,
+	0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x36, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x70, 0x75, 0x73, 0x68, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4f,
+	0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64,
+	0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x69, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x75,
+	0x73, 0x68, 0x65, 0x78, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pushext_pushext_proto_rawDescOnce sync.Once
+	file_pushext_pushext_proto_rawDescData = file_pushext_pushext_proto_rawDesc
+)
+
+func file_pushext_pushext_proto_rawDescGZIP() []byte {
+	file_pushext_pushext_proto_rawDescOnce.Do(func() {
+		file_pushext_pushext_proto_rawDescData = protoimpl.X.CompressGZIP(file_pushext_pushext_proto_rawDescData)
+	})
+	return file_pushext_pushext_proto_rawDescData
+}
+
+var file_pushext_pushext_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pushext_pushext_proto_goTypes = []interface{}{
+	(*OfflinePushDeadLetter)(nil),            // 0: OpenIMServer.pushext.OfflinePushDeadLetter
+	(*GetOfflinePushDeadLettersReq)(nil),     // 1: OpenIMServer.pushext.GetOfflinePushDeadLettersReq
+	(*GetOfflinePushDeadLettersResp)(nil),    // 2: OpenIMServer.pushext.GetOfflinePushDeadLettersResp
+	(*ReplayOfflinePushDeadLettersReq)(nil),  // 3: OpenIMServer.pushext.ReplayOfflinePushDeadLettersReq
+	(*ReplayOfflinePushDeadLettersResp)(nil), // 4: OpenIMServer.pushext.ReplayOfflinePushDeadLettersResp
+	(*sdkws.RequestPagination)(nil),          // 5: OpenIMServer.sdkws.RequestPagination
+}
+var file_pushext_pushext_proto_depIdxs = []int32{
+	5, // 0: OpenIMServer.pushext.GetOfflinePushDeadLettersReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	0, // 1: OpenIMServer.pushext.GetOfflinePushDeadLettersResp.deadLetters:type_name -> OpenIMServer.pushext.OfflinePushDeadLetter
+	1, // 2: OpenIMServer.pushext.pushExt.GetOfflinePushDeadLetters:input_type -> OpenIMServer.pushext.GetOfflinePushDeadLettersReq
+	3, // 3: OpenIMServer.pushext.pushExt.ReplayOfflinePushDeadLetters:input_type -> OpenIMServer.pushext.ReplayOfflinePushDeadLettersReq
+	2, // 4: OpenIMServer.pushext.pushExt.GetOfflinePushDeadLetters:output_type -> OpenIMServer.pushext.GetOfflinePushDeadLettersResp
+	4, // 5: OpenIMServer.pushext.pushExt.ReplayOfflinePushDeadLetters:output_type -> OpenIMServer.pushext.ReplayOfflinePushDeadLettersResp
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_pushext_pushext_proto_init() }
+func file_pushext_pushext_proto_init() {
+	if File_pushext_pushext_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pushext_pushext_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OfflinePushDeadLetter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pushext_pushext_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOfflinePushDeadLettersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pushext_pushext_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOfflinePushDeadLettersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pushext_pushext_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayOfflinePushDeadLettersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pushext_pushext_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayOfflinePushDeadLettersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pushext_pushext_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pushext_pushext_proto_goTypes,
+		DependencyIndexes: file_pushext_pushext_proto_depIdxs,
+		MessageInfos:      file_pushext_pushext_proto_msgTypes,
+	}.Build()
+	File_pushext_pushext_proto = out.File
+	file_pushext_pushext_proto_rawDesc = nil
+	file_pushext_pushext_proto_goTypes = nil
+	file_pushext_pushext_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// PushExtClient is the client API for PushExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PushExtClient interface {
+	// 分页获取重试耗尽的离线推送
+	GetOfflinePushDeadLetters(ctx context.Context, in *GetOfflinePushDeadLettersReq, opts ...grpc.CallOption) (*GetOfflinePushDeadLettersResp, error)
+	// 重新推送死信
+	ReplayOfflinePushDeadLetters(ctx context.Context, in *ReplayOfflinePushDeadLettersReq, opts ...grpc.CallOption) (*ReplayOfflinePushDeadLettersResp, error)
+}
+
+type pushExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPushExtClient(cc grpc.ClientConnInterface) PushExtClient {
+	return &pushExtClient{cc}
+}
+
+func (c *pushExtClient) GetOfflinePushDeadLetters(ctx context.Context, in *GetOfflinePushDeadLettersReq, opts ...grpc.CallOption) (*GetOfflinePushDeadLettersResp, error) {
+	out := new(GetOfflinePushDeadLettersResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.pushext.pushExt/GetOfflinePushDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushExtClient) ReplayOfflinePushDeadLetters(ctx context.Context, in *ReplayOfflinePushDeadLettersReq, opts ...grpc.CallOption) (*ReplayOfflinePushDeadLettersResp, error) {
+	out := new(ReplayOfflinePushDeadLettersResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.pushext.pushExt/ReplayOfflinePushDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PushExtServer is the server API for PushExt service.
+type PushExtServer interface {
+	// 分页获取重试耗尽的离线推送
+	GetOfflinePushDeadLetters(context.Context, *GetOfflinePushDeadLettersReq) (*GetOfflinePushDeadLettersResp, error)
+	// 重新推送死信
+	ReplayOfflinePushDeadLetters(context.Context, *ReplayOfflinePushDeadLettersReq) (*ReplayOfflinePushDeadLettersResp, error)
+}
+
+// UnimplementedPushExtServer can be embedded to have forward compatible implementations.
+type UnimplementedPushExtServer struct {
+}
+
+func (*UnimplementedPushExtServer) GetOfflinePushDeadLetters(context.Context, *GetOfflinePushDeadLettersReq) (*GetOfflinePushDeadLettersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOfflinePushDeadLetters not implemented")
+}
+func (*UnimplementedPushExtServer) ReplayOfflinePushDeadLetters(context.Context, *ReplayOfflinePushDeadLettersReq) (*ReplayOfflinePushDeadLettersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayOfflinePushDeadLetters not implemented")
+}
+
+func RegisterPushExtServer(s *grpc.Server, srv PushExtServer) {
+	s.RegisterService(&_PushExt_serviceDesc, srv)
+}
+
+func _PushExt_GetOfflinePushDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOfflinePushDeadLettersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushExtServer).GetOfflinePushDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.pushext.pushExt/GetOfflinePushDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushExtServer).GetOfflinePushDeadLetters(ctx, req.(*GetOfflinePushDeadLettersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushExt_ReplayOfflinePushDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayOfflinePushDeadLettersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushExtServer).ReplayOfflinePushDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.pushext.pushExt/ReplayOfflinePushDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushExtServer).ReplayOfflinePushDeadLetters(ctx, req.(*ReplayOfflinePushDeadLettersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PushExt_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "OpenIMServer.pushext.pushExt",
+	HandlerType: (*PushExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetOfflinePushDeadLetters",
+			Handler:    _PushExt_GetOfflinePushDeadLetters_Handler,
+		},
+		{
+			MethodName: "ReplayOfflinePushDeadLetters",
+			Handler:    _PushExt_ReplayOfflinePushDeadLetters_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pushext/pushext.proto",
+}
@@ -0,0 +1,34 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thirdext
+
+import (
+	"errors"
+
+	"github.com/OpenIMSDK/protocol/constant"
+)
+
+func (x *ApnsUpdateTokenReq) Check() error {
+	if x.PlatformID != constant.IOSPlatformID && x.PlatformID != constant.IPadPlatformID {
+		return errors.New("platformID is not ios or ipad")
+	}
+	if x.ApnsToken == "" {
+		return errors.New("apnsToken is empty")
+	}
+	if x.Account == "" {
+		return errors.New("account is empty")
+	}
+	return nil
+}
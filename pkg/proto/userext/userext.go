@@ -0,0 +1,161 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package userext
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/tools/log"
+)
+
+var localeRegexp = regexp.MustCompile(`^[A-Za-z]{2,3}([-_][A-Za-z0-9]{2,8})*$`)
+
+// OfflinePushPlatformIDs are the platforms that receive offline pushes.
+var OfflinePushPlatformIDs = []int32{constant.IOSPlatformID, constant.AndroidPlatformID, constant.AndroidPadPlatformID, constant.IPadPlatformID}
+
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, errors.New("time must be formatted as HH:MM")
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func (x *NotificationPreference) Check() error {
+	if x == nil {
+		return errors.New("preference is empty")
+	}
+	if _, err := time.LoadLocation(x.Timezone); err != nil {
+		return errors.New("timezone is invalid")
+	}
+	if x.QuietHours != nil && x.QuietHours.Enable {
+		if _, err := parseClock(x.QuietHours.Start); err != nil {
+			return err
+		}
+		if _, err := parseClock(x.QuietHours.End); err != nil {
+			return err
+		}
+	}
+	for _, platformID := range x.DisabledPushPlatformIDs {
+		if constant.PlatformIDToName(int(platformID)) == "" {
+			return errors.New("disabledPushPlatformIDs is invalid")
+		}
+	}
+	return nil
+}
+
+// InQuietHours reports whether now falls within the quiet hours in the user's timezone.
+func (x *NotificationPreference) InQuietHours(now time.Time) bool {
+	if x == nil || x.QuietHours == nil || !x.QuietHours.Enable {
+		return false
+	}
+	loc, err := time.LoadLocation(x.Timezone)
+	if err != nil {
+		return false
+	}
+	start, err := parseClock(x.QuietHours.Start)
+	if err != nil {
+		return false
+	}
+	end, err := parseClock(x.QuietHours.End)
+	if err != nil {
+		return false
+	}
+	now = now.In(loc)
+	clock := now.Hour()*60 + now.Minute()
+	if start <= end {
+		return clock >= start && clock < end
+	}
+	return clock >= start || clock < end
+}
+
+// PushDisabled reports whether the user turned off offline pushes on platformID.
+func (x *NotificationPreference) PushDisabled(platformID int32) bool {
+	if x == nil {
+		return false
+	}
+	for _, id := range x.DisabledPushPlatformIDs {
+		if id == platformID {
+			return true
+		}
+	}
+	return false
+}
+
+// AllPushDisabled reports whether the user turned off offline pushes on every platform that receives them.
+func (x *NotificationPreference) AllPushDisabled() bool {
+	for _, platformID := range OfflinePushPlatformIDs {
+		if !x.PushDisabled(platformID) {
+			return false
+		}
+	}
+	return true
+}
+
+// FilterPushUserIDs keeps the users whose preferences accept an offline push now, keep narrows them further and may be nil.
+func FilterPushUserIDs(
+	ctx context.Context,
+	userIDs []string,
+	getPreferences func(ctx context.Context, userIDs []string) (map[string]*NotificationPreference, error),
+	keep func(userID string, preference *NotificationPreference) bool,
+) []string {
+	preferences, err := getPreferences(ctx, userIDs)
+	if err != nil {
+		// preferences only narrow the recipients, push to everyone rather than to no one
+		log.ZWarn(ctx, "GetNotificationPreferences failed", err, "userIDs", userIDs)
+		return userIDs
+	}
+	now := time.Now()
+	pushUserIDs := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		preference := preferences[userID]
+		if preference.InQuietHours(now) || preference.AllPushDisabled() {
+			continue
+		}
+		if keep != nil && !keep(userID, preference) {
+			continue
+		}
+		pushUserIDs = append(pushUserIDs, userID)
+	}
+	return pushUserIDs
+}
+
+func (x *SetNotificationPreferenceReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return x.Preference.Check()
+}
+
+func (x *GetNotificationPreferenceReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *SetUserLocaleReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Locale != "" && !localeRegexp.MatchString(x.Locale) {
+		return errors.New("locale is not a language tag")
+	}
+	return nil
+}
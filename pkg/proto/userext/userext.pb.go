@@ -0,0 +1,1443 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.22.0
+// source: userext/userext.proto
+
+package userext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// quiet hours are local times in the user's timezone formatted as HH:MM, end may be earlier than start to span midnight
+type QuietHours struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enable bool   `protobuf:"varint,1,opt,name=enable,proto3" json:"enable"`
+	Start  string `protobuf:"bytes,2,opt,name=start,proto3" json:"start"`
+	End    string `protobuf:"bytes,3,opt,name=end,proto3" json:"end"`
+}
+
+func (x *QuietHours) Reset() {
+	*x = QuietHours{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userext_userext_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuietHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuietHours) ProtoMessage() {}
+
+func (x *QuietHours) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuietHours.ProtoReflect.Descriptor instead.
+func (*QuietHours) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QuietHours) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *QuietHours) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *QuietHours) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+type NotificationPreference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IANA timezone name such as Asia/Shanghai, empty means UTC
+	Timezone   string      `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone"`
+	QuietHours *QuietHours `protobuf:"bytes,2,opt,name=quietHours,proto3" json:"quietHours"`
+	// platforms that receive no offline push, getui and jpush only accept turning off every platform
+	DisabledPushPlatformIDs []int32 `protobuf:"varint,3,rep,packed,name=disabledPushPlatformIDs,proto3" json:"disabledPushPlatformIDs"`
+	// only offline push group messages that mention the user
+	GroupMentionOnly bool `protobuf:"varint,4,opt,name=groupMentionOnly,proto3" json:"groupMentionOnly"`
+}
+
+func (x *NotificationPreference) Reset() {
+	*x = NotificationPreference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userext_userext_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationPreference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreference) ProtoMessage() {}
+
+func (x *NotificationPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreference.ProtoReflect.Descriptor instead.
+func (*NotificationPreference) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NotificationPreference) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *NotificationPreference) GetQuietHours() *QuietHours {
+	if x != nil {
+		return x.QuietHours
+	}
+	return nil
+}
+
+func (x *NotificationPreference) GetDisabledPushPlatformIDs() []int32 {
+	if x != nil {
+		return x.DisabledPushPlatformIDs
+	}
+	return nil
+}
+
+func (x *NotificationPreference) GetGroupMentionOnly() bool {
+	if x != nil {
+		return x.GroupMentionOnly
+	}
+	return false
+}
+
+type SetNotificationPreferenceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string                  `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Preference *NotificationPreference `protobuf:"bytes,2,opt,name=preference,proto3" json:"preference"`
+}
+
+func (x *SetNotificationPreferenceReq) Reset() {
+	*x = SetNotificationPreferenceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userext_userext_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetNotificationPreferenceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationPreferenceReq) ProtoMessage() {}
+
+func (x *SetNotificationPreferenceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationPreferenceReq.ProtoReflect.Descriptor instead.
+func (*SetNotificationPreferenceReq) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetNotificationPreferenceReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetNotificationPreferenceReq) GetPreference() *NotificationPreference {
+	if x != nil {
+		return x.Preference
+	}
+	return nil
+}
+
+type SetNotificationPreferenceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetNotificationPreferenceResp) Reset() {
+	*x = SetNotificationPreferenceResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userext_userext_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetNotificationPreferenceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationPreferenceResp) ProtoMessage() {}
+
+func (x *SetNotificationPreferenceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationPreferenceResp.ProtoReflect.Descriptor instead.
+func (*SetNotificationPreferenceResp) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{3}
+}
+
+type GetNotificationPreferenceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *GetNotificationPreferenceReq) Reset() {
+	*x = GetNotificationPreferenceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userext_userext_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNotificationPreferenceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferenceReq) ProtoMessage() {}
+
+func (x *GetNotificationPreferenceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferenceReq.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferenceReq) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetNotificationPreferenceReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetNotificationPreferenceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preference *NotificationPreference `protobuf:"bytes,1,opt,name=preference,proto3" json:"preference"`
+}
+
+func (x *GetNotificationPreferenceResp) Reset() {
+	*x = GetNotificationPreferenceResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userext_userext_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNotificationPreferenceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferenceResp) ProtoMessage() {}
+
+func (x *GetNotificationPreferenceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferenceResp.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferenceResp) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetNotificationPreferenceResp) GetPreference() *NotificationPreference {
+	if x != nil {
+		return x.Preference
+	}
+	return nil
+}
+
+type UserNotificationPreference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string                  `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Preference *NotificationPreference `protobuf:"bytes,2,opt,name=preference,proto3" json:"preference"`
+}
+
+func (x *UserNotificationPreference) Reset() {
+	*x = UserNotificationPreference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userext_userext_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserNotificationPreference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserNotificationPreference) ProtoMessage() {}
+
+func (x *UserNotificationPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserNotificationPreference.ProtoReflect.Descriptor instead.
+func (*UserNotificationPreference) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UserNotificationPreference) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UserNotificationPreference) GetPreference() *NotificationPreference {
+	if x != nil {
+		return x.Preference
+	}
+	return nil
+}
+
+type GetNotificationPreferencesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *GetNotificationPreferencesReq) Reset() {
+	*x = GetNotificationPreferencesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userext_userext_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNotificationPreferencesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesReq) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesReq.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesReq) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetNotificationPreferencesReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type GetNotificationPreferencesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preferences []*UserNotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences"`
+}
+
+func (x *GetNotificationPreferencesResp) Reset() {
+	*x = GetNotificationPreferencesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userext_userext_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNotificationPreferencesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesResp) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesResp.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesResp) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetNotificationPreferencesResp) GetPreferences() []*UserNotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type NotificationPreferenceSetTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string                  `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Preference *NotificationPreference `protobuf:"bytes,2,opt,name=preference,proto3" json:"preference"`
+}
+
+func (x *NotificationPreferenceSetTips) Reset() {
+	*x = NotificationPreferenceSetTips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userext_userext_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationPreferenceSetTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferenceSetTips) ProtoMessage() {}
+
+func (x *NotificationPreferenceSetTips) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreferenceSetTips.ProtoReflect.Descriptor instead.
+func (*NotificationPreferenceSetTips) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *NotificationPreferenceSetTips) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *NotificationPreferenceSetTips) GetPreference() *NotificationPreference {
+	if x != nil {
+		return x.Preference
+	}
+	return nil
+}
+
+type SetUserLocaleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	// BCP 47 language tag such as zh-CN, empty falls back to the default locale
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale"`
+}
+
+func (x *SetUserLocaleReq) Reset() {
+	*x = SetUserLocaleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userext_userext_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUserLocaleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserLocaleReq) ProtoMessage() {}
+
+func (x *SetUserLocaleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserLocaleReq.ProtoReflect.Descriptor instead.
+func (*SetUserLocaleReq) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetUserLocaleReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetUserLocaleReq) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type SetUserLocaleResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetUserLocaleResp) Reset() {
+	*x = SetUserLocaleResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userext_userext_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUserLocaleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserLocaleResp) ProtoMessage() {}
+
+func (x *SetUserLocaleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserLocaleResp.ProtoReflect.Descriptor instead.
+func (*SetUserLocaleResp) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{11}
+}
+
+type UserLocale struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale"`
+}
+
+func (x *UserLocale) Reset() {
+	*x = UserLocale{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userext_userext_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserLocale) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserLocale) ProtoMessage() {}
+
+func (x *UserLocale) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserLocale.ProtoReflect.Descriptor instead.
+func (*UserLocale) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UserLocale) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UserLocale) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type GetUsersLocaleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *GetUsersLocaleReq) Reset() {
+	*x = GetUsersLocaleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userext_userext_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsersLocaleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersLocaleReq) ProtoMessage() {}
+
+func (x *GetUsersLocaleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersLocaleReq.ProtoReflect.Descriptor instead.
+func (*GetUsersLocaleReq) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetUsersLocaleReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type GetUsersLocaleResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locales []*UserLocale `protobuf:"bytes,1,rep,name=locales,proto3" json:"locales"`
+}
+
+func (x *GetUsersLocaleResp) Reset() {
+	*x = GetUsersLocaleResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_userext_userext_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsersLocaleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersLocaleResp) ProtoMessage() {}
+
+func (x *GetUsersLocaleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersLocaleResp.ProtoReflect.Descriptor instead.
+func (*GetUsersLocaleResp) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetUsersLocaleResp) GetLocales() []*UserLocale {
+	if x != nil {
+		return x.Locales
+	}
+	return nil
+}
+
+var File_userext_userext_proto protoreflect.FileDescriptor
+
+var file_userext_userext_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x22, 0x4c, 0x0a,
+	0x0a, 0x51, 0x75, 0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0xdc, 0x01, 0x0a, 0x16,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f,
+	0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f,
+	0x6e, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x71, 0x75, 0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x51, 0x75,
+	0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x0a, 0x71, 0x75, 0x69, 0x65, 0x74, 0x48,
+	0x6f, 0x75, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x17, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x50, 0x75, 0x73, 0x68, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x17, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x50,
+	0x75, 0x73, 0x68, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x73, 0x12, 0x2a,
+	0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x6e,
+	0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x84, 0x01, 0x0a, 0x1c, 0x53,
+	0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x4c, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x36, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x6d, 0x0a, 0x1d, 0x47, 0x65,
+	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4c, 0x0a, 0x0a, 0x70,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x70,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x1a, 0x55, 0x73,
+	0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x4c, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x39,
+	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x74, 0x0a, 0x1e, 0x47, 0x65, 0x74,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x52, 0x0a, 0x0b, 0x70,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x30, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22,
+	0x85, 0x01, 0x0a, 0x1d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x54, 0x69, 0x70,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x4c, 0x0a, 0x0a, 0x70, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x65, 0x78, 0x74, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x42, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x53,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x3c, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x2d,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x50, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x32,
+	0xe8, 0x04, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x45, 0x78, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x19,
+	0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74,
+	0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x33, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x84, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x32, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x33, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x87, 0x01, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x34, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x60, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x12, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x63, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73,
+	0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x69, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x75,
+	0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_userext_userext_proto_rawDescOnce sync.Once
+	file_userext_userext_proto_rawDescData = file_userext_userext_proto_rawDesc
+)
+
+func file_userext_userext_proto_rawDescGZIP() []byte {
+	file_userext_userext_proto_rawDescOnce.Do(func() {
+		file_userext_userext_proto_rawDescData = protoimpl.X.CompressGZIP(file_userext_userext_proto_rawDescData)
+	})
+	return file_userext_userext_proto_rawDescData
+}
+
+var file_userext_userext_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_userext_userext_proto_goTypes = []interface{}{
+	(*QuietHours)(nil),                     // 0: OpenIMServer.userext.QuietHours
+	(*NotificationPreference)(nil),         // 1: OpenIMServer.userext.NotificationPreference
+	(*SetNotificationPreferenceReq)(nil),   // 2: OpenIMServer.userext.SetNotificationPreferenceReq
+	(*SetNotificationPreferenceResp)(nil),  // 3: OpenIMServer.userext.SetNotificationPreferenceResp
+	(*GetNotificationPreferenceReq)(nil),   // 4: OpenIMServer.userext.GetNotificationPreferenceReq
+	(*GetNotificationPreferenceResp)(nil),  // 5: OpenIMServer.userext.GetNotificationPreferenceResp
+	(*UserNotificationPreference)(nil),     // 6: OpenIMServer.userext.UserNotificationPreference
+	(*GetNotificationPreferencesReq)(nil),  // 7: OpenIMServer.userext.GetNotificationPreferencesReq
+	(*GetNotificationPreferencesResp)(nil), // 8: OpenIMServer.userext.GetNotificationPreferencesResp
+	(*NotificationPreferenceSetTips)(nil),  // 9: OpenIMServer.userext.NotificationPreferenceSetTips
+	(*SetUserLocaleReq)(nil),               // 10: OpenIMServer.userext.SetUserLocaleReq
+	(*SetUserLocaleResp)(nil),              // 11: OpenIMServer.userext.SetUserLocaleResp
+	(*UserLocale)(nil),                     // 12: OpenIMServer.userext.UserLocale
+	(*GetUsersLocaleReq)(nil),              // 13: OpenIMServer.userext.GetUsersLocaleReq
+	(*GetUsersLocaleResp)(nil),             // 14: OpenIMServer.userext.GetUsersLocaleResp
+}
+var file_userext_userext_proto_depIdxs = []int32{
+	0,  // 0: OpenIMServer.userext.NotificationPreference.quietHours:type_name -> OpenIMServer.userext.QuietHours
+	1,  // 1: OpenIMServer.userext.SetNotificationPreferenceReq.preference:type_name -> OpenIMServer.userext.NotificationPreference
+	1,  // 2: OpenIMServer.userext.GetNotificationPreferenceResp.preference:type_name -> OpenIMServer.userext.NotificationPreference
+	1,  // 3: OpenIMServer.userext.UserNotificationPreference.preference:type_name -> OpenIMServer.userext.NotificationPreference
+	6,  // 4: OpenIMServer.userext.GetNotificationPreferencesResp.preferences:type_name -> OpenIMServer.userext.UserNotificationPreference
+	1,  // 5: OpenIMServer.userext.NotificationPreferenceSetTips.preference:type_name -> OpenIMServer.userext.NotificationPreference
+	12, // 6: OpenIMServer.userext.GetUsersLocaleResp.locales:type_name -> OpenIMServer.userext.UserLocale
+	2,  // 7: OpenIMServer.userext.userExt.SetNotificationPreference:input_type -> OpenIMServer.userext.SetNotificationPreferenceReq
+	4,  // 8: OpenIMServer.userext.userExt.GetNotificationPreference:input_type -> OpenIMServer.userext.GetNotificationPreferenceReq
+	7,  // 9: OpenIMServer.userext.userExt.GetNotificationPreferences:input_type -> OpenIMServer.userext.GetNotificationPreferencesReq
+	10, // 10: OpenIMServer.userext.userExt.SetUserLocale:input_type -> OpenIMServer.userext.SetUserLocaleReq
+	13, // 11: OpenIMServer.userext.userExt.GetUsersLocale:input_type -> OpenIMServer.userext.GetUsersLocaleReq
+	3,  // 12: OpenIMServer.userext.userExt.SetNotificationPreference:output_type -> OpenIMServer.userext.SetNotificationPreferenceResp
+	5,  // 13: OpenIMServer.userext.userExt.GetNotificationPreference:output_type -> OpenIMServer.userext.GetNotificationPreferenceResp
+	8,  // 14: OpenIMServer.userext.userExt.GetNotificationPreferences:output_type -> OpenIMServer.userext.GetNotificationPreferencesResp
+	11, // 15: OpenIMServer.userext.userExt.SetUserLocale:output_type -> OpenIMServer.userext.SetUserLocaleResp
+	14, // 16: OpenIMServer.userext.userExt.GetUsersLocale:output_type -> OpenIMServer.userext.GetUsersLocaleResp
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_userext_userext_proto_init() }
+func file_userext_userext_proto_init() {
+	if File_userext_userext_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_userext_userext_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuietHours); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userext_userext_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationPreference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userext_userext_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNotificationPreferenceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userext_userext_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNotificationPreferenceResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userext_userext_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNotificationPreferenceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userext_userext_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNotificationPreferenceResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userext_userext_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserNotificationPreference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userext_userext_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNotificationPreferencesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userext_userext_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNotificationPreferencesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userext_userext_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationPreferenceSetTips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userext_userext_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetUserLocaleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userext_userext_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetUserLocaleResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userext_userext_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserLocale); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userext_userext_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsersLocaleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_userext_userext_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsersLocaleResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_userext_userext_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_userext_userext_proto_goTypes,
+		DependencyIndexes: file_userext_userext_proto_depIdxs,
+		MessageInfos:      file_userext_userext_proto_msgTypes,
+	}.Build()
+	File_userext_userext_proto = out.File
+	file_userext_userext_proto_rawDesc = nil
+	file_userext_userext_proto_goTypes = nil
+	file_userext_userext_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// UserExtClient is the client API for UserExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type UserExtClient interface {
+	// 设置通知偏好
+	SetNotificationPreference(ctx context.Context, in *SetNotificationPreferenceReq, opts ...grpc.CallOption) (*SetNotificationPreferenceResp, error)
+	// 获取通知偏好
+	GetNotificationPreference(ctx context.Context, in *GetNotificationPreferenceReq, opts ...grpc.CallOption) (*GetNotificationPreferenceResp, error)
+	// 批量获取通知偏好, 供推送使用
+	GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesReq, opts ...grpc.CallOption) (*GetNotificationPreferencesResp, error)
+	// 设置用户语言, 用于离线推送本地化
+	SetUserLocale(ctx context.Context, in *SetUserLocaleReq, opts ...grpc.CallOption) (*SetUserLocaleResp, error)
+	// 批量获取用户语言
+	GetUsersLocale(ctx context.Context, in *GetUsersLocaleReq, opts ...grpc.CallOption) (*GetUsersLocaleResp, error)
+}
+
+type userExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserExtClient(cc grpc.ClientConnInterface) UserExtClient {
+	return &userExtClient{cc}
+}
+
+func (c *userExtClient) SetNotificationPreference(ctx context.Context, in *SetNotificationPreferenceReq, opts ...grpc.CallOption) (*SetNotificationPreferenceResp, error) {
+	out := new(SetNotificationPreferenceResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.userext.userExt/SetNotificationPreference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) GetNotificationPreference(ctx context.Context, in *GetNotificationPreferenceReq, opts ...grpc.CallOption) (*GetNotificationPreferenceResp, error) {
+	out := new(GetNotificationPreferenceResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.userext.userExt/GetNotificationPreference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesReq, opts ...grpc.CallOption) (*GetNotificationPreferencesResp, error) {
+	out := new(GetNotificationPreferencesResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.userext.userExt/GetNotificationPreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) SetUserLocale(ctx context.Context, in *SetUserLocaleReq, opts ...grpc.CallOption) (*SetUserLocaleResp, error) {
+	out := new(SetUserLocaleResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.userext.userExt/SetUserLocale", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) GetUsersLocale(ctx context.Context, in *GetUsersLocaleReq, opts ...grpc.CallOption) (*GetUsersLocaleResp, error) {
+	out := new(GetUsersLocaleResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.userext.userExt/GetUsersLocale", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserExtServer is the server API for UserExt service.
+type UserExtServer interface {
+	// 设置通知偏好
+	SetNotificationPreference(context.Context, *SetNotificationPreferenceReq) (*SetNotificationPreferenceResp, error)
+	// 获取通知偏好
+	GetNotificationPreference(context.Context, *GetNotificationPreferenceReq) (*GetNotificationPreferenceResp, error)
+	// 批量获取通知偏好, 供推送使用
+	GetNotificationPreferences(context.Context, *GetNotificationPreferencesReq) (*GetNotificationPreferencesResp, error)
+	// 设置用户语言, 用于离线推送本地化
+	SetUserLocale(context.Context, *SetUserLocaleReq) (*SetUserLocaleResp, error)
+	// 批量获取用户语言
+	GetUsersLocale(context.Context, *GetUsersLocaleReq) (*GetUsersLocaleResp, error)
+}
+
+// UnimplementedUserExtServer can be embedded to have forward compatible implementations.
+type UnimplementedUserExtServer struct {
+}
+
+func (*UnimplementedUserExtServer) SetNotificationPreference(context.Context, *SetNotificationPreferenceReq) (*SetNotificationPreferenceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNotificationPreference not implemented")
+}
+func (*UnimplementedUserExtServer) GetNotificationPreference(context.Context, *GetNotificationPreferenceReq) (*GetNotificationPreferenceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationPreference not implemented")
+}
+func (*UnimplementedUserExtServer) GetNotificationPreferences(context.Context, *GetNotificationPreferencesReq) (*GetNotificationPreferencesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationPreferences not implemented")
+}
+func (*UnimplementedUserExtServer) SetUserLocale(context.Context, *SetUserLocaleReq) (*SetUserLocaleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserLocale not implemented")
+}
+func (*UnimplementedUserExtServer) GetUsersLocale(context.Context, *GetUsersLocaleReq) (*GetUsersLocaleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersLocale not implemented")
+}
+
+func RegisterUserExtServer(s *grpc.Server, srv UserExtServer) {
+	s.RegisterService(&_UserExt_serviceDesc, srv)
+}
+
+func _UserExt_SetNotificationPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNotificationPreferenceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).SetNotificationPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.userext.userExt/SetNotificationPreference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).SetNotificationPreference(ctx, req.(*SetNotificationPreferenceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_GetNotificationPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationPreferenceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).GetNotificationPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.userext.userExt/GetNotificationPreference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).GetNotificationPreference(ctx, req.(*GetNotificationPreferenceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_GetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationPreferencesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).GetNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.userext.userExt/GetNotificationPreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).GetNotificationPreferences(ctx, req.(*GetNotificationPreferencesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_SetUserLocale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserLocaleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).SetUserLocale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.userext.userExt/SetUserLocale",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).SetUserLocale(ctx, req.(*SetUserLocaleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_GetUsersLocale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersLocaleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).GetUsersLocale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.userext.userExt/GetUsersLocale",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).GetUsersLocale(ctx, req.(*GetUsersLocaleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _UserExt_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "OpenIMServer.userext.userExt",
+	HandlerType: (*UserExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetNotificationPreference",
+			Handler:    _UserExt_SetNotificationPreference_Handler,
+		},
+		{
+			MethodName: "GetNotificationPreference",
+			Handler:    _UserExt_GetNotificationPreference_Handler,
+		},
+		{
+			MethodName: "GetNotificationPreferences",
+			Handler:    _UserExt_GetNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "SetUserLocale",
+			Handler:    _UserExt_SetUserLocale_Handler,
+		},
+		{
+			MethodName: "GetUsersLocale",
+			Handler:    _UserExt_GetUsersLocale_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "userext/userext.proto",
+}
@@ -0,0 +1,113 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package userext
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/constant"
+)
+
+func TestInQuietHours(t *testing.T) {
+	overnight := &NotificationPreference{
+		Timezone:   "Asia/Shanghai",
+		QuietHours: &QuietHours{Enable: true, Start: "22:00", End: "07:30"},
+	}
+	daytime := &NotificationPreference{
+		QuietHours: &QuietHours{Enable: true, Start: "09:00", End: "17:00"},
+	}
+	disabled := &NotificationPreference{
+		QuietHours: &QuietHours{Enable: false, Start: "00:00", End: "23:59"},
+	}
+	cases := []struct {
+		name       string
+		preference *NotificationPreference
+		now        time.Time
+		want       bool
+	}{
+		// 15:00 UTC is 23:00 in Shanghai
+		{"overnight before midnight", overnight, time.Date(2023, 1, 1, 15, 0, 0, 0, time.UTC), true},
+		{"overnight after midnight", overnight, time.Date(2023, 1, 1, 23, 0, 0, 0, time.UTC), true},
+		{"overnight end is exclusive", overnight, time.Date(2023, 1, 1, 23, 30, 0, 0, time.UTC), false},
+		{"overnight daytime", overnight, time.Date(2023, 1, 1, 4, 0, 0, 0, time.UTC), false},
+		{"daytime utc", daytime, time.Date(2023, 1, 1, 9, 0, 0, 0, time.UTC), true},
+		{"daytime evening", daytime, time.Date(2023, 1, 1, 18, 0, 0, 0, time.UTC), false},
+		{"disabled", disabled, time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC), false},
+		{"nil", nil, time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC), false},
+	}
+	for _, c := range cases {
+		if got := c.preference.InQuietHours(c.now); got != c.want {
+			t.Errorf("%s: InQuietHours = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestAllPushDisabled(t *testing.T) {
+	preference := &NotificationPreference{DisabledPushPlatformIDs: []int32{constant.IOSPlatformID, constant.AndroidPlatformID}}
+	if preference.AllPushDisabled() {
+		t.Error("iPad and Android pad are still enabled")
+	}
+	preference.DisabledPushPlatformIDs = append(preference.DisabledPushPlatformIDs, constant.IPadPlatformID, constant.AndroidPadPlatformID)
+	if !preference.AllPushDisabled() {
+		t.Error("every offline push platform is disabled")
+	}
+	if (*NotificationPreference)(nil).AllPushDisabled() {
+		t.Error("users without a preference receive pushes")
+	}
+}
+
+func TestFilterPushUserIDs(t *testing.T) {
+	preferences := map[string]*NotificationPreference{
+		"muted":   {DisabledPushPlatformIDs: OfflinePushPlatformIDs},
+		"partial": {DisabledPushPlatformIDs: []int32{constant.IOSPlatformID}},
+	}
+	get := func(ctx context.Context, userIDs []string) (map[string]*NotificationPreference, error) {
+		return preferences, nil
+	}
+	userIDs := []string{"muted", "partial", "none", "dropped"}
+	got := FilterPushUserIDs(context.Background(), userIDs, get, func(userID string, preference *NotificationPreference) bool {
+		return userID != "dropped"
+	})
+	if want := []string{"partial", "none"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterPushUserIDs = %v, want %v", got, want)
+	}
+	fail := func(ctx context.Context, userIDs []string) (map[string]*NotificationPreference, error) {
+		return nil, errors.New("user rpc unavailable")
+	}
+	if got := FilterPushUserIDs(context.Background(), userIDs, fail, nil); !reflect.DeepEqual(got, userIDs) {
+		t.Errorf("FilterPushUserIDs without preferences = %v, want every user", got)
+	}
+}
+
+func TestNotificationPreferenceCheck(t *testing.T) {
+	valid := &NotificationPreference{Timezone: "Europe/Berlin", QuietHours: &QuietHours{Enable: true, Start: "23:00", End: "06:00"}}
+	if err := valid.Check(); err != nil {
+		t.Error(err)
+	}
+	for _, invalid := range []*NotificationPreference{
+		nil,
+		{Timezone: "Mars/Olympus"},
+		{QuietHours: &QuietHours{Enable: true, Start: "25:00", End: "06:00"}},
+		{DisabledPushPlatformIDs: []int32{100}},
+	} {
+		if err := invalid.Check(); err == nil {
+			t.Errorf("expected %v to be invalid", invalid)
+		}
+	}
+}
@@ -28,12 +28,14 @@ import (
 	"github.com/OpenIMSDK/tools/utils"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/groupext"
 )
 
 type Group struct {
-	conn   grpc.ClientConnInterface
-	Client group.GroupClient
-	discov discoveryregistry.SvcDiscoveryRegistry
+	conn      grpc.ClientConnInterface
+	Client    group.GroupClient
+	ExtClient groupext.GroupExtClient
+	discov    discoveryregistry.SvcDiscoveryRegistry
 }
 
 func NewGroup(discov discoveryregistry.SvcDiscoveryRegistry) *Group {
@@ -42,7 +44,7 @@ func NewGroup(discov discoveryregistry.SvcDiscoveryRegistry) *Group {
 		panic(err)
 	}
 	client := group.NewGroupClient(conn)
-	return &Group{discov: discov, conn: conn, Client: client}
+	return &Group{discov: discov, conn: conn, Client: client, ExtClient: groupext.NewGroupExtClient(conn)}
 }
 
 type GroupRpcClient Group
@@ -201,6 +203,12 @@ func (g *GroupRpcClient) GetGroupMemberCache(
 	return resp.Member, nil
 }
 
+// CheckGroupAdmin asks the group server whether the op user is the group owner or an admin.
+func (g *GroupRpcClient) CheckGroupAdmin(ctx context.Context, groupID string) error {
+	_, err := g.ExtClient.VerifyGroupAdmin(ctx, &groupext.VerifyGroupAdminReq{GroupID: groupID})
+	return err
+}
+
 func (g *GroupRpcClient) DismissGroup(ctx context.Context, groupID string) error {
 	_, err := g.Client.DismissGroup(ctx, &group.DismissGroupReq{
 		GroupID:      groupID,
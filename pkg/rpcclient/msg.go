@@ -29,6 +29,8 @@ import (
 	"github.com/OpenIMSDK/tools/utils"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/msgext"
 	// "google.golang.org/protobuf/proto".
 )
 
@@ -74,9 +76,16 @@ func newContentTypeConf() map[int32]config.NotificationConf {
 		constant.ConversationUnreadNotification:      config.Config.Notification.ConversationChanged,
 		constant.ConversationPrivateChatNotification: config.Config.Notification.ConversationSetPrivate,
 		// msg
-		constant.MsgRevokeNotification:  {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
-		constant.HasReadReceipt:         {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
-		constant.DeleteMsgsNotification: {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		constant.MsgRevokeNotification:          {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		constant.HasReadReceipt:                 {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		constant.DeleteMsgsNotification:         {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		msgprocessor.MsgEditNotification:        {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		msgprocessor.MsgReactionNotification:    {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		msgprocessor.MsgThreadReplyNotification: {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		msgprocessor.MsgPinNotification:         {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		msgprocessor.MsgPollVoteNotification:    {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		// user
+		msgprocessor.NotificationPreferenceSetNotification: {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
 	}
 }
 
@@ -104,8 +113,9 @@ func newSessionTypeConf() map[int32]int32 {
 		constant.GroupInfoSetAnnouncementNotification:     constant.SuperGroupChatType,
 		constant.GroupInfoSetNameNotification:             constant.SuperGroupChatType,
 		// user
-		constant.UserInfoUpdatedNotification:  constant.SingleChatType,
-		constant.UserStatusChangeNotification: constant.SingleChatType,
+		constant.UserInfoUpdatedNotification:               constant.SingleChatType,
+		constant.UserStatusChangeNotification:              constant.SingleChatType,
+		msgprocessor.NotificationPreferenceSetNotification: constant.SingleChatType,
 		// friend
 		constant.FriendApplicationNotification:         constant.SingleChatType,
 		constant.FriendApplicationApprovedNotification: constant.SingleChatType,
@@ -127,9 +137,10 @@ func newSessionTypeConf() map[int32]int32 {
 }
 
 type Message struct {
-	conn   grpc.ClientConnInterface
-	Client msg.MsgClient
-	discov discoveryregistry.SvcDiscoveryRegistry
+	conn      grpc.ClientConnInterface
+	Client    msg.MsgClient
+	ExtClient msgext.MsgExtClient
+	discov    discoveryregistry.SvcDiscoveryRegistry
 }
 
 func NewMessage(discov discoveryregistry.SvcDiscoveryRegistry) *Message {
@@ -138,7 +149,7 @@ func NewMessage(discov discoveryregistry.SvcDiscoveryRegistry) *Message {
 		panic(err)
 	}
 	client := msg.NewMsgClient(conn)
-	return &Message{discov: discov, conn: conn, Client: client}
+	return &Message{discov: discov, conn: conn, Client: client, ExtClient: msgext.NewMsgExtClient(conn)}
 }
 
 type MessageRpcClient Message
@@ -22,6 +22,8 @@ import (
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
 	relationtb "github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/userext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 )
 
@@ -121,3 +123,12 @@ func (u *UserNotificationSender) UserCommandDeleteNotification(
 ) error {
 	return u.Notification(ctx, tips.FromUserID, tips.ToUserID, constant.UserCommandDeleteNotification, tips)
 }
+
+func (u *UserNotificationSender) NotificationPreferenceSetNotification(
+	ctx context.Context,
+	userID string,
+	preference *userext.NotificationPreference,
+) error {
+	tips := &userext.NotificationPreferenceSetTips{UserID: userID, Preference: preference}
+	return u.Notification(ctx, userID, userID, msgprocessor.NotificationPreferenceSetNotification, tips)
+}
@@ -29,13 +29,15 @@ import (
 	"github.com/OpenIMSDK/tools/utils"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/proto/userext"
 )
 
 // User represents a structure holding connection details for the User RPC client.
 type User struct {
-	conn   grpc.ClientConnInterface
-	Client user.UserClient
-	Discov discoveryregistry.SvcDiscoveryRegistry
+	conn      grpc.ClientConnInterface
+	Client    user.UserClient
+	ExtClient userext.UserExtClient
+	Discov    discoveryregistry.SvcDiscoveryRegistry
 }
 
 // NewUser initializes and returns a User instance based on the provided service discovery registry.
@@ -45,7 +47,7 @@ func NewUser(discov discoveryregistry.SvcDiscoveryRegistry) *User {
 		panic(err)
 	}
 	client := user.NewUserClient(conn)
-	return &User{Discov: discov, Client: client, conn: conn}
+	return &User{Discov: discov, Client: client, ExtClient: userext.NewUserExtClient(conn), conn: conn}
 }
 
 // UserRpcClient represents the structure for a User RPC client.
@@ -189,3 +191,35 @@ func (u *UserRpcClient) GetNotificationByID(ctx context.Context, userID string)
 	})
 	return err
 }
+
+// GetNotificationPreferences retrieves the notification preferences of multiple users, keyed by user ID.
+func (u *UserRpcClient) GetNotificationPreferences(ctx context.Context, userIDs []string) (map[string]*userext.NotificationPreference, error) {
+	if len(userIDs) == 0 {
+		return map[string]*userext.NotificationPreference{}, nil
+	}
+	resp, err := u.ExtClient.GetNotificationPreferences(ctx, &userext.GetNotificationPreferencesReq{UserIDs: userIDs})
+	if err != nil {
+		return nil, err
+	}
+	preferences := make(map[string]*userext.NotificationPreference, len(resp.Preferences))
+	for _, preference := range resp.Preferences {
+		preferences[preference.UserID] = preference.Preference
+	}
+	return preferences, nil
+}
+
+// GetUsersLocale retrieves the locales of multiple users, keyed by user ID.
+func (u *UserRpcClient) GetUsersLocale(ctx context.Context, userIDs []string) (map[string]string, error) {
+	if len(userIDs) == 0 {
+		return map[string]string{}, nil
+	}
+	resp, err := u.ExtClient.GetUsersLocale(ctx, &userext.GetUsersLocaleReq{UserIDs: userIDs})
+	if err != nil {
+		return nil, err
+	}
+	locales := make(map[string]string, len(resp.Locales))
+	for _, locale := range resp.Locales {
+		locales[locale.UserID] = locale.Locale
+	}
+	return locales, nil
+}
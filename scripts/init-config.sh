@@ -49,6 +49,7 @@ declare -A COPY_TEMPLATES=(
   ["${OPENIM_ROOT}/deployments/templates/email.tmpl"]="${OPENIM_ROOT}/config/email.tmpl"
   ["${OPENIM_ROOT}/deployments/templates/instance-down-rules.yml"]="${OPENIM_ROOT}/config/instance-down-rules.yml"
   ["${OPENIM_ROOT}/deployments/templates/notification.yaml"]="${OPENIM_ROOT}/config/notification.yaml"
+  ["${OPENIM_ROOT}/deployments/templates/push-template.yaml"]="${OPENIM_ROOT}/config/push-template.yaml"
 )
 
 # Templates for config Copy file
@@ -56,6 +57,7 @@ declare -A COPY_EXAMPLES=(
   ["${OPENIM_ROOT}/deployments/templates/email.tmpl"]="${OPENIM_ROOT}/config/templates/email.tmpl.template"
   ["${OPENIM_ROOT}/deployments/templates/instance-down-rules.yml"]="${OPENIM_ROOT}/config/templates/instance-down-rules.yml.template"
   ["${OPENIM_ROOT}/deployments/templates/notification.yaml"]="${OPENIM_ROOT}/config/templates/notification.yaml.template"
+  ["${OPENIM_ROOT}/deployments/templates/push-template.yaml"]="${OPENIM_ROOT}/config/templates/push-template.yaml.template"
 )
 
 # Command-line options
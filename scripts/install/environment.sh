@@ -229,6 +229,7 @@ def "REDIS_USERNAME"                                        # Redis的用户名
 readonly REDIS_PASSWORD=${REDIS_PASSWORD:-"${PASSWORD}"}
 
 ###################### Kafka 配置信息 ######################
+def "MQ_TYPE" "kafka"                                       # 消息队列类型，可选 kafka、redis
 def "KAFKA_USERNAME"                                        # `Kafka` 的用户名
 def "KAFKA_PASSWORD"                                        # `Kafka` 的密码
 def "KAFKA_PORT" "19094"                                    # `Kafka` 的端口
@@ -236,10 +237,13 @@ def "KAFKA_ADDRESS" "${DOCKER_BRIDGE_GATEWAY}"              # `Kafka` 的地址
 def "KAFKA_LATESTMSG_REDIS_TOPIC" "latestMsgToRedis"        # `Kafka` 的最新消息到Redis的主题
 def "KAFKA_OFFLINEMSG_MONGO_TOPIC" "offlineMsgToMongoMysql" # `Kafka` 的离线消息到Mongo的主题
 def "KAFKA_MSG_PUSH_TOPIC" "msgToPush"                      # `Kafka` 的消息到推送的主题
+def "KAFKA_MSG_MONGO_DEAD_LETTER_TOPIC" "msgToMongoDeadLetter" # `Kafka` 的写入Mongo失败消息的死信主题
 def "KAFKA_CONSUMERGROUPID_REDIS" "redis"                   # `Kafka` 的消费组ID到Redis
 def "KAFKA_CONSUMERGROUPID_MONGO" "mongo"                   # `Kafka` 的消费组ID到Mongo
 def "KAFKA_CONSUMERGROUPID_MYSQL" "mysql"                   # `Kafka` 的消费组ID到MySql
 def "KAFKA_CONSUMERGROUPID_PUSH" "push"                     # `Kafka` 的消费组ID到推送
+def "KAFKA_CONSUMERGROUPID_SEARCH" "search"                 # `Kafka` 的消费组ID到消息搜索索引
+def "KAFKA_CONSUMERGROUPID_MONGO_DEAD_LETTER" "mongoDeadLetter" # `Kafka` 的消费组ID到Mongo死信重投
 
 ###################### openim-web 配置信息 ######################
 def "OPENIM_WEB_PORT" "11001"                       # openim-web的端口
@@ -325,6 +329,7 @@ def "OPENIM_THIRD_NAME" "Third"                     # OpenIM第三方服务名
 
 ###################### Log Configuration Variables ######################
 def "LOG_STORAGE_LOCATION" "${OPENIM_ROOT}/logs/" # 日志存储位置
+def "MSG_SEARCH_DIR" "${DATA_DIR}/data/msgsearch/" # 消息搜索索引存储位置
 def "LOG_ROTATION_TIME" "24"                        # 日志轮替时间
 def "LOG_REMAIN_ROTATION_COUNT" "2"                 # 保留的日志轮替数量
 def "LOG_REMAIN_LOG_LEVEL" "6"                      # 保留的日志级别
@@ -336,6 +341,7 @@ def "LOG_WITH_STACK" "false"                        # 日志是否带有堆栈
 def "WEBSOCKET_MAX_CONN_NUM" "100000" # Websocket最大连接数
 def "WEBSOCKET_MAX_MSG_LEN" "4096"    # Websocket最大消息长度
 def "WEBSOCKET_TIMEOUT" "10"          # Websocket超时
+def "GATEWAY_ROUTE_ENABLE" "true"     # 网关用户路由表启用
 def "PUSH_ENABLE" "getui"             # 推送是否启用
 # GeTui推送URL
 readonly GETUI_PUSH_URL=${GETUI_PUSH_URL:-'https://restapi.getui.com/v2/$appId'}
@@ -349,6 +355,14 @@ def "JPNS_APP_KEY" ""                 # JPNS应用密钥
 def "JPNS_MASTER_SECRET" ""           # JPNS主密钥
 def "JPNS_PUSH_URL" ""                # JPNS推送URL
 def "JPNS_PUSH_INTENT" ""             # JPNS推送意图
+def "APNS_KEY_FILE" "AuthKey.p8"      # APNs认证密钥文件
+def "APNS_KEY_ID" ""                  # APNs密钥ID
+def "APNS_TEAM_ID" ""                 # APNs团队ID
+def "APNS_BUNDLE_ID" ""               # APNs应用Bundle ID
+def "WEBHOOK_PUSH_URL" ""             # 自建推送网关地址
+def "WEBHOOK_PUSH_SECRET" ""          # 自建推送网关签名密钥
+def "PUSH_RETRY_ENABLE" "true"        # 离线推送失败重试是否启用
+def "PUSH_DELIVERY_STATUS_ENABLE" "true" # 消息投递状态记录是否启用
 def "IM_ADMIN_USERID" "imAdmin"       # IM管理员ID
 def "IM_ADMIN_NAME" "imAdmin"         # IM管理员昵称
 def "MULTILOGIN_POLICY" "1"           # 多登录策略
@@ -361,6 +375,14 @@ def "RETAIN_CHAT_RECORDS" "365"       # 保留聊天记录
 readonly CHAT_RECORDS_CLEAR_TIME=${CHAT_RECORDS_CLEAR_TIME:-'0 2 * * 3'}
 # 消息销毁时间
 readonly MSG_DESTRUCT_TIME=${MSG_DESTRUCT_TIME:-'0 2 * * *'}
+# 定时消息发送时间
+readonly SCHEDULED_MSG_DISPATCH_TIME=${SCHEDULED_MSG_DISPATCH_TIME:-'* * * * *'}
+def "MSG_ARCHIVE_ENABLE" "false"      # 消息归档启用
+# 消息归档时间
+readonly MSG_ARCHIVE_TIME=${MSG_ARCHIVE_TIME:-'0 3 * * *'}
+def "MSG_ARCHIVE_BUCKET" ""           # 消息归档专用私有桶，为空时使用对象存储的桶
+def "MSG_RATE_LIMIT_ENABLE" "false"   # 消息限流启用
+def "MODERATION_ENABLE" "false"       # 消息内容审核启用
 # 密钥
 readonly SECRET=${SECRET:-"${PASSWORD}"}
 def "TOKEN_EXPIRE" "90"         # Token到期时间
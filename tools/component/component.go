@@ -28,6 +28,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/unrelation"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister/zookeeper"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/kafka"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/mq"
 
 	"github.com/OpenIMSDK/tools/component"
 	"github.com/OpenIMSDK/tools/errs"
@@ -150,6 +151,13 @@ func checkZookeeper() error {
 
 // checkKafka checks the Kafka connection
 func checkKafka() error {
+	// the memory mq can not connect the separate binaries, the redis mq needs no broker
+	switch mq.Type() {
+	case mq.TypeMemory:
+		return mq.ErrMemoryType()
+	case mq.TypeRedis:
+		return nil
+	}
 	// Prioritize environment variables
 	kafkaStu := &component.Kafka{
 		Username: config.Config.Kafka.Username,